@@ -0,0 +1,9 @@
+#include "_cgo_export.h"
+
+// gnparser_call calls a callback given to gnparser_parse_array. Go code
+// cannot call C function pointers directly.
+int gnparser_call(
+	gnparser_callback cb, int index, const char *result, void *user_data
+) {
+	return cb(index, result, user_data);
+}
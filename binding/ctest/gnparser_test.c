@@ -0,0 +1,117 @@
+// Tests of the gnparser C library. Run it with `make clib-test`.
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+#include "libgnparser.h"
+
+static int failures = 0;
+
+#define CHECK(cond)                                                      \
+	do {                                                                 \
+		if (!(cond)) {                                                   \
+			fprintf(stderr, "%s:%d: check failed: %s\n", __FILE__,     \
+			        __LINE__, #cond);                                    \
+			failures++;                                                  \
+		}                                                                \
+	} while (0)
+
+// collected keeps results received by the callback.
+struct collected {
+	int count;
+	int in_order;
+	int stop_at;
+	char *last;
+};
+
+static int collect(int index, const char *result, void *user_data) {
+	struct collected *c = user_data;
+	if (index != c->count) {
+		c->in_order = 0;
+	}
+	c->count++;
+	free(c->last);
+	c->last = strdup(result);
+	return c->stop_at > 0 && c->count >= c->stop_at;
+}
+
+static void test_parse(void) {
+	gnparser_handle h = gnparser_new("{\"Format\": \"csv\"}");
+	CHECK(h != 0);
+
+	char *res = gnparser_parse(h, "Pardosa moesta Banks, 1892");
+	CHECK(res != NULL);
+	CHECK(strstr(res, "Pardosa moesta,Banks 1892") != NULL);
+	FreeMemory(res);
+
+	res = gnparser_parse(h, "Bubo bubo");
+	CHECK(strstr(res, "Bubo bubo") != NULL);
+	FreeMemory(res);
+	gnparser_free(h);
+
+	CHECK(gnparser_parse(h, "Bubo bubo") == NULL);
+}
+
+static void test_options(void) {
+	CHECK(gnparser_new("{not json") == 0);
+
+	gnparser_handle h = gnparser_new(NULL);
+	CHECK(h != 0);
+	char *res = gnparser_parse(h, "Bubo bubo");
+	CHECK(strncmp(res, "4431a0f3", 8) == 0);
+	FreeMemory(res);
+	gnparser_free(h);
+
+	h = gnparser_new("{\"Format\": \"compact\", \"CacheSize\": 100}");
+	res = gnparser_parse(h, "Bubo bubo");
+	CHECK(res[0] == '{');
+	CHECK(strstr(res, "\"details\"") == NULL);
+	FreeMemory(res);
+	gnparser_free(h);
+
+	h = gnparser_new("{\"Format\": \"compact\", \"WithDetails\": true}");
+	res = gnparser_parse(h, "Bubo bubo");
+	CHECK(strstr(res, "\"details\"") != NULL);
+	FreeMemory(res);
+	gnparser_free(h);
+}
+
+static void test_parse_array(void) {
+	enum { size = 1000 };
+	char *names[size];
+	for (int i = 0; i < size; i++) {
+		names[i] = i % 2 ? "Bubo bubo" : "Pardosa moesta Banks, 1892";
+	}
+	names[size - 1] = "Aus bus";
+
+	gnparser_handle h = gnparser_new("{\"Format\": \"csv\", \"BatchSize\": 100}");
+	struct collected c = {0, 1, 0, NULL};
+	int rc = gnparser_parse_array(h, names, size, collect, &c);
+	CHECK(rc == GNPARSER_OK);
+	CHECK(c.count == size);
+	CHECK(c.in_order);
+	CHECK(strstr(c.last, "Aus bus") != NULL);
+	free(c.last);
+
+	struct collected stop = {0, 1, 10, NULL};
+	rc = gnparser_parse_array(h, names, size, collect, &stop);
+	CHECK(rc == GNPARSER_STOPPED);
+	CHECK(stop.count == 10);
+	free(stop.last);
+	gnparser_free(h);
+
+	rc = gnparser_parse_array(h, names, size, collect, &c);
+	CHECK(rc == GNPARSER_BAD_HANDLE);
+}
+
+int main(void) {
+	test_parse();
+	test_options();
+	test_parse_array();
+	if (failures > 0) {
+		fprintf(stderr, "FAIL: %d checks failed\n", failures);
+		return 1;
+	}
+	printf("PASS\n");
+	return 0;
+}
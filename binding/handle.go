@@ -0,0 +1,194 @@
+package main
+
+/*
+	#include <stdint.h>
+	#include <stdlib.h>
+
+	// gnparser_handle identifies a parser created by gnparser_new.
+	// A handle with value 0 is never valid.
+	typedef uintptr_t gnparser_handle;
+
+	// gnparser_callback receives a parsing result of a name with its index
+	// in the input array. The result is freed after the callback returns,
+	// so it has to be copied if it is needed later. user_data is the pointer
+	// given to gnparser_parse_array. A not 0 return value stops parsing.
+	typedef int (*gnparser_callback)(
+		int index, const char *result, void *user_data
+	);
+
+	// Return codes of gnparser_parse_array.
+	#define GNPARSER_OK 0
+	#define GNPARSER_BAD_HANDLE 1
+	#define GNPARSER_STOPPED 2
+	#define GNPARSER_PARSE_ERROR 3
+
+	int gnparser_call(
+		gnparser_callback cb, int index, const char *result, void *user_data
+	);
+*/
+import "C"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// options are settings of a parser given to gnparser_new as JSON. Keys are
+// the same as in the gnparser configuration file.
+type options struct {
+	Format          string
+	JobsNum         int
+	BatchSize       int
+	IgnoreHTMLTags  bool
+	WithDetails     bool
+	WithDedupe      bool
+	WithPublication bool
+	CacheSize       int
+}
+
+// handles keep parsers created by gnparser_new. C code cannot keep
+// pointers to Go memory, so parsers are accessed by their handles.
+var handles = struct {
+	sync.Mutex
+	last    C.gnparser_handle
+	parsers map[C.gnparser_handle]gnparser.GNparser
+}{parsers: make(map[C.gnparser_handle]gnparser.GNparser)}
+
+// errStopped is returned when a callback asks to stop parsing.
+var errStopped = errors.New("stopped by callback")
+
+// gnparser_new creates a parser and returns its handle. The parser keeps
+// its settings, a cache and a pool of parsing engines between calls, so it
+// is much faster than ParseToString for many names. Settings are given
+// as a JSON object, for example
+// `{"Format": "compact", "WithDetails": true, "CacheSize": 10000}`.
+// An empty string or NULL gives default settings. It returns 0 if the
+// settings cannot be read. The parser has to be released by gnparser_free.
+//
+//export gnparser_new
+func gnparser_new(optionsJSON *C.char) C.gnparser_handle {
+	var o options
+	if optionsJSON != nil {
+		s := strings.TrimSpace(C.GoString(optionsJSON))
+		if s != "" {
+			if err := json.Unmarshal([]byte(s), &o); err != nil {
+				return 0
+			}
+		}
+	}
+	opts := []gnparser.Option{
+		gnparser.OptIgnoreHTMLTags(o.IgnoreHTMLTags),
+		gnparser.OptWithDetails(o.WithDetails),
+		gnparser.OptWithDedupe(o.WithDedupe),
+		gnparser.OptWithPublication(o.WithPublication),
+		gnparser.OptCacheSize(o.CacheSize),
+	}
+	if o.Format != "" {
+		opts = append(opts, gnparser.OptFormat(strings.ToLower(o.Format)))
+	}
+	if o.JobsNum > 0 {
+		opts = append(opts, gnparser.OptJobsNum(o.JobsNum))
+	}
+	if o.BatchSize > 0 {
+		opts = append(opts, gnparser.OptBatchSize(o.BatchSize))
+	}
+	gnp := gnparser.New(gnparser.NewConfig(opts...))
+
+	handles.Lock()
+	defer handles.Unlock()
+	handles.last++
+	handles.parsers[handles.last] = gnp
+	return handles.last
+}
+
+// gnparser_free releases a parser created by gnparser_new. The handle
+// cannot be used after that.
+//
+//export gnparser_free
+func gnparser_free(h C.gnparser_handle) {
+	handles.Lock()
+	defer handles.Unlock()
+	delete(handles.parsers, h)
+}
+
+// gnparser_parse parses a name-string with a parser and returns the result
+// in the format of the parser. The result has to be released by FreeMemory.
+// It returns NULL if the handle is not valid.
+//
+//export gnparser_parse
+func gnparser_parse(h C.gnparser_handle, name *C.char) *C.char {
+	gnp, ok := getParser(h)
+	if !ok {
+		return nil
+	}
+	res := gnp.ParseName(C.GoString(name)).Output(gnp.Format())
+	return C.CString(res)
+}
+
+// gnparser_parse_array parses an array of name-strings concurrently and
+// calls the callback for every result in the order of the input. Results
+// are not collected in memory, so it is suitable for large arrays. The
+// callback is called from the thread that called gnparser_parse_array.
+// It returns GNPARSER_OK on success, GNPARSER_BAD_HANDLE if the handle is
+// not valid, GNPARSER_STOPPED if the callback stopped parsing, and
+// GNPARSER_PARSE_ERROR if some names failed to parse. Such names are given
+// to the callback as not parsed.
+//
+//export gnparser_parse_array
+func gnparser_parse_array(
+	h C.gnparser_handle,
+	in **C.char,
+	length C.int,
+	cb C.gnparser_callback,
+	userData unsafe.Pointer,
+) C.int {
+	gnp, ok := getParser(h)
+	if !ok {
+		return C.GNPARSER_BAD_HANDLE
+	}
+	gnp = gnp.ChangeConfig(gnparser.OptWithNoOrder(false))
+	f := gnp.Format()
+	names := goStrings(in, length)
+
+	var idxIn, idxOut int
+	next := func() (string, bool) {
+		if idxIn == len(names) {
+			return "", false
+		}
+		idxIn++
+		return names[idxIn-1], true
+	}
+	err := gnp.ParseIter(context.Background(), next, func(p parsed.Parsed) error {
+		res := C.CString(p.Output(f))
+		defer C.free(unsafe.Pointer(res))
+		rc := C.gnparser_call(cb, C.int(idxOut), res, userData)
+		idxOut++
+		if rc != 0 {
+			return errStopped
+		}
+		return nil
+	})
+	switch {
+	case err == nil:
+		return C.GNPARSER_OK
+	case err == errStopped:
+		return C.GNPARSER_STOPPED
+	default:
+		return C.GNPARSER_PARSE_ERROR
+	}
+}
+
+// getParser returns a parser of a handle.
+func getParser(h C.gnparser_handle) (gnparser.GNparser, bool) {
+	handles.Lock()
+	defer handles.Unlock()
+	gnp, ok := handles.parsers[h]
+	return gnp, ok
+}
@@ -57,21 +57,12 @@ func ParseAryToString(
 	f *C.char,
 	details C.int,
 ) *C.char {
-	names := make([]string, int(length))
+	names := goStrings(in, length)
 
 	opts := []gnparser.Option{
 		gnparser.OptFormat(C.GoString(f)),
 		gnparser.OptWithDetails(int(details) > 0),
 	}
-	start := unsafe.Pointer(in)
-	pointerSize := unsafe.Sizeof(in)
-
-	for i := 0; i < int(length); i++ {
-		// Copy each input string into a Go string and add it to the slice.
-		pointer := (**C.char)(unsafe.Pointer(uintptr(start) + uintptr(i)*pointerSize))
-		name := C.GoString(*pointer)
-		names[i] = name
-	}
 
 	cfg := gnparser.NewConfig(opts...)
 	gnp := gnparser.New(cfg)
@@ -91,4 +82,19 @@ func ParseAryToString(
 	return C.CString(res)
 }
 
+// goStrings copies an array of C strings into a slice of Go strings.
+func goStrings(in **C.char, length C.int) []string {
+	names := make([]string, int(length))
+	start := unsafe.Pointer(in)
+	pointerSize := unsafe.Sizeof(in)
+
+	for i := 0; i < int(length); i++ {
+		// Copy each input string into a Go string and add it to the slice.
+		pointer := (**C.char)(unsafe.Pointer(uintptr(start) + uintptr(i)*pointerSize))
+		name := C.GoString(*pointer)
+		names[i] = name
+	}
+	return names
+}
+
 func main() {}
@@ -38,9 +38,24 @@ type Config struct {
 	// WithNoOrder flag, when true, output and input are in different order.
 	WithNoOrder bool
 
+	// WithDedupe flag, when true, parses every unique name-string of a batch
+	// only once. Results are copied to all positions of the name-string in
+	// the input.
+	WithDedupe bool
+
+	// WithPublication flag, when true, cuts off references to publications,
+	// like ", Sp. Pl. 2: 975. 1753", from the end of name-strings and
+	// gives them in the Publication field of results.
+	WithPublication bool
+
 	// Port to run wer-service.
 	Port int
 
+	// CacheSize sets the maximum number of parsing results kept in a cache.
+	// Repeated name-strings are taken from the cache instead of being
+	// parsed again. If CacheSize is 0, the cache is not used.
+	CacheSize int
+
 	// IsTest can be set to true when parsing functionality is used for tests.
 	// In such cases the `ParserVersion` field is presented as `test_version`
 	// instead of displaying the actual version of `gnparser`.
@@ -130,6 +145,20 @@ func OptWithNoOrder(b bool) Option {
 	}
 }
 
+// OptWithDedupe sets the WithDedupe field.
+func OptWithDedupe(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithDedupe = b
+	}
+}
+
+// OptWithPublication sets the WithPublication field.
+func OptWithPublication(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithPublication = b
+	}
+}
+
 // OptPort sets a port for web-service.
 func OptPort(i int) Option {
 	return func(cfg *Config) {
@@ -137,6 +166,18 @@ func OptPort(i int) Option {
 	}
 }
 
+// OptCacheSize sets the maximum number of results in the parsing cache.
+// Zero disables the cache.
+func OptCacheSize(i int) Option {
+	return func(cfg *Config) {
+		if i < 0 {
+			log.Println("Cache size cannot be negative")
+			return
+		}
+		cfg.CacheSize = i
+	}
+}
+
 // OptIsTest sets a test flag.
 func OptIsTest(b bool) Option {
 	return func(cfg *Config) {
@@ -27,12 +27,15 @@ func TestNewOpts(t *testing.T) {
 	opts := opts()
 	cnf := gnparser.NewConfig(opts...)
 	updt := gnparser.Config{
-		Format:         gnfmt.CompactJSON,
-		JobsNum:        161,
-		BatchSize:      1,
-		IgnoreHTMLTags: true,
-		WithDetails:    true,
-		Port:           8989,
+		Format:          gnfmt.CompactJSON,
+		JobsNum:         161,
+		BatchSize:       1,
+		IgnoreHTMLTags:  true,
+		WithDetails:     true,
+		WithDedupe:      true,
+		WithPublication: true,
+		Port:            8989,
+		CacheSize:       1000,
 	}
 	assert.Equal(t, cnf, updt)
 }
@@ -44,6 +47,9 @@ func opts() []gnparser.Option {
 		gnparser.OptBatchSize(1),
 		gnparser.OptIgnoreHTMLTags(true),
 		gnparser.OptWithDetails(true),
+		gnparser.OptWithDedupe(true),
+		gnparser.OptWithPublication(true),
 		gnparser.OptPort(8989),
+		gnparser.OptCacheSize(1000),
 	}
 }
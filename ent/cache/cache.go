@@ -0,0 +1,122 @@
+// Package cache provides a size-bounded Least Recently Used cache of
+// parsing results. Inputs of parsing are often very repetitive, and the
+// cache allows to skip parsing of name-strings that were seen recently.
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Key identifies a parsing result. Besides the name-string it contains
+// settings that change the result of parsing.
+type Key struct {
+	// Verbatim is the input name-string.
+	Verbatim string
+
+	// WithDetails is true if results contain details.
+	WithDetails bool
+
+	// IgnoreHTMLTags is true if HTML tags and entities are kept intact.
+	IgnoreHTMLTags bool
+
+	// WithPublication is true if publication references are cut off.
+	WithPublication bool
+}
+
+// Stats provides information about the cache usage.
+type Stats struct {
+	// Size is the maximum number of results in the cache.
+	Size int `json:"size"`
+
+	// Len is the current number of results in the cache.
+	Len int `json:"len"`
+
+	// Hits is the number of results found in the cache.
+	Hits uint64 `json:"hits"`
+
+	// Misses is the number of results not found in the cache.
+	Misses uint64 `json:"misses"`
+}
+
+// entry is an element of the cache.
+type entry struct {
+	key Key
+	val parsed.Parsed
+}
+
+// lru implements Cache interface.
+type lru struct {
+	// hits and misses go first to be 64-bit aligned for atomic operations.
+	hits   uint64
+	misses uint64
+
+	sync.Mutex
+	size  int
+	ll    *list.List
+	items map[Key]*list.Element
+}
+
+// New creates a cache that keeps up to `size` parsing results.
+// Results returned by the cache are shared between callers and should
+// not be modified.
+func New(size int) Cache {
+	if size < 1 {
+		size = 1
+	}
+	return &lru{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[Key]*list.Element, size),
+	}
+}
+
+// Get returns a parsing result saved for a key, and true if the key
+// was found.
+func (c *lru) Get(k Key) (parsed.Parsed, bool) {
+	c.Lock()
+	el, ok := c.items[k]
+	if !ok {
+		c.Unlock()
+		atomic.AddUint64(&c.misses, 1)
+		return parsed.Parsed{}, false
+	}
+	c.ll.MoveToFront(el)
+	res := el.Value.(*entry).val
+	c.Unlock()
+	atomic.AddUint64(&c.hits, 1)
+	return res, true
+}
+
+// Set saves a parsing result for a key.
+func (c *lru) Set(k Key, p parsed.Parsed) {
+	c.Lock()
+	defer c.Unlock()
+	if el, ok := c.items[k]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*entry).val = p
+		return
+	}
+	c.items[k] = c.ll.PushFront(&entry{key: k, val: p})
+	if c.ll.Len() > c.size {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*entry).key)
+	}
+}
+
+// Stats returns statistics of the cache usage.
+func (c *lru) Stats() Stats {
+	c.Lock()
+	l := c.ll.Len()
+	c.Unlock()
+	return Stats{
+		Size:   c.size,
+		Len:    l,
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+	}
+}
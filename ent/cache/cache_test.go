@@ -0,0 +1,63 @@
+package cache_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/gnames/gnparser/ent/cache"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSet(t *testing.T) {
+	c := cache.New(2)
+	k1 := cache.Key{Verbatim: "Bubo bubo"}
+	k2 := cache.Key{Verbatim: "Bubo bubo", WithDetails: true}
+	k3 := cache.Key{Verbatim: "Pomatomus"}
+
+	_, ok := c.Get(k1)
+	assert.False(t, ok)
+
+	c.Set(k1, parsed.Parsed{Verbatim: "Bubo bubo", Cardinality: 2})
+	c.Set(k2, parsed.Parsed{Verbatim: "Bubo bubo", Cardinality: 3})
+	p, ok := c.Get(k1)
+	assert.True(t, ok)
+	assert.Equal(t, 2, p.Cardinality)
+	p, ok = c.Get(k2)
+	assert.True(t, ok)
+	assert.Equal(t, 3, p.Cardinality)
+
+	// k1 is the least recently used now.
+	c.Set(k3, parsed.Parsed{Verbatim: "Pomatomus"})
+	_, ok = c.Get(k1)
+	assert.False(t, ok)
+	_, ok = c.Get(k2)
+	assert.True(t, ok)
+	_, ok = c.Get(k3)
+	assert.True(t, ok)
+
+	stats := c.Stats()
+	assert.Equal(t, cache.Stats{Size: 2, Len: 2, Hits: 4, Misses: 2}, stats)
+}
+
+func TestConcurrent(t *testing.T) {
+	c := cache.New(50)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				k := cache.Key{Verbatim: fmt.Sprintf("Aus bus %d", (i*j)%100)}
+				if _, ok := c.Get(k); !ok {
+					c.Set(k, parsed.Parsed{Verbatim: k.Verbatim})
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	stats := c.Stats()
+	assert.Equal(t, 50, stats.Len)
+	assert.Equal(t, uint64(8000), stats.Hits+stats.Misses)
+}
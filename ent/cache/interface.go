@@ -0,0 +1,16 @@
+package cache
+
+import "github.com/gnames/gnparser/ent/parsed"
+
+// Cache keeps parsing results of recently used name-strings. It is safe
+// for concurrent use.
+type Cache interface {
+	// Get returns a parsing result saved for a key, and true if the key
+	// was found.
+	Get(Key) (parsed.Parsed, bool)
+	// Set saves a parsing result for a key. If the cache is full, the least
+	// recently used result is removed.
+	Set(Key, parsed.Parsed)
+	// Stats returns statistics of the cache usage.
+	Stats() Stats
+}
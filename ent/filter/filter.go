@@ -0,0 +1,156 @@
+// Package filter selects parsing results according to their quality,
+// cardinality, warnings or annotations.
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Filter keeps criteria for selection of parsing results. A result
+// has to satisfy all set criteria to be selected.
+type Filter struct {
+	// MinQuality is the minimal allowed parsing quality.
+	MinQuality int
+
+	// MaxQuality is the maximal allowed parsing quality.
+	MaxQuality int
+
+	// Cardinality is a list of allowed cardinalities. If it is empty, any
+	// cardinality is allowed.
+	Cardinality []int
+
+	// Warnings is a list of warnings. If it is not empty, a result must have
+	// at least one of them.
+	Warnings []parsed.Warning
+
+	// Hybrid requires a result to be a hybrid.
+	Hybrid bool
+
+	// Surrogate requires a result to be a surrogate.
+	Surrogate bool
+
+	// Bacteria requires a result to be a bacterial name.
+	Bacteria bool
+}
+
+// New creates a Filter that accepts all results.
+func New() Filter {
+	return Filter{MinQuality: 0, MaxQuality: 4}
+}
+
+// IsEmpty returns true if the filter accepts all results.
+func (f Filter) IsEmpty() bool {
+	return f.MinQuality <= 0 && f.MaxQuality >= 4 &&
+		len(f.Cardinality) == 0 && len(f.Warnings) == 0 &&
+		!f.Hybrid && !f.Surrogate && !f.Bacteria
+}
+
+// Match returns true if a parsing result satisfies the filter.
+func (f Filter) Match(p parsed.Parsed) bool {
+	if p.ParseQuality < f.MinQuality || p.ParseQuality > f.MaxQuality {
+		return false
+	}
+	if len(f.Cardinality) > 0 && !hasInt(f.Cardinality, p.Cardinality) {
+		return false
+	}
+	if len(f.Warnings) > 0 && !hasWarning(f.Warnings, p.QualityWarnings) {
+		return false
+	}
+	if f.Hybrid && p.Hybrid == nil {
+		return false
+	}
+	if f.Surrogate && p.Surrogate == nil {
+		return false
+	}
+	if f.Bacteria && p.Bacteria == nil {
+		return false
+	}
+	return true
+}
+
+// Split separates results that satisfy the filter from the rest.
+func (f Filter) Split(ps []parsed.Parsed) ([]parsed.Parsed, []parsed.Parsed) {
+	if f.IsEmpty() {
+		return ps, nil
+	}
+	match := make([]parsed.Parsed, 0, len(ps))
+	var reject []parsed.Parsed
+	for i := range ps {
+		if f.Match(ps[i]) {
+			match = append(match, ps[i])
+		} else {
+			reject = append(reject, ps[i])
+		}
+	}
+	return match, reject
+}
+
+// Validate returns an error if the filter criteria are impossible.
+func (f Filter) Validate() error {
+	if f.MinQuality < 0 || f.MaxQuality > 4 || f.MinQuality > f.MaxQuality {
+		return fmt.Errorf(
+			"quality range %d-%d is not within 0-4", f.MinQuality, f.MaxQuality,
+		)
+	}
+	return nil
+}
+
+// ParseCardinality converts a comma-separated list of numbers, for
+// example "2,3", into a slice of cardinalities.
+func ParseCardinality(s string) ([]int, error) {
+	var res []int
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 0 || i > 4 {
+			return nil, fmt.Errorf("cardinality '%s' is not a number from 0 to 4", v)
+		}
+		res = append(res, i)
+	}
+	return res, nil
+}
+
+// ParseWarnings converts a comma-separated list of warning codes, for
+// example "TAIL,AUTH_EX", into a slice of warnings.
+func ParseWarnings(s string) ([]parsed.Warning, error) {
+	var res []parsed.Warning
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		w, err := parsed.NewWarning(v)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, w)
+	}
+	return res, nil
+}
+
+func hasInt(is []int, i int) bool {
+	for _, v := range is {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
+
+func hasWarning(ws []parsed.Warning, qws []parsed.QualityWarning) bool {
+	for _, w := range ws {
+		for _, qw := range qws {
+			if qw.Warning == w {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/filter"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig())
+	res := gnp.ParseNames([]string{
+		"Bubo bubo",                 // 0: binomial, quality 1
+		"Bubo",                      // 1: uninomial
+		"Aus bus something 1234",    // 2: tail
+		"Aus × bus",                 // 3: hybrid
+		"Aus cf. bus",               // 4: surrogate
+		"Salmonella enterica",       // 5: bacteria
+		"Bubo bubo Smith y Jones",   // 6: quality 2
+		"Plantago major var. major", // 7: trinomial
+	})
+
+	data := []struct {
+		msg   string
+		flt   filter.Filter
+		match []int
+	}{
+		{"empty", filter.New(), []int{0, 1, 2, 3, 4, 5, 6, 7}},
+		{"max quality", filter.Filter{MaxQuality: 1}, []int{0, 1, 5, 7}},
+		{"binomials of quality 2",
+			filter.Filter{MinQuality: 2, MaxQuality: 2, Cardinality: []int{2}},
+			[]int{3, 6}},
+		{"tail",
+			filter.Filter{MaxQuality: 4, Warnings: []parsed.Warning{parsed.TailWarn}},
+			[]int{2}},
+		{"hybrid", filter.Filter{MaxQuality: 4, Hybrid: true}, []int{3}},
+		{"surrogate", filter.Filter{MaxQuality: 4, Surrogate: true}, []int{4}},
+		{"bacteria", filter.Filter{MaxQuality: 4, Bacteria: true}, []int{5}},
+	}
+
+	for _, v := range data {
+		var match []int
+		for i := range res {
+			if v.flt.Match(res[i]) {
+				match = append(match, i)
+			}
+		}
+		assert.Equal(t, v.match, match, v.msg)
+		m, r := v.flt.Split(res)
+		assert.Equal(t, len(res), len(m)+len(r), v.msg)
+	}
+}
+
+func TestParse(t *testing.T) {
+	card, err := filter.ParseCardinality("2, 3")
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 3}, card)
+	_, err = filter.ParseCardinality("two")
+	assert.NotNil(t, err)
+
+	ws, err := filter.ParseWarnings("tail,AUTH_EX,Year with period")
+	assert.Nil(t, err)
+	assert.Equal(t,
+		[]parsed.Warning{parsed.TailWarn, parsed.AuthExWarn, parsed.YearDotWarn},
+		ws,
+	)
+	_, err = filter.ParseWarnings("NOT_A_WARNING")
+	assert.NotNil(t, err)
+
+	assert.Nil(t, filter.New().Validate())
+	assert.NotNil(t, filter.Filter{MinQuality: 3, MaxQuality: 2}.Validate())
+}
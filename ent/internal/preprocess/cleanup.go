@@ -3,6 +3,7 @@ package preprocess
 import (
 	"bytes"
 	"io"
+	"strings"
 	"sync"
 
 	"golang.org/x/net/html"
@@ -50,6 +51,9 @@ func cleanupWorker(in <-chan string, out chan<- *CleanupResult,
 // tags removed and html entities escaped. It does keep all uncommon tags
 // intact to let parser deal with them.
 func StripTags(s string) string {
+	if !strings.ContainsAny(s, "<&") {
+		return s
+	}
 	var buff bytes.Buffer
 	r := bytes.NewReader([]byte(s))
 
@@ -17,7 +17,7 @@ var notesRe = regexp.MustCompile(
 	`(?i)\s+(species\s+group|species\s+complex|group|author)\b.*$`,
 )
 var taxonConceptsRe1 = regexp.MustCompile(
-	`(?i)\s+(sero(var|type)|sensu|auct|sec|near|str)\.?\b.*$`,
+	`(?i)\s+(sensu|auct|sec|near)\.?\b.*$`,
 )
 var taxonConceptsRe2 = regexp.MustCompile(
 	`(,\s*|\s+)(\(?s\.\s?s\.|\(?s\.\s?l\.|\(?s\.\s?str\.|\(?s\.\s?lat\.).*$`,
@@ -34,10 +34,40 @@ var lastWordJunkRe = regexp.MustCompile(
 		`|sensu|new|non|nec|nudum|ssp\.?` +
 		`|subsp|subgen|hybrid)\??\s*$`,
 )
+
+// incertaeSedisRe finds 'incertae sedis' qualifier at the end of a name. Such
+// names are parsed as open nomenclature, while 'incertae sedis' anywhere else
+// makes a string not parseable.
+var incertaeSedisRe = regexp.MustCompile(
+	`(?i)\S\s+(incertae\s+sedis|inc\.\s*sed\.)\s*$`,
+)
+
+// candidatusRe finds 'Candidatus' at the start of a name. Such names are
+// parsed as provisional bacterial names.
+var candidatusRe = regexp.MustCompile(`^Candidatus\s+\S`)
 var stopWordsRe = regexp.MustCompile(
 	`\s+(of[\W_]|\(?ht\.?\W|\(?hort\.?\W|spec\.|nov\s+spec|cv\.?\W).*$`,
 )
 
+// virusLikeNames are genera with epithets that look like virus names.
+var virusLikeNames = map[string]string{
+	"Aspilota":      "vector",
+	"Ceylonesmus":   "vector",
+	"Cryptops":      "vector",
+	"Culex":         "vector",
+	"Dasyproctus":   "cevirus",
+	"Desmoxytes":    "vector",
+	"Dicathais":     "vector",
+	"Euragallia":    "prion",
+	"Exochus":       "virus",
+	"Hilara":        "vector",
+	"Microgoneplax": "prion",
+	"Neoaemula":     "vector",
+	"Ophion":        "virus",
+	"Psenulus":      "trevirus",
+	"Tidabius":      "vector",
+}
+
 // Preprocessor structure keeps state of the preprocessor results.
 type Preprocessor struct {
 	Virus       bool
@@ -66,7 +96,14 @@ func Preprocess(bs []byte) *Preprocessor {
 		pr.NoParse = true
 		return pr
 	}
-	pr.NoParse = NoParse(bs[0:i])
+	start, end := 0, i
+	if loc := candidatusRe.FindIndex(bs[0:i]); loc != nil {
+		start = loc[1] - 1
+	}
+	if loc := incertaeSedisRe.FindIndex(bs[start:i]); loc != nil {
+		end = start + loc[0] + 1
+	}
+	pr.NoParse = NoParse(bs[start:end])
 	if pr.NoParse {
 		return pr
 	}
@@ -107,28 +144,11 @@ func Preprocess(bs []byte) *Preprocessor {
 //    Psenulus trevirus Leclercq, 1961
 //    Tidabius vector Chamberlin, 1931
 func VirusLikeName(name string) bool {
-	names := map[string]string{
-		"Aspilota":      "vector",
-		"Ceylonesmus":   "vector",
-		"Cryptops":      "vector",
-		"Culex":         "vector",
-		"Dasyproctus":   "cevirus",
-		"Desmoxytes":    "vector",
-		"Dicathais":     "vector",
-		"Euragallia":    "prion",
-		"Exochus":       "virus",
-		"Hilara":        "vector",
-		"Microgoneplax": "prion",
-		"Neoaemula":     "vector",
-		"Ophion":        "virus",
-		"Psenulus":      "trevirus",
-		"Tidabius":      "vector",
-	}
 	words := strings.Fields(name)
 	if len(words) < 2 {
 		return false
 	}
-	if epithet, ok := names[words[0]]; ok {
+	if epithet, ok := virusLikeNames[words[0]]; ok {
 		for _, w := range words[1:] {
 			if strings.HasPrefix(w, epithet) {
 				return true
@@ -208,6 +208,41 @@ func TestPreprocess(t *testing.T) {
 		}
 	})
 
+	t.Run("IncertaeSedis", func(t *testing.T) {
+		data := []struct {
+			msg     string
+			name    string
+			noParse bool
+		}{
+			{"After uninomial", "Hipponicidae incertae sedis", false},
+			{"After binomial", "Homo sapiens inc.sed.", false},
+			{"Alone", "Incertae sedis", true},
+			{"In the middle", "Hipponicidae incertae sedis Smith", true},
+			{"Other noparse", "Not Homo incertae sedis", true},
+		}
+		for _, v := range data {
+			res := ppr.Preprocess([]byte(v.name))
+			assert.Equal(t, res.NoParse, v.noParse, v.msg)
+		}
+	})
+
+	t.Run("Candidatus", func(t *testing.T) {
+		data := []struct {
+			msg     string
+			name    string
+			noParse bool
+		}{
+			{"Binomial", "Candidatus Liberibacter asiaticus", false},
+			{"Uninomial", "Candidatus Halobonum", false},
+			{"Alone", "Candidatus", true},
+			{"Other noparse", "Candidatus Amesbacteria bacterium GW2011", true},
+		}
+		for _, v := range data {
+			res := ppr.Preprocess([]byte(v.name))
+			assert.Equal(t, res.NoParse, v.noParse, v.msg)
+		}
+	})
+
 	t.Run("Annotations", func(t *testing.T) {
 		data := []struct {
 			msg  string
@@ -27,6 +27,19 @@ const (
 	HybridFormulaAnnot
 	// NothoHybridAnnot is a hybrid with notho- 'ranks'.
 	NothoHybridAnnot
+	// OpenNomenclatureAnnot is a name with an open nomenclature qualifier
+	// that is not covered by comparison or approximation (cf. or aff. before
+	// infraspecific epithets, sp. indet., incertae sedis etc.).
+	OpenNomenclatureAnnot
+	// GraftChimaeraFormulaAnnot is a graft-chimaera written as a formula of
+	// its components joined by '+'.
+	GraftChimaeraFormulaAnnot
+	// NamedGraftChimaeraAnnot is a graft-chimaera with a name that starts
+	// with '+'.
+	NamedGraftChimaeraAnnot
+	// PhraseNameAnnot is an informal phrase name used by Australian herbaria
+	// for taxa that are not formally described yet.
+	PhraseNameAnnot
 )
 
 var annotMap = map[Annotation]string{
@@ -39,6 +52,11 @@ var annotMap = map[Annotation]string{
 	NamedHybridAnnot:   "NAMED_HYBRID",
 	HybridFormulaAnnot: "HYBRID_FORMULA",
 	NothoHybridAnnot:   "NOTHO_HYBRID",
+
+	OpenNomenclatureAnnot:     "OPEN_NOMENCLATURE",
+	GraftChimaeraFormulaAnnot: "GRAFT_CHIMAERA_FORMULA",
+	NamedGraftChimaeraAnnot:   "NAMED_GRAFT_CHIMAERA",
+	PhraseNameAnnot:           "PHRASE_NAME",
 }
 
 var annotStrMap = func() map[string]Annotation {
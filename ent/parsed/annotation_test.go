@@ -17,6 +17,7 @@ func TestStringAnnot(t *testing.T) {
 		{parsed.ComparisonAnnot, "COMPARISON"},
 		{parsed.ApproximationAnnot, "APPROXIMATION"},
 		{parsed.SurrogateAnnot, "SURROGATE"},
+		{parsed.OpenNomenclatureAnnot, "OPEN_NOMENCLATURE"},
 	}
 
 	for i := range data {
@@ -0,0 +1,40 @@
+package parsed
+
+import (
+	"strconv"
+
+	"github.com/gnames/gnfmt"
+)
+
+// Counted is a parsing result of a unique name-string together with the
+// number of its occurrences in the input.
+type Counted struct {
+	Parsed
+	// Count is the number of occurrences of the name-string in the input.
+	Count int `json:"count"`
+}
+
+// Output creates a JSON or CSV representation of Counted results.
+func (c Counted) Output(f gnfmt.Format) string {
+	switch f {
+	case gnfmt.CSV:
+		return c.Parsed.csvOutput() + "," + strconv.Itoa(c.Count)
+	case gnfmt.CompactJSON:
+		return c.jsonOutput(false)
+	case gnfmt.PrettyJSON:
+		return c.jsonOutput(true)
+	default:
+		return "N/A"
+	}
+}
+
+// HeaderCountedCSV returns the CSV header for output of unique names.
+func HeaderCountedCSV() string {
+	return HeaderCSV() + ",Count"
+}
+
+func (c Counted) jsonOutput(pretty bool) string {
+	enc := gnfmt.GNjson{Pretty: pretty}
+	res, _ := enc.Encode(c)
+	return string(res)
+}
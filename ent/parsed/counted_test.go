@@ -0,0 +1,20 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountedOutput(t *testing.T) {
+	c := parsed.Counted{
+		Parsed: parsed.Parsed{Verbatim: "Bubo bubo", Cardinality: 2},
+		Count:  3,
+	}
+	assert.Equal(t, ",Bubo bubo,2,,,,,,0,3", c.Output(gnfmt.CSV))
+	assert.Contains(t, c.Output(gnfmt.CompactJSON), `"verbatim":"Bubo bubo"`)
+	assert.Contains(t, c.Output(gnfmt.CompactJSON), `"count":3`)
+	assert.Equal(t, parsed.HeaderCSV()+",Count", parsed.HeaderCountedCSV())
+}
@@ -11,6 +11,8 @@ type Uninomial struct {
 	Parent string `json:"parent,omitempty"`
 	// Authorship of the uninomial.
 	Authorship *Authorship `json:"authorship,omitempty"`
+	// Uncertain is true if the uninomial is marked by a question mark.
+	Uncertain bool `json:"uncertain,omitempty"`
 }
 
 // Species are details for binomial names with cardinality 2.
@@ -23,6 +25,11 @@ type Species struct {
 	Species string `json:"species"`
 	// Authorship of the binomial.
 	Authorship *Authorship `json:"authorship,omitempty"`
+	// GenusUncertain is true if the genus is marked by a question mark.
+	GenusUncertain bool `json:"genusUncertain,omitempty"`
+	// SpeciesUncertain is true if the specific epithet is marked by
+	// a question mark.
+	SpeciesUncertain bool `json:"speciesUncertain,omitempty"`
 }
 
 // Infraspecies are details for names with cardinality higher than 2.
@@ -42,6 +49,8 @@ type InfraspeciesElem struct {
 	Rank string `json:"rank,omitempty"`
 	// Authorship of the infraspecific epithet.
 	Authorship *Authorship `json:"authorship,omitempty"`
+	// Uncertain is true if the epithet is marked by a question mark.
+	Uncertain bool `json:"uncertain,omitempty"`
 }
 
 // Comparison are details for a surrogate comparison name.
@@ -70,6 +79,111 @@ type Approximation struct {
 	Ignored string `json:"ignored,omitempty"`
 }
 
+// QualifierType is a kind of an open nomenclature qualifier.
+type QualifierType string
+
+const (
+	// ComparisonQualifier means that a specimen should be compared with
+	// a taxon (cf.).
+	ComparisonQualifier QualifierType = "COMPARISON"
+	// AffinityQualifier means that a specimen is related to a taxon, but
+	// probably is a different one (aff.).
+	AffinityQualifier QualifierType = "AFFINITY"
+	// NearQualifier means that a specimen is close to a taxon (nr.).
+	NearQualifier QualifierType = "NEAR"
+	// IndeterminateQualifier means that a specimen cannot be identified
+	// further (indet., sp. indet., gen. et sp. indet.).
+	IndeterminateQualifier QualifierType = "INDETERMINATE"
+	// UncertainQualifier means a species of uncertain identity (sp. inc.).
+	UncertainQualifier QualifierType = "UNCERTAIN"
+	// IncertaeSedisQualifier means a taxon with uncertain placement
+	// (incertae sedis, inc. sed.).
+	IncertaeSedisQualifier QualifierType = "INCERTAE_SEDIS"
+)
+
+// QualifierPosition is the element of a name an open nomenclature
+// qualifier applies to.
+type QualifierPosition string
+
+const (
+	// GenusPosition is a qualifier of a genus, or of a higher taxon.
+	GenusPosition QualifierPosition = "GENUS"
+	// SpeciesPosition is a qualifier of a specific epithet.
+	SpeciesPosition QualifierPosition = "SPECIES"
+	// InfraspeciesPosition is a qualifier of an infraspecific epithet.
+	InfraspeciesPosition QualifierPosition = "INFRASPECIES"
+)
+
+// OpenNomenclature are details for a surrogate name with an open
+// nomenclature qualifier.
+type OpenNomenclature struct {
+	// Genus is the genus of a name, or a higher taxon.
+	Genus string `json:"genus"`
+	// Species is a specific epithet of a name.
+	Species string `json:"species,omitempty"`
+	// SpeciesAuthorship the authorship of Species.
+	SpeciesAuthorship *Authorship `json:"authorship,omitempty"`
+	// Infraspecies is an infraspecific epithet of a name.
+	Infraspecies *InfraspeciesElem `json:"infraspecies,omitempty"`
+	// Qualifier is the normalized qualifier, for example "aff.".
+	Qualifier string `json:"qualifier"`
+	// QualifierType is the kind of the qualifier.
+	QualifierType QualifierType `json:"qualifierType"`
+	// Position is the element of the name the qualifier applies to.
+	Position QualifierPosition `json:"position"`
+}
+
+// PhraseName are details for an informal phrase name, for example
+// "Prostanthera sp. Somersbey (B.J.Conn 4024) NSW Herbarium".
+type PhraseName struct {
+	// Genus is the genus of a name.
+	Genus string `json:"genus"`
+	// Rank is the rank of the phrase, usually "sp.".
+	Rank string `json:"rank"`
+	// Phrase distinguishes the taxon, usually it is a locality.
+	Phrase string `json:"phrase"`
+	// Collector of the voucher specimen.
+	Collector string `json:"collector"`
+	// Voucher is the collecting number of the voucher specimen.
+	Voucher string `json:"voucher"`
+	// Herbarium that is responsible for the phrase name.
+	Herbarium string `json:"herbarium,omitempty"`
+}
+
+// BacterialDetails are details that are specific to names of bacteria.
+type BacterialDetails struct {
+	// Candidatus is true for provisional names that start with
+	// "Candidatus", for example "Candidatus Liberibacter asiaticus".
+	Candidatus bool `json:"candidatus,omitempty"`
+	// Designations are infrasubspecific designations like serovars or
+	// biovars.
+	Designations []Designation `json:"designations,omitempty"`
+	// Strains are strains of a bacterium given after the name.
+	Strains []Strain `json:"strains,omitempty"`
+}
+
+// Designation is an infrasubspecific designation of a bacterium, for
+// example "serovar Typhimurium".
+type Designation struct {
+	// Rank of the designation, for example "serovar" or "biovar".
+	Rank string `json:"rank"`
+	// Value of the designation.
+	Value string `json:"value"`
+}
+
+// Strain is a strain of a bacterium, for example "ATCC 25922" or
+// "str. K-12".
+type Strain struct {
+	// Value is the normalized strain without the type strain marker.
+	Value string `json:"value"`
+	// Collection is the acronym of a culture collection that keeps
+	// the strain, for example "ATCC" or "DSM".
+	Collection string `json:"collection,omitempty"`
+	// TypeStrain is true if the strain is marked as a type strain
+	// (for example "DSM 30083T").
+	TypeStrain bool `json:"typeStrain,omitempty"`
+}
+
 // DetailsHybridFormula are details for a hybrid formula names.
 type DetailsHybridFormula struct {
 	HybridFormula []Details `json:"hybridFormula"`
@@ -122,3 +236,22 @@ type DetailsApproximation struct {
 
 // isDetails implements Details interface.
 func (DetailsApproximation) isDetails() {}
+
+// DetailsOpenNomenclature are details for surrogate names with open
+// nomenclature qualifiers.
+type DetailsOpenNomenclature struct {
+	// OpenNomenclature details.
+	OpenNomenclature OpenNomenclature `json:"openNomenclature"`
+}
+
+// isDetails implements Details interface.
+func (DetailsOpenNomenclature) isDetails() {}
+
+// DetailsPhraseName are details for informal phrase names.
+type DetailsPhraseName struct {
+	// PhraseName details.
+	PhraseName PhraseName `json:"phraseName"`
+}
+
+// isDetails implements Details interface.
+func (DetailsPhraseName) isDetails() {}
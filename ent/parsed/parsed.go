@@ -47,14 +47,22 @@ type Parsed struct {
 	// This authorship provided outside of Details belongs to
 	// the most fine-grained element of a name.
 	Authorship *Authorship `json:"authorship,omitempty"`
+	// Publication is a reference to the publication of a name, cut off
+	// from the end of the name-string. It is provided only if
+	// config.WithPublication is true.
+	Publication *Publication `json:"publication,omitempty"`
 	// Bacteria is not nil if the input name has a genus
-	// that is registered as bacterial. Possible
-	// values are "maybe" - if the genus has homonyms in other groups
-	// and "yes" if GNparser dictionary does not detect any homonyms
+	// that is registered as bacterial, or if the name starts with
+	// "Candidatus". Possible values are "maybe" - if the genus has
+	// homonyms in other groups and "yes" if GNparser dictionary does not
+	// detect any homonyms
 	//
-	// The bacterial names often contain strain information which are
-	// not parseable and are placed into the "tail" field.
+	// The bacterial names often contain strains and infrasubspecific
+	// designations (serovar, biovar). They are parsed into BacterialDetails.
 	Bacteria *tb.Tribool `json:"bacteria,omitempty"`
+	// Extinct is true if a name or some of its elements are marked as
+	// extinct by a dagger "†", or by "(fossil)" after the name.
+	Extinct bool `json:"extinct,omitempty"`
 	// Virus is set to true in case if name is not parsed, and probably
 	// belongs to a wide variety of sub-cellular entities like
 	//
@@ -94,6 +102,10 @@ type Parsed struct {
 	Tail string `json:"tail,omitempty"`
 	// Details contain more fine-grained information about parsed name.
 	Details Details `json:"details,omitempty"`
+	// BacterialDetails contain Candidatus status, infrasubspecific
+	// designations and strains of a bacterial name. They are provided
+	// only if details are requested.
+	BacterialDetails *BacterialDetails `json:"bacterialDetails,omitempty"`
 	// Words contain description of every parsed word of a name.
 	Words []Word `json:"words,omitempty"`
 	// VerbatimID is a UUID v5 generated from the verbatim value of the
@@ -153,6 +165,9 @@ type Authorship struct {
 	// Combination is an AuthGroup that contains authors of new combination,
 	// rank etc.
 	Combination *AuthGroup `json:"combinationAuth,omitempty"`
+	// Corrig is true if the authorship is followed by "corrig." that
+	// marks a corrected spelling of a bacterial name.
+	Corrig bool `json:"corrig,omitempty"`
 }
 
 // AuthGroup are provided only if config.WithDetails is true. Group of
@@ -176,6 +191,11 @@ type AuthGroup struct {
 	// authors, that sometimes appear in scientific names after "emend."
 	// qualifier.
 	EmendAuthors *Authors `json:"emendAuthors,omitempty"`
+	// InAuthors provided only if "with_details=true" Authors of a
+	// publication where the name was published, they appear after "in"
+	// qualifier. If the group has no year of its own, the year of
+	// InAuthors is used.
+	InAuthors *Authors `json:"inAuthors,omitempty"`
 }
 
 // Authors contains information about authors and a year of publication.
@@ -198,6 +218,25 @@ type Year struct {
 	IsApproximate bool `json:"isApproximate,omitempty"`
 }
 
+// Publication is a bibliographic reference that follows the authorship,
+// for example ", Sp. Pl. 2: 975. 1753" in
+// "Carex flava L., Sp. Pl. 2: 975. 1753".
+type Publication struct {
+	// Verbatim is the reference as it was cut off from the name-string.
+	Verbatim string `json:"verbatim"`
+	// Title is an abbreviated title of a book or a journal.
+	Title string `json:"title,omitempty"`
+	// Volume is a volume of the publication.
+	Volume string `json:"volume,omitempty"`
+	// Pages are a page or a range of pages.
+	Pages string `json:"pages,omitempty"`
+	// Plates are a plate or a range of plates.
+	Plates string `json:"plates,omitempty"`
+	// Year is a year of the publication. It is taken from the authorship,
+	// if the reference gives only pages after the year.
+	Year string `json:"year,omitempty"`
+}
+
 // Word represents a parsed word and its meaning in the name-string.
 type Word struct {
 	// Verbatim is unmodified value of a word.
@@ -210,4 +249,7 @@ type Word struct {
 	Start int `json:"start"`
 	// End is the index of the end of a word.
 	End int `json:"end"`
+	// Uncertain is true if a question mark shows that the identification
+	// of the word is uncertain, for example "Abies ?alba".
+	Uncertain bool `json:"uncertain,omitempty"`
 }
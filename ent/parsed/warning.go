@@ -2,6 +2,7 @@ package parsed
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 )
 
@@ -57,6 +58,11 @@ const (
 	YearQuestionWarn
 	YearRangeWarn
 	YearSqBracketsWarn
+	EpithetQuestionWarn
+	GraftChimaeraFormulaWarn
+	GraftChimaeraNamedWarn
+	HybridFormulaParensWarn
+	PhraseNameWarn
 )
 
 var warningMap = map[Warning]string{
@@ -107,6 +113,12 @@ var warningMap = map[Warning]string{
 	YearQuestionWarn:                "Year with question mark",
 	YearRangeWarn:                   "Years range",
 	YearSqBracketsWarn:              "Year with square brackets",
+
+	EpithetQuestionWarn:      "Epithet with question mark",
+	GraftChimaeraFormulaWarn: "Graft-chimaera formula",
+	GraftChimaeraNamedWarn:   "Named graft-chimaera",
+	HybridFormulaParensWarn:  "Hybrid formula with parent in parentheses",
+	PhraseNameWarn:           "Phrase name",
 }
 
 var warningStrMap = func() map[string]Warning {
@@ -117,6 +129,72 @@ var warningStrMap = func() map[string]Warning {
 	return res
 }()
 
+// warningCodeMap provides short codes for warnings. Codes are used to
+// select warnings in filters.
+var warningCodeMap = map[Warning]string{
+	TailWarn:                        "TAIL",
+	ApostrOtherWarn:                 "APOSTR_OTHER",
+	AuthAmbiguousFiliusWarn:         "AUTH_AMBIGUOUS_FILIUS",
+	AuthDoubleParensWarn:            "AUTH_DOUBLE_PARENS",
+	AuthExWarn:                      "AUTH_EX",
+	AuthExWithDotWarn:               "AUTH_EX_WITH_DOT",
+	AuthEmendWarn:                   "AUTH_EMEND",
+	AuthEmendWithoutDotWarn:         "AUTH_EMEND_WITHOUT_DOT",
+	AuthMissingOneParensWarn:        "AUTH_MISSING_ONE_PARENS",
+	AuthQuestionWarn:                "AUTH_QUESTION",
+	AuthShortWarn:                   "AUTH_SHORT",
+	AuthUnknownWarn:                 "AUTH_UNKNOWN",
+	AuthUpperCaseWarn:               "AUTH_UPPER_CASE",
+	BacteriaMaybeWarn:               "BACTERIA_MAYBE",
+	BotanyAuthorNotSubgenWarn:       "BOTANY_AUTHOR_NOT_SUBGEN",
+	CanonicalApostropheWarn:         "CANONICAL_APOSTROPHE",
+	CapWordQuestionWarn:             "CAP_WORD_QUESTION",
+	CharBadWarn:                     "CHAR_BAD",
+	GenusAbbrWarn:                   "GENUS_ABBR",
+	GenusUpperCharAfterDash:         "GENUS_UPPER_CHAR_AFTER_DASH",
+	GreekLetterInRank:               "GREEK_LETTER_IN_RANK",
+	HTMLTagsEntitiesWarn:            "HTML_TAGS_ENTITIES",
+	HybridCharNoSpaceWarn:           "HYBRID_CHAR_NO_SPACE",
+	HybridFormulaWarn:               "HYBRID_FORMULA",
+	HybridFormulaIncompleteWarn:     "HYBRID_FORMULA_INCOMPLETE",
+	HybridFormulaProbIncompleteWarn: "HYBRID_FORMULA_PROB_INCOMPLETE",
+	HybridNamedWarn:                 "HYBRID_NAMED",
+	NameApproxWarn:                  "NAME_APPROX",
+	NameComparisonWarn:              "NAME_COMPARISON",
+	RankUncommonWarn:                "RANK_UNCOMMON",
+	SpaceMultipleWarn:               "SPACE_MULTIPLE",
+	SpaceNonStandardWarn:            "SPACE_NON_STANDARD",
+	SpanishAndAsSeparator:           "SPANISH_AND_AS_SEPARATOR",
+	SpeciesNumericWarn:              "SPECIES_NUMERIC",
+	SubgenusAbbrWarn:                "SUBGENUS_ABBR",
+	SuperspeciesWarn:                "SUPERSPECIES",
+	UTF8ConvBadWarn:                 "UTF8_CONV_BAD",
+	UninomialComboWarn:              "UNINOMIAL_COMBO",
+	WhiteSpaceTrailWarn:             "WHITE_SPACE_TRAIL",
+	YearCharWarn:                    "YEAR_CHAR",
+	YearDotWarn:                     "YEAR_DOT",
+	YearOrigMisplacedWarn:           "YEAR_ORIG_MISPLACED",
+	YearPageWarn:                    "YEAR_PAGE",
+	YearParensWarn:                  "YEAR_PARENS",
+	YearQuestionWarn:                "YEAR_QUESTION",
+	YearRangeWarn:                   "YEAR_RANGE",
+	YearSqBracketsWarn:              "YEAR_SQ_BRACKETS",
+
+	EpithetQuestionWarn:      "EPITHET_QUESTION",
+	GraftChimaeraFormulaWarn: "GRAFT_CHIMAERA_FORMULA",
+	GraftChimaeraNamedWarn:   "GRAFT_CHIMAERA_NAMED",
+	HybridFormulaParensWarn:  "HYBRID_FORMULA_PARENS",
+	PhraseNameWarn:           "PHRASE_NAME",
+}
+
+var warningCodeStrMap = func() map[string]Warning {
+	res := make(map[string]Warning)
+	for k, v := range warningCodeMap {
+		res[v] = k
+	}
+	return res
+}()
+
 // WarningQualityMap assigns quality of parsing for each warning type.
 var WarningQualityMap = map[Warning]int{
 	TailWarn:                        4,
@@ -166,6 +244,12 @@ var WarningQualityMap = map[Warning]int{
 	YearQuestionWarn:                2,
 	YearRangeWarn:                   3,
 	YearSqBracketsWarn:              3,
+
+	EpithetQuestionWarn:      4,
+	GraftChimaeraFormulaWarn: 2,
+	GraftChimaeraNamedWarn:   2,
+	HybridFormulaParensWarn:  2,
+	PhraseNameWarn:           3,
 }
 
 // QualityWarning is and object that contains the warning and its
@@ -180,6 +264,24 @@ func (w Warning) String() string {
 	return warningMap[w]
 }
 
+// Code returns a short code of a warning, for example "TAIL" for
+// TailWarn.
+func (w Warning) Code() string {
+	return warningCodeMap[w]
+}
+
+// NewWarning creates a Warning from its code or its message. Codes are
+// case-insensitive.
+func NewWarning(s string) (Warning, error) {
+	if w, ok := warningCodeStrMap[strings.ToUpper(s)]; ok {
+		return w, nil
+	}
+	if w, ok := warningStrMap[s]; ok {
+		return w, nil
+	}
+	return TailWarn, fmt.Errorf("unknown warning '%s'", s)
+}
+
 // Quality returns parsing quality number that corresponds to a
 // particular warning.
 func (w Warning) Quality() int {
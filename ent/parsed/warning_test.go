@@ -47,3 +47,26 @@ func TestJSONWarn(t *testing.T) {
 		assert.Equal(t, dob.Warn, data[i].dob.Warn)
 	}
 }
+
+func TestWarningCode(t *testing.T) {
+	assert.Equal(t, "TAIL", parsed.TailWarn.Code())
+	assert.Equal(t, "YEAR_SQ_BRACKETS", parsed.YearSqBracketsWarn.Code())
+	w, err := parsed.NewWarning("auth_ex")
+	assert.Nil(t, err)
+	assert.Equal(t, parsed.AuthExWarn, w)
+	w, err = parsed.NewWarning("Unparsed tail")
+	assert.Nil(t, err)
+	assert.Equal(t, parsed.TailWarn, w)
+	_, err = parsed.NewWarning("something")
+	assert.NotNil(t, err)
+}
+
+func TestWarningCodesComplete(t *testing.T) {
+	codes := make(map[string]struct{})
+	for w := range parsed.WarningQualityMap {
+		code := w.Code()
+		assert.NotEmpty(t, code, w.String())
+		codes[code] = struct{}{}
+	}
+	assert.Equal(t, len(parsed.WarningQualityMap), len(codes))
+}
@@ -24,6 +24,17 @@ const (
 	UninomialType
 	YearApproximateType
 	YearType
+	GraftChimaeraCharType
+	PhraseType
+	CollectorType
+	VoucherType
+	HerbariumType
+	CandidatusType
+	DesignationType
+	StrainType
+	ExtinctMarkerType
+	AuthorWordInType
+	QualifierMarkerType
 )
 
 var wordTypeMap = map[WordType]string{
@@ -41,6 +52,18 @@ var wordTypeMap = map[WordType]string{
 	UninomialType:        "UNINOMIAL",
 	YearApproximateType:  "APPROXIMATE_YEAR",
 	YearType:             "YEAR",
+
+	GraftChimaeraCharType: "GRAFT_CHIMAERA_CHAR",
+	PhraseType:            "PHRASE",
+	CollectorType:         "COLLECTOR",
+	VoucherType:           "VOUCHER",
+	HerbariumType:         "HERBARIUM",
+	CandidatusType:        "CANDIDATUS",
+	DesignationType:       "DESIGNATION",
+	StrainType:            "STRAIN",
+	ExtinctMarkerType:     "EXTINCT_MARKER",
+	AuthorWordInType:      "AUTHOR_WORD_IN",
+	QualifierMarkerType:   "QUALIFIER_MARKER",
 }
 
 var wordTypeStrMap = func() map[string]WordType {
@@ -23,6 +23,9 @@ type scientificNameNode struct {
 	hybrid        *parsed.Annotation
 	surrogate     *parsed.Annotation
 	bacteria      *tb.Tribool
+	candidatus    *wordNode
+	bacterialInfo *bacterialInfoNode
+	extinct       []*wordNode
 	tail          string
 	parserVersion string
 	warnings      map[parsed.Warning]struct{}
@@ -31,27 +34,50 @@ type scientificNameNode struct {
 func (p *Engine) newScientificNameNode() {
 	n := p.root.up
 	var name nameData
+	var cand *wordNode
+	var bi *bacterialInfoNode
+	var nameEnd, tailEnd uint32
 	var tail string
 
 	for n != nil {
 		switch n.token32.pegRule {
+		case ruleCandidatus:
+			cand = p.newWordNode(n, parsed.CandidatusType)
 		case ruleName:
 			name = p.newName(n)
+			nameEnd = n.token32.end
+		case ruleBacterialInfo:
+			bi = p.newBacterialInfoNode(n)
 		case ruleTail:
 			tail = p.tailValue(n)
+			tailEnd = n.token32.end
 		}
 		n = n.next
 	}
+	if cand != nil {
+		bac := tb.New(1)
+		p.bacteria = &bac
+		delete(p.warnings, parsed.BacteriaMaybeWarn)
+	}
+	// Strains and designations make sense only for bacterial names,
+	// for other names they stay in the tail.
+	if bi != nil && p.bacteria == nil {
+		bi = nil
+		tail = string(p.buffer[nameEnd:tailEnd])
+	}
 	if p.tail != "" && tail == "" {
 		tail = p.tail
 	}
 	sn := scientificNameNode{
-		nameData:    name,
-		cardinality: p.cardinality,
-		hybrid:      p.hybrid,
-		surrogate:   p.surrogate,
-		bacteria:    p.bacteria,
-		tail:        tail,
+		nameData:      name,
+		cardinality:   p.cardinality,
+		hybrid:        p.hybrid,
+		surrogate:     p.surrogate,
+		bacteria:      p.bacteria,
+		candidatus:    cand,
+		bacterialInfo: bi,
+		extinct:       p.extinct,
+		tail:          tail,
 	}
 	p.sn = &sn
 }
@@ -74,6 +100,107 @@ func (p *Engine) tailValue(n *node32) string {
 	return string(p.buffer[t.begin:t.end])
 }
 
+type bacterialInfoNode struct {
+	Elements []*bacterialElement
+}
+
+// bacterialElement is either an infrasubspecific designation, or a strain.
+type bacterialElement struct {
+	// Sep is a normalized separator from the previous element.
+	Sep string
+	// Rank is the rank of a designation, it is nil for strains.
+	Rank       *wordNode
+	Value      *wordNode
+	StrainID   string
+	Collection string
+	TypeStrain bool
+}
+
+func (p *Engine) newBacterialInfoNode(n *node32) *bacterialInfoNode {
+	var bi bacterialInfoNode
+	var sep string
+	n = n.up
+	for n != nil {
+		var be *bacterialElement
+		switch n.token32.pegRule {
+		case ruleBacterialSep:
+			val := p.nodeValue(n)
+			switch {
+			case strings.Contains(val, "="):
+				sep = " = "
+			case strings.Contains(val, ","):
+				sep = ", "
+			default:
+				sep = " "
+			}
+		case ruleBacterialDesignation:
+			be = p.newDesignationNode(n)
+		case ruleStrainCollected:
+			be = p.newStrainCollectedNode(n)
+		case ruleStrainNamed:
+			be = p.newStrainNamedNode(n)
+		}
+		if be != nil {
+			be.Sep = sep
+			bi.Elements = append(bi.Elements, be)
+		}
+		n = n.next
+	}
+	return &bi
+}
+
+func (p *Engine) newDesignationNode(n *node32) *bacterialElement {
+	var be bacterialElement
+	n = n.up
+	for n != nil {
+		switch n.token32.pegRule {
+		case ruleDesignationRank:
+			be.Rank = p.newWordNode(n, parsed.RankType)
+			switch be.Rank.Value {
+			case "bv.":
+				be.Rank.NormValue = "biovar"
+			case "sv.":
+				be.Rank.NormValue = "serovar"
+			}
+		case ruleDesignationValue:
+			be.Value = p.newWordNode(n, parsed.DesignationType)
+		}
+		n = n.next
+	}
+	return &be
+}
+
+func (p *Engine) newStrainCollectedNode(n *node32) *bacterialElement {
+	be := bacterialElement{Value: p.newWordNode(n, parsed.StrainType)}
+	var num string
+	n = n.up
+	for n != nil {
+		switch n.token32.pegRule {
+		case ruleStrainCollection:
+			be.Collection = p.nodeValue(n)
+		case ruleStrainNumber:
+			num = p.nodeValue(n)
+		case ruleTypeStrain:
+			be.TypeStrain = true
+		}
+		n = n.next
+	}
+	be.StrainID = be.Collection + " " + num
+	be.Value.NormValue = be.StrainID
+	if be.TypeStrain {
+		be.Value.NormValue += "T"
+	}
+	return &be
+}
+
+func (p *Engine) newStrainNamedNode(n *node32) *bacterialElement {
+	be := bacterialElement{Value: p.newWordNode(n, parsed.StrainType)}
+	words := strings.Fields(be.Value.Value)
+	be.StrainID = words[len(words)-1]
+	be.Value.NormValue = "str. " + be.StrainID
+	return &be
+}
+
 func (p *Engine) newName(n *node32) nameData {
 	var name nameData
 	var annot parsed.Annotation
@@ -85,6 +212,9 @@ func (p *Engine) newName(n *node32) nameData {
 		name = p.newHybridFormulaNode(n)
 	case ruleNamedGenusHybrid:
 		annot = parsed.NamedHybridAnnot
+		if n.up.token32.pegRule == ruleGraftChimaeraChar {
+			annot = parsed.NamedGraftChimaeraAnnot
+		}
 		p.hybrid = &annot
 		name = p.newNamedGenusHybridNode(n)
 	case ruleNamedSpeciesHybrid:
@@ -100,6 +230,7 @@ func (p *Engine) newName(n *node32) nameData {
 type hybridFormulaNode struct {
 	FirstSpecies   nameData
 	HybridElements []*hybridElement
+	Parens         bool
 }
 
 type hybridElement struct {
@@ -108,10 +239,20 @@ type hybridElement struct {
 }
 
 func (p *Engine) newHybridFormulaNode(n *node32) *hybridFormulaNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newHybridFormulaNode"})
+		defer p.explainPop()
+	}
 	var hf *hybridFormulaNode
-	p.addWarn(parsed.HybridFormulaWarn)
 	n = n.up
-	firstName := p.newSingleName(n)
+	if isGraftChimaeraFormula(n) {
+		annot := parsed.GraftChimaeraFormulaAnnot
+		p.hybrid = &annot
+		p.addWarn(parsed.GraftChimaeraFormulaWarn)
+	} else {
+		p.addWarn(parsed.HybridFormulaWarn)
+	}
+	firstName := p.newHybridParent(n)
 	n = n.next
 	var hes []*hybridElement
 	var he *hybridElement
@@ -121,8 +262,12 @@ func (p *Engine) newHybridFormulaNode(n *node32) *hybridFormulaNode {
 			he = &hybridElement{
 				HybridChar: p.newWordNode(n, parsed.HybridCharType),
 			}
-		case ruleSingleName:
-			he.Species = p.newSingleName(n)
+		case ruleGraftChimaeraChar:
+			he = &hybridElement{
+				HybridChar: p.newWordNode(n, parsed.GraftChimaeraCharType),
+			}
+		case ruleSingleName, ruleHybridFormulaParens, ruleHybridNameParens:
+			he.Species = p.newHybridParent(n)
 			hes = append(hes, he)
 		case ruleSpeciesEpithet:
 			p.addWarn(parsed.HybridFormulaIncompleteWarn)
@@ -134,6 +279,11 @@ func (p *Engine) newHybridFormulaNode(n *node32) *hybridFormulaNode {
 				g = node.Word
 			case *comparisonNode:
 				g = node.Genus
+			case *openNomenNode:
+				g = node.Genus
+			}
+			if g == nil {
+				g = &wordNode{}
 			}
 			spe := p.newSpeciesEpithetNode(n)
 			g = &wordNode{Value: g.Value, NormValue: g.NormValue}
@@ -155,6 +305,38 @@ func (p *Engine) newHybridFormulaNode(n *node32) *hybridFormulaNode {
 	return hf
 }
 
+// newHybridParent creates a parent of a hybrid formula, that is either a name,
+// a name in parentheses, or a hybrid formula in parentheses.
+func (p *Engine) newHybridParent(n *node32) nameData {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newHybridParent"})
+		defer p.explainPop()
+	}
+	switch n.pegRule {
+	case ruleSingleName:
+		return p.newSingleName(n)
+	case ruleHybridNameParens:
+		p.addWarn(parsed.HybridFormulaParensWarn)
+		return p.newSingleName(n.up)
+	}
+	p.addWarn(parsed.HybridFormulaParensWarn)
+	hf := p.newHybridFormulaNode(n.up)
+	hf.Parens = true
+	return hf
+}
+
+// isGraftChimaeraFormula checks if elements of a formula are joined by '+'
+// instead of a hybrid sign.
+func isGraftChimaeraFormula(n *node32) bool {
+	for n != nil {
+		if n.pegRule == ruleGraftChimaeraChar {
+			return true
+		}
+		n = n.next
+	}
+	return false
+}
+
 func (hf *hybridFormulaNode) normalizeAbbreviated() {
 	var fsv string
 	if fsp, ok := hf.FirstSpecies.(*speciesNode); ok {
@@ -181,16 +363,26 @@ type namedGenusHybridNode struct {
 }
 
 func (p *Engine) newNamedGenusHybridNode(n *node32) *namedGenusHybridNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newNamedGenusHybridNode"})
+		defer p.explainPop()
+	}
 	var nhn *namedGenusHybridNode
 	var name nameData
 	n = n.up
-	if n.token32.pegRule != ruleHybridChar {
+	var hybr *wordNode
+	switch n.token32.pegRule {
+	case ruleHybridChar:
+		hybr = p.newWordNode(n, parsed.HybridCharType)
+		p.addWarn(parsed.HybridNamedWarn)
+	case ruleGraftChimaeraChar:
+		hybr = p.newWordNode(n, parsed.GraftChimaeraCharType)
+		p.addWarn(parsed.GraftChimaeraNamedWarn)
+	default:
 		return nhn
 	}
-	hybr := p.newWordNode(n, parsed.HybridCharType)
 	n = n.next
 	n = n.up
-	p.addWarn(parsed.HybridNamedWarn)
 	if n.token32.begin == 1 {
 		p.addWarn(parsed.HybridCharNoSpaceWarn)
 	}
@@ -221,6 +413,10 @@ type namedSpeciesHybridNode struct {
 }
 
 func (p *Engine) newNamedSpeciesHybridNode(n *node32) *namedSpeciesHybridNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newNamedSpeciesHybridNode"})
+		defer p.explainPop()
+	}
 	var nhl *namedSpeciesHybridNode
 	var annot parsed.Annotation
 	n = n.up
@@ -229,6 +425,8 @@ func (p *Engine) newNamedSpeciesHybridNode(n *node32) *namedSpeciesHybridNode {
 	var infs []*infraspEpithetNode
 	for n != nil {
 		switch n.pegRule {
+		case ruleExtinctChar, ruleExtinctFossil:
+			p.addExtinct(n)
 		case ruleGenusWord:
 			gen = p.newWordNode(n, parsed.GenusType)
 		case ruleComparison:
@@ -280,6 +478,10 @@ func (p *Engine) botanicalUninomial(n *node32) bool {
 }
 
 func (p *Engine) newBotanicalUninomialNode(n *node32) *uninomialNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newBotanicalUninomialNode"})
+		defer p.explainPop()
+	}
 	var at2 *authorsGroupNode
 	n = n.up
 	w := p.newWordNode(n, parsed.UninomialType)
@@ -306,27 +508,41 @@ func (p *Engine) newBotanicalUninomialNode(n *node32) *uninomialNode {
 }
 
 func (p *Engine) newSingleName(n *node32) nameData {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newSingleName"})
+		defer p.explainPop()
+	}
 	var name nameData
 	var annot parsed.Annotation
 	n = n.up
-	switch n.token32.pegRule {
-	case ruleNameSpecies:
-		name = p.newSpeciesNode(n)
-	case ruleNameApprox:
-		name = p.newApproxNode(n)
-	case ruleNameComp:
-		p.addWarn(parsed.NameComparisonWarn)
-		annot = parsed.ComparisonAnnot
-		p.surrogate = &annot
-		name = p.newComparisonNode(n)
-	case ruleUninomial:
-		name = p.newUninomialNode(n)
-	case ruleUninomialCombo:
-		if p.botanicalUninomial(n) {
-			return p.newBotanicalUninomialNode(n)
+	for n != nil {
+		switch n.token32.pegRule {
+		case ruleExtinctChar, ruleExtinctFossil:
+			p.addExtinct(n)
+		case ruleNameSpecies:
+			name = p.newSpeciesNode(n)
+		case ruleNameApprox:
+			name = p.newApproxNode(n)
+		case ruleNameComp:
+			p.addWarn(parsed.NameComparisonWarn)
+			annot = parsed.ComparisonAnnot
+			p.surrogate = &annot
+			name = p.newComparisonNode(n)
+		case ruleNameOpenNomen:
+			name = p.newOpenNomenNode(n)
+		case ruleNamePhrase:
+			name = p.newPhraseNode(n)
+		case ruleUninomial:
+			name = p.newUninomialNode(n)
+		case ruleUninomialCombo:
+			if p.botanicalUninomial(n) {
+				name = p.newBotanicalUninomialNode(n)
+				break
+			}
+			p.addWarn(parsed.UninomialComboWarn)
+			name = p.newUninomialComboNode(n)
 		}
-		p.addWarn(parsed.UninomialComboWarn)
-		name = p.newUninomialComboNode(n)
+		n = n.next
 	}
 	return name
 }
@@ -339,6 +555,10 @@ type approxNode struct {
 }
 
 func (p *Engine) newApproxNode(n *node32) *approxNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newApproxNode"})
+		defer p.explainPop()
+	}
 	var an *approxNode
 	annot := parsed.ApproximationAnnot
 	p.surrogate = &annot
@@ -409,6 +629,204 @@ func (p *Engine) newComparisonNode(n *node32) *comparisonNode {
 	return cn
 }
 
+type openNomenNode struct {
+	Genus         *wordNode
+	SpEpithet     *spEpithetNode
+	Infraspecies  *infraspEpithetNode
+	Qualifier     *wordNode
+	QualifierType parsed.QualifierType
+	Position      parsed.QualifierPosition
+	Indet         bool
+}
+
+func (p *Engine) newOpenNomenNode(n *node32) *openNomenNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newOpenNomenNode"})
+		defer p.explainPop()
+	}
+	annot := parsed.OpenNomenclatureAnnot
+	p.surrogate = &annot
+	on := openNomenNode{}
+	var gen *node32
+	n = n.up.up
+	for n != nil {
+		switch n.pegRule {
+		case ruleGenusWord:
+			gen = n
+		case ruleComparison, ruleAffinis, ruleNear, ruleQuestion:
+			p.newOpenQualifier(n, &on)
+			on.Position = parsed.SpeciesPosition
+		case ruleSpeciesEpithet:
+			on.SpEpithet = p.newSpeciesEpithetNode(n)
+		case ruleOpenInfraspEpithet:
+			p.newOpenInfraspEpithet(n, &on)
+		case ruleIndeterminate:
+			p.newIndeterminate(n, &on)
+		}
+		n = n.next
+	}
+
+	wt := parsed.GenusType
+	if on.SpEpithet == nil && on.Position == parsed.GenusPosition {
+		wt = parsed.UninomialType
+	}
+	on.Genus = p.newWordNode(gen, wt)
+
+	if on.QualifierType == parsed.ComparisonQualifier {
+		p.addWarn(parsed.NameComparisonWarn)
+	} else {
+		p.addWarn(parsed.NameApproxWarn)
+	}
+
+	// Cardinality counts determined elements of the name, indeterminate
+	// names have cardinality 0.
+	p.cardinality = 0
+	if !on.Indet {
+		p.cardinality = 1
+		if on.SpEpithet != nil {
+			p.cardinality++
+		}
+		if on.Infraspecies != nil {
+			p.cardinality++
+		}
+	}
+	return &on
+}
+
+// newOpenInfraspEpithet sets a qualified infraspecific epithet, with its
+// qualifier, to an open nomenclature node.
+func (p *Engine) newOpenInfraspEpithet(n *node32, on *openNomenNode) {
+	inf := infraspEpithetNode{}
+	on.Position = parsed.InfraspeciesPosition
+	n = n.up
+	for n != nil {
+		switch n.pegRule {
+		case ruleRank:
+			inf.Rank = p.newRankNode(n)
+		case ruleComparison, ruleAffinis, ruleNear, ruleQuestion:
+			p.newOpenQualifier(n, on)
+		case ruleWord:
+			inf.Word = p.newWordNode(n, parsed.InfraspEpithetType)
+		case ruleAuthorship:
+			inf.Authorship = p.newAuthorshipNode(n)
+		}
+		n = n.next
+	}
+	on.Infraspecies = &inf
+}
+
+// newOpenQualifier sets a qualifier that precedes a specific or an
+// infraspecific epithet to an open nomenclature node.
+func (p *Engine) newOpenQualifier(n *node32, on *openNomenNode) {
+	on.Qualifier = p.newWordNode(n, parsed.QualifierMarkerType)
+	switch n.pegRule {
+	case ruleComparison:
+		on.Qualifier.NormValue = "cf."
+		on.QualifierType = parsed.ComparisonQualifier
+	case ruleAffinis:
+		on.Qualifier.NormValue = "aff."
+		on.QualifierType = parsed.AffinityQualifier
+	case ruleNear:
+		on.Qualifier.NormValue = "nr."
+		on.QualifierType = parsed.NearQualifier
+	case ruleQuestion:
+		on.QualifierType = parsed.UncertainQualifier
+	}
+}
+
+// newIndeterminate sets a qualifier of an indeterminate, uncertain, or
+// incertae sedis name to an open nomenclature node.
+func (p *Engine) newIndeterminate(n *node32, on *openNomenNode) {
+	n = n.up
+	on.Qualifier = p.newWordNode(n, parsed.QualifierMarkerType)
+	on.QualifierType = parsed.IndeterminateQualifier
+	on.Indet = true
+	on.Position = parsed.SpeciesPosition
+	if on.SpEpithet != nil {
+		on.Position = parsed.InfraspeciesPosition
+	}
+	switch n.pegRule {
+	case ruleIncertaeSedis:
+		on.Qualifier.NormValue = "incertae sedis"
+		on.QualifierType = parsed.IncertaeSedisQualifier
+		on.Indet = false
+		if on.SpEpithet == nil {
+			on.Position = parsed.GenusPosition
+		} else {
+			on.Position = parsed.SpeciesPosition
+		}
+	case ruleGenSpIndet:
+		on.Qualifier.NormValue = "gen. et sp. indet."
+		on.Position = parsed.GenusPosition
+	case ruleSpIndet:
+		switch {
+		case strings.HasPrefix(on.Qualifier.Value, "spp."):
+			on.Qualifier.NormValue = "spp. indet."
+		case strings.HasPrefix(on.Qualifier.Value, "sp."):
+			on.Qualifier.NormValue = "sp. indet."
+		default:
+			on.Qualifier.NormValue = "indet."
+		}
+	case ruleSpInc:
+		on.Qualifier.NormValue = "sp. inc."
+		on.QualifierType = parsed.UncertainQualifier
+	}
+}
+
+type phraseNode struct {
+	Genus     *wordNode
+	Rank      *wordNode
+	Phrase    *wordNode
+	Collector *wordNode
+	Voucher   *wordNode
+	Herbarium *wordNode
+}
+
+func (p *Engine) newPhraseNode(n *node32) *phraseNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newPhraseNode"})
+		defer p.explainPop()
+	}
+	annot := parsed.PhraseNameAnnot
+	p.surrogate = &annot
+	p.addWarn(parsed.PhraseNameWarn)
+	var pn phraseNode
+	n = n.up
+	for n != nil {
+		switch n.pegRule {
+		case ruleGenusWord:
+			pn.Genus = p.newWordNode(n, parsed.GenusType)
+		case rulePhraseRank:
+			pn.Rank = p.newWordNode(n, parsed.RankType)
+		case rulePhrase:
+			pn.Phrase = p.newPhraseWordNode(n, parsed.PhraseType)
+		case ruleCollector:
+			pn.Collector = p.newPhraseWordNode(n, parsed.CollectorType)
+		case ruleVoucher:
+			pn.Voucher = p.newPhraseWordNode(n, parsed.VoucherType)
+		case rulePhraseHerbarium:
+			pn.Herbarium = p.newPhraseWordNode(n, parsed.HerbariumType)
+		}
+		n = n.next
+	}
+	p.cardinality = 2
+	return &pn
+}
+
+// newPhraseWordNode creates a node for a part of a phrase name. Such parts
+// can have several words, and their normalized value has only single spaces
+// between the words.
+func (p *Engine) newPhraseWordNode(n *node32, wt parsed.WordType) *wordNode {
+	t := n.token32
+	val := p.nodeValue(n)
+	pos := parsed.Word{Type: wt, Start: int(t.begin), End: int(t.end)}
+	return &wordNode{
+		Value:     val,
+		NormValue: strings.Join(strings.Fields(val), " "),
+		Pos:       pos,
+	}
+}
+
 type speciesNode struct {
 	Genus        *wordNode
 	Subgenus     *wordNode
@@ -417,6 +835,10 @@ type speciesNode struct {
 }
 
 func (p *Engine) newSpeciesNode(n *node32) *speciesNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newSpeciesNode"})
+		defer p.explainPop()
+	}
 	var sp *spEpithetNode
 	var sg *wordNode
 	var infs []*infraspEpithetNode
@@ -465,12 +887,30 @@ type spEpithetNode struct {
 }
 
 func (p *Engine) newSpeciesEpithetNode(n *node32) *spEpithetNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newSpeciesEpithetNode"})
+		defer p.explainPop()
+	}
+	var se *wordNode
 	var au *authorshipNode
+	var uncertain bool
 	n = n.up
-	se := p.newWordNode(n, parsed.SpEpithetType)
-	n = n.next
-	if n != nil {
-		au = p.newAuthorshipNode(n)
+	for n != nil {
+		switch n.token32.pegRule {
+		case ruleUncertainPrefix, ruleUncertainSuffix:
+			uncertain = true
+		case ruleExtinctChar:
+			p.addExtinct(n)
+		case ruleWord:
+			se = p.newWordNode(n, parsed.SpEpithetType)
+		case ruleAuthorship:
+			au = p.newAuthorshipNode(n)
+		}
+		n = n.next
+	}
+	if uncertain {
+		p.addWarn(parsed.EpithetQuestionWarn)
+		se.Pos.Uncertain = true
 	}
 	sen := spEpithetNode{
 		Word:       se,
@@ -486,6 +926,10 @@ type infraspEpithetNode struct {
 }
 
 func (p *Engine) newInfraspeciesGroup(n *node32) []*infraspEpithetNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newInfraspeciesGroup"})
+		defer p.explainPop()
+	}
 	var infs []*infraspEpithetNode
 	n = n.up
 	if n == nil || n.token32.pegRule != ruleInfraspEpithet {
@@ -506,6 +950,10 @@ func (p *Engine) newInfraspeciesGroup(n *node32) []*infraspEpithetNode {
 }
 
 func (p *Engine) newInfraspEpithetNode(n *node32) *infraspEpithetNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newInfraspEpithetNode"})
+		defer p.explainPop()
+	}
 	var inf infraspEpithetNode
 	var r *rankNode
 	var w *wordNode
@@ -515,8 +963,13 @@ func (p *Engine) newInfraspEpithetNode(n *node32) *infraspEpithetNode {
 		return &inf
 	}
 
+	var uncertain bool
 	for n != nil {
 		switch n.token32.pegRule {
+		case ruleUncertainPrefix, ruleUncertainSuffix:
+			uncertain = true
+		case ruleExtinctChar:
+			p.addExtinct(n)
 		case ruleWord:
 			w = p.newWordNode(n, parsed.InfraspEpithetType)
 		case ruleRank:
@@ -526,6 +979,10 @@ func (p *Engine) newInfraspEpithetNode(n *node32) *infraspEpithetNode {
 		}
 		n = n.next
 	}
+	if uncertain {
+		p.addWarn(parsed.EpithetQuestionWarn)
+		w.Pos.Uncertain = true
+	}
 	inf = infraspEpithetNode{
 		Word:       w,
 		Rank:       r,
@@ -539,6 +996,10 @@ type rankNode struct {
 }
 
 func (p *Engine) newRankNode(n *node32) *rankNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newRankNode"})
+		defer p.explainPop()
+	}
 	if n.up == nil {
 		w := p.newWordNode(n, parsed.RankType)
 		r := rankNode{Word: w}
@@ -643,16 +1104,21 @@ type authorshipNode struct {
 	OriginalAuthors    *authorsGroupNode
 	CombinationAuthors *authorsGroupNode
 	TerminalFilius     bool
+	Corrig             bool
 }
 
 func (p *Engine) newAuthorshipNode(n *node32) *authorshipNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newAuthorshipNode"})
+		defer p.explainPop()
+	}
 	var a *authorshipNode
 	if n == nil {
 		return a
 	}
 	var oa, ca *authorsGroupNode
 	var misplacedYear bool
-	var fil bool
+	var fil, corrig bool
 	verbatim := p.buffer[n.begin:n.end]
 	n = n.up
 	for n != nil {
@@ -676,6 +1142,8 @@ func (p *Engine) newAuthorshipNode(n *node32) *authorshipNode {
 			}
 		case ruleCombinationAuthorship:
 			ca = p.newAuthorsGroupNode(n.up)
+		case ruleAuthorCorrig:
+			corrig = true
 		}
 		n = n.next
 	}
@@ -689,6 +1157,7 @@ func (p *Engine) newAuthorshipNode(n *node32) *authorshipNode {
 		OriginalAuthors:    oa,
 		CombinationAuthors: ca,
 		TerminalFilius:     fil,
+		Corrig:             corrig,
 	}
 	return a
 }
@@ -706,11 +1175,17 @@ type authorsGroupNode struct {
 	Team2Type      teamType
 	Team2Word      *wordNode
 	Team2          *authorsTeamNode
+	InWord         *wordNode
+	InTeam         *authorsTeamNode
 	Parens         bool
 	TerminalFilius bool
 }
 
 func (p *Engine) newAuthorsGroupNode(n *node32) *authorsGroupNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newAuthorsGroupNode"})
+		defer p.explainPop()
+	}
 	var t1, t2 *authorsTeamNode
 	var t2t teamType
 	var t2wrd *wordNode
@@ -728,6 +1203,10 @@ func (p *Engine) newAuthorsGroupNode(n *node32) *authorsGroupNode {
 	if n == nil {
 		return &ag
 	}
+	if n.token32.pegRule == ruleAuthorIn {
+		p.addInTeam(&ag, n)
+		return &ag
+	}
 	switch n.token32.pegRule {
 	case ruleAuthorEx:
 		p.addWarn(parsed.AuthExWarn)
@@ -759,9 +1238,26 @@ func (p *Engine) newAuthorsGroupNode(n *node32) *authorsGroupNode {
 	ag.Team2Word = t2wrd
 	ag.Team2 = t2
 	ag.TerminalFilius = ag.Team2.TerminalFilius
+	if n.next != nil && n.next.token32.pegRule == ruleAuthorIn {
+		p.addInTeam(&ag, n.next)
+	}
 	return &ag
 }
 
+// addInTeam adds authors of a publication where the name was published,
+// for example "Jones" in "Smith in Jones".
+func (p *Engine) addInTeam(ag *authorsGroupNode, n *node32) {
+	wrd := p.newWordNode(n, parsed.AuthorWordInType)
+	wrd.NormValue = "in"
+	n = n.next
+	if n == nil || n.token32.pegRule != ruleAuthorsTeam {
+		return
+	}
+	ag.InWord = wrd
+	ag.InTeam = p.newAuthorTeam(n)
+	ag.TerminalFilius = ag.InTeam.TerminalFilius
+}
+
 type authorsTeamNode struct {
 	Authors        []*authorNode
 	TerminalFilius bool
@@ -815,6 +1311,10 @@ type authorNode struct {
 }
 
 func (p *Engine) newAuthorNode(n *node32) *authorNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newAuthorNode"})
+		defer p.explainPop()
+	}
 	var w *wordNode
 	var fil bool
 	var ws []*wordNode
@@ -859,6 +1359,10 @@ func (p *Engine) newAuthorNode(n *node32) *authorNode {
 }
 
 func (p *Engine) authorWord(n *node32) *wordNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST authorWord"})
+		defer p.explainPop()
+	}
 	w := p.newWordNode(n, parsed.AuthorWordType)
 	if n.up != nil && n.up.token32.pegRule == ruleAllCapsAuthorWord {
 		count := 0
@@ -881,6 +1385,10 @@ type yearNode struct {
 }
 
 func (p *Engine) newYearNode(nd *node32) *yearNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newYearNode"})
+		defer p.explainPop()
+	}
 	var w *wordNode
 	appr := false
 	nodes := nd.flatChildren()
@@ -949,6 +1457,10 @@ type wordNode struct {
 }
 
 func (p *Engine) newWordNode(n *node32, wt parsed.WordType) *wordNode {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST newWordNode"})
+		defer p.explainPop()
+	}
 	t := n.token32
 	val := p.nodeValue(n)
 	pos := parsed.Word{Type: wt, Start: int(t.begin), End: int(t.end)}
@@ -976,9 +1488,14 @@ func (p *Engine) newWordNode(n *node32, wt parsed.WordType) *wordNode {
 		}
 	}
 	if wt == parsed.GenusType || wt == parsed.UninomialType {
+		// A question mark after a genus or a uninomial makes the word
+		// uncertain, the same way as for epithets. The warning stays
+		// CapWordQuestionWarn, as it existed before EpithetQuestionWarn,
+		// and filters by its code must keep working.
 		if val[len(val)-1] == '?' {
 			p.addWarn(parsed.CapWordQuestionWarn)
 			wrd.NormValue = wrd.NormValue[0 : len(wrd.NormValue)-1]
+			wrd.Pos.Uncertain = true
 		}
 		if _, ok := p.warnings[parsed.GenusUpperCharAfterDash]; ok {
 			runes := []rune(wrd.Value)
@@ -16,8 +16,13 @@ type baseEngine struct {
 	hybrid      *parsed.Annotation
 	surrogate   *parsed.Annotation
 	bacteria    *tribool.Tribool
+	extinct     []*wordNode
 	warnings    map[parsed.Warning]struct{}
 	tail        string
+
+	// explain collects data for Explain method, it is nil during
+	// normal parsing.
+	explain *explainData
 }
 
 // New creates implementation of Parser interface.
@@ -33,6 +38,7 @@ func (p *Engine) fullReset() {
 	p.hybrid = nil
 	p.surrogate = nil
 	p.bacteria = nil
+	p.extinct = nil
 	var warnReset map[parsed.Warning]struct{}
 	p.warnings = warnReset
 	p.tail = ""
@@ -46,9 +52,30 @@ func (p *Engine) addWarn(w parsed.Warning) {
 	if _, ok := p.warnings[w]; !ok {
 		p.warnings[w] = struct{}{}
 	}
+	p.explainWarn(w)
+}
+
+// parensOpen is true if the buffer has an unclosed parenthesis before the
+// position. The grammar uses it to keep the closing parenthesis of a hybrid
+// parent, like in "Aus bus × (Aus cus L.)", out of the authorship.
+func (p *Engine) parensOpen(pos uint32) bool {
+	var open int
+	for _, r := range p.buffer[:pos] {
+		switch r {
+		case '(':
+			open++
+		case ')':
+			open--
+		}
+	}
+	return open > 0
 }
 
 func (p *Engine) isBacteria(gen string) {
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "AST isBacteria"})
+		defer p.explainPop()
+	}
 	if hom, ok := dict.Dict.Bacteria[gen]; ok {
 		if hom {
 			p.addWarn(parsed.BacteriaMaybeWarn)
@@ -61,6 +88,10 @@ func (p *Engine) isBacteria(gen string) {
 	}
 }
 
+func (p *Engine) addExtinct(n *node32) {
+	p.extinct = append(p.extinct, p.newWordNode(n, parsed.ExtinctMarkerType))
+}
+
 // OutputAST assembles PEG nodes AST structure.
 func (p *Engine) OutputAST() {
 	type element struct {
@@ -102,6 +133,10 @@ func (p *Engine) PrintOutputSyntaxTree(w io.Writer) {
 func (p *Engine) newNode(t token32) (*node32, bool) {
 	var node *node32
 	var annot parsed.Annotation
+	if p.explain != nil {
+		p.explainPush(warnSource{rule: t.pegRule})
+		defer p.explainPop()
+	}
 	switch t.pegRule {
 	case ruleHybridChar:
 		annot = parsed.HybridAnnot
@@ -137,10 +172,11 @@ func (p *Engine) newNode(t token32) (*node32, bool) {
 	return node, true
 }
 
+// nodeValue returns the value of a node. Spans of nodes are rune offsets,
+// so the value is taken from the runes of the buffer.
 func (p *Engine) nodeValue(n *node32) string {
 	t := n.token32
-	v := string([]rune(p.Buffer)[t.begin:t.end])
-	return v
+	return string(p.buffer[t.begin:t.end])
 }
 
 // ParseName returns the name the nodes. In case of parsing errors
@@ -162,18 +198,55 @@ var nodeRules = map[pegRule]struct{}{
 	ruleSciName:                         {},
 	ruleName:                            {},
 	ruleTail:                            {},
+	ruleCandidatus:                      {},
+	ruleBacterialInfo:                   {},
+	ruleBacterialSep:                    {},
+	ruleBacterialDesignation:            {},
+	ruleDesignationRank:                 {},
+	ruleDesignationValue:                {},
+	ruleStrainCollected:                 {},
+	ruleStrainCollection:                {},
+	ruleStrainNumber:                    {},
+	ruleTypeStrain:                      {},
+	ruleStrainNamed:                     {},
+	ruleStrainPrefix:                    {},
 	ruleHybridFormula:                   {},
+	ruleHybridFormulaParens:             {},
+	ruleHybridNameParens:                {},
 	ruleNamedSpeciesHybrid:              {},
 	ruleNamedGenusHybrid:                {},
 	ruleSingleName:                      {},
+	ruleExtinctChar:                     {},
+	ruleExtinctFossil:                   {},
 	ruleNameApprox:                      {},
 	ruleNameComp:                        {},
+	ruleNameOpenNomen:                   {},
+	ruleNameOpenSpecies:                 {},
+	ruleNameOpenInfrasp:                 {},
+	ruleNameIndet:                       {},
+	ruleNamePhrase:                      {},
+	rulePhraseRank:                      {},
+	rulePhrase:                          {},
+	ruleCollector:                       {},
+	ruleVoucher:                         {},
+	rulePhraseHerbarium:                 {},
 	ruleNameSpecies:                     {},
 	ruleGenusWord:                       {},
 	ruleInfraspGroup:                    {},
 	ruleInfraspEpithet:                  {},
 	ruleSpeciesEpithet:                  {},
+	ruleUncertainPrefix:                 {},
+	ruleUncertainSuffix:                 {},
 	ruleComparison:                      {},
+	ruleOpenInfraspEpithet:              {},
+	ruleAffinis:                         {},
+	ruleNear:                            {},
+	ruleQuestion:                        {},
+	ruleIndeterminate:                   {},
+	ruleIncertaeSedis:                   {},
+	ruleGenSpIndet:                      {},
+	ruleSpIndet:                         {},
+	ruleSpInc:                           {},
 	ruleRank:                            {},
 	ruleRankOtherUncommon:               {},
 	ruleRankVar:                         {},
@@ -191,6 +264,7 @@ var nodeRules = map[pegRule]struct{}{
 	ruleWordApostr:                      {},
 	ruleWordStartsWithDigit:             {},
 	ruleHybridChar:                      {},
+	ruleGraftChimaeraChar:               {},
 	ruleApproxNameIgnored:               {},
 	ruleApproximation:                   {},
 	ruleAuthorship:                      {},
@@ -204,7 +278,9 @@ var nodeRules = map[pegRule]struct{}{
 	ruleAuthorsTeam:                     {},
 	ruleAuthorSep:                       {},
 	ruleAuthorEx:                        {},
+	ruleAuthorIn:                        {},
 	ruleAuthorEmend:                     {},
+	ruleAuthorCorrig:                    {},
 	ruleAuthor:                          {},
 	ruleUnknownAuthor:                   {},
 	ruleAuthorWord:                      {},
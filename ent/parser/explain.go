@@ -0,0 +1,288 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gnames/gnparser/ent/internal/preprocess"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Explanation describes how a name-string was parsed. It is used for
+// debugging of unexpected parsing results.
+type Explanation struct {
+	// Verbatim is the input name-string.
+	Verbatim string `json:"verbatim"`
+
+	// Preprocessing describes changes and decisions made before the
+	// PEG parsing.
+	Preprocessing Preprocessing `json:"preprocessing"`
+
+	// Tree is the tree of PEG rules matched by the parser. It is nil if
+	// the name-string was not sent to the PEG parser.
+	Tree *RuleNode `json:"tree,omitempty"`
+
+	// Warnings shows where every warning was emitted.
+	Warnings []WarningSource `json:"warnings,omitempty"`
+
+	// Parsed is the final result of parsing.
+	Parsed parsed.Parsed `json:"parsed"`
+}
+
+// Preprocessing contains results of steps that happen before the PEG
+// parsing.
+type Preprocessing struct {
+	// HTMLStripped is true if HTML tags or entities were removed.
+	HTMLStripped bool `json:"htmlStripped"`
+
+	// Cleaned is the name-string after removal of HTML tags and entities.
+	Cleaned string `json:"cleaned"`
+
+	// Virus is true if the name-string was detected as a virus name.
+	Virus bool `json:"virus"`
+
+	// NoParse is true if the name-string was not sent to the PEG parser.
+	NoParse bool `json:"noParse"`
+
+	// Annotation is true if a part of the name-string was cut as an
+	// annotation before parsing.
+	Annotation bool `json:"annotation"`
+
+	// AnnotationStart is the position (in UTF-8 characters) of the cut in
+	// the Cleaned string.
+	AnnotationStart int `json:"annotationStart,omitempty"`
+
+	// Underscore is true if underscores were replaced by spaces.
+	Underscore bool `json:"underscore"`
+
+	// Body is the part of the name-string sent to the PEG parser.
+	Body string `json:"body"`
+
+	// Tail is the part of the name-string cut before parsing.
+	Tail string `json:"tail,omitempty"`
+
+	// Error is the PEG parser error, if the parser failed.
+	Error string `json:"error,omitempty"`
+}
+
+// RuleNode is a node of the PEG rules tree.
+type RuleNode struct {
+	// Rule is the name of a PEG rule.
+	Rule string `json:"rule"`
+
+	// Start is the position of the first character matched by the rule
+	// in the Body.
+	Start int `json:"start"`
+
+	// End is the position after the last character matched by the rule.
+	End int `json:"end"`
+
+	// Value is the text matched by the rule.
+	Value string `json:"value"`
+
+	// Children are the rules matched inside of the rule.
+	Children []*RuleNode `json:"children,omitempty"`
+}
+
+// WarningSource shows where a warning was emitted.
+type WarningSource struct {
+	// Warning is the message of the warning.
+	Warning string `json:"warning"`
+
+	// Quality is the quality of the warning.
+	Quality int `json:"quality"`
+
+	// Source is a PEG rule or a step of processing that emitted the
+	// warning.
+	Source string `json:"source"`
+}
+
+// explainData collects information for an Explanation during parsing.
+type explainData struct {
+	preproc  Preprocessing
+	sources  map[parsed.Warning]string
+	stack    []warnSource
+	sentBody bool
+}
+
+// warnSource is a PEG rule, or a named step of processing, that emits
+// warnings. The name is used if it is not empty.
+type warnSource struct {
+	rule pegRule
+	name string
+}
+
+// Explain parses a name-string and returns the description of the parsing
+// process together with the parsing result.
+func (p *Engine) Explain(s, ver string, keepHTML bool) Explanation {
+	p.explain = &explainData{sources: make(map[parsed.Warning]string)}
+	defer func() { p.explain = nil }()
+
+	sn := p.PreprocessAndParse(s, ver, keepHTML)
+	res := Explanation{
+		Verbatim:      s,
+		Preprocessing: p.explain.preproc,
+		Parsed:        sn.ToOutput(true),
+	}
+	if p.explain.sentBody && p.error == nil {
+		res.Tree = p.ruleTree(p.AST())
+	}
+	for _, v := range res.Parsed.QualityWarnings {
+		src, ok := p.explain.sources[v.Warning]
+		if !ok {
+			src = "output"
+		}
+		res.Warnings = append(res.Warnings, WarningSource{
+			Warning: v.Warning.String(),
+			Quality: v.Quality,
+			Source:  src,
+		})
+	}
+	return res
+}
+
+// explainPreprocess saves results of preprocessing for Explain.
+func (p *Engine) explainPreprocess(
+	cleaned string,
+	htmlStripped bool,
+	pp *preprocess.Preprocessor,
+) {
+	if p.explain == nil {
+		return
+	}
+	res := Preprocessing{
+		HTMLStripped: htmlStripped,
+		Cleaned:      cleaned,
+		Virus:        pp.Virus,
+		NoParse:      pp.NoParse,
+		Annotation:   pp.Annotation,
+		Underscore:   pp.Underscore,
+		Body:         string(pp.Body),
+		Tail:         string(pp.Tail),
+	}
+	if pp.Annotation {
+		cut := len(cleaned) - len(pp.Tail)
+		res.AnnotationStart = utf8.RuneCountInString(cleaned[:cut])
+	}
+	p.explain.preproc = res
+	p.explain.sentBody = !pp.NoParse
+}
+
+// explainError saves the PEG parser error for Explain.
+func (p *Engine) explainError(err error) {
+	if p.explain == nil {
+		return
+	}
+	p.explain.preproc.Error = err.Error()
+}
+
+// explainPush makes src the source of warnings emitted until the matching
+// explainPop. Sources are kept in a stack, so a nested rule or function
+// does not change the source of warnings emitted after its return.
+// Callers check that p.explain is not nil, so parsing without Explain does
+// not pay for the stack.
+func (p *Engine) explainPush(src warnSource) {
+	p.explain.stack = append(p.explain.stack, src)
+}
+
+// explainPop restores the source of warnings set before the last
+// explainPush.
+func (p *Engine) explainPop() {
+	if p.explain == nil || len(p.explain.stack) == 0 {
+		return
+	}
+	p.explain.stack = p.explain.stack[:len(p.explain.stack)-1]
+}
+
+// explainWarn saves the source of a warning for Explain.
+func (p *Engine) explainWarn(w parsed.Warning) {
+	if p.explain == nil {
+		return
+	}
+	if _, ok := p.explain.sources[w]; ok {
+		return
+	}
+	src := "unknown"
+	if l := len(p.explain.stack); l > 0 {
+		ws := p.explain.stack[l-1]
+		src = ws.name
+		if src == "" {
+			src = "rule " + rul3s[ws.rule]
+		}
+	}
+	p.explain.sources[w] = src
+}
+
+// ruleTree converts PEG nodes into a tree of RuleNode objects.
+func (p *Engine) ruleTree(n *node32) *RuleNode {
+	if n == nil {
+		return nil
+	}
+	res := &RuleNode{
+		Rule:  rul3s[n.pegRule],
+		Start: int(n.begin),
+		End:   int(n.end),
+		Value: string(p.buffer[n.begin:n.end]),
+	}
+	for c := n.up; c != nil; c = c.next {
+		res.Children = append(res.Children, p.ruleTree(c))
+	}
+	return res
+}
+
+// Text returns a human-readable version of the Explanation.
+func (e Explanation) Text() string {
+	var b strings.Builder
+	pp := e.Preprocessing
+	fmt.Fprintf(&b, "Verbatim: %s\n", strconv.Quote(e.Verbatim))
+
+	b.WriteString("\nPreprocessing:\n")
+	fmt.Fprintf(&b, "  HTML stripped: %t\n", pp.HTMLStripped)
+	if pp.HTMLStripped {
+		fmt.Fprintf(&b, "  Cleaned:       %s\n", strconv.Quote(pp.Cleaned))
+	}
+	fmt.Fprintf(&b, "  Virus:         %t\n", pp.Virus)
+	fmt.Fprintf(&b, "  No parse:      %t\n", pp.NoParse)
+	if pp.Annotation {
+		fmt.Fprintf(&b, "  Annotation:    cut at %d\n", pp.AnnotationStart)
+	} else {
+		b.WriteString("  Annotation:    false\n")
+	}
+	fmt.Fprintf(&b, "  Underscore:    %t\n", pp.Underscore)
+	fmt.Fprintf(&b, "  Body:          %s\n", strconv.Quote(pp.Body))
+	if pp.Tail != "" {
+		fmt.Fprintf(&b, "  Tail:          %s\n", strconv.Quote(pp.Tail))
+	}
+	if pp.Error != "" {
+		fmt.Fprintf(&b, "  Parser error:  %s\n", pp.Error)
+	}
+
+	if e.Tree != nil {
+		b.WriteString("\nPEG tree:\n")
+		writeRuleNode(&b, e.Tree, 1)
+	}
+
+	if len(e.Warnings) > 0 {
+		b.WriteString("\nWarnings:\n")
+		for _, v := range e.Warnings {
+			fmt.Fprintf(&b, "  [%d] %s <- %s\n", v.Quality, v.Warning, v.Source)
+		}
+	}
+
+	fmt.Fprintf(&b, "\nParsed: %t, quality: %d, cardinality: %d\n",
+		e.Parsed.Parsed, e.Parsed.ParseQuality, e.Parsed.Cardinality)
+	if e.Parsed.Canonical != nil {
+		fmt.Fprintf(&b, "Canonical: %s\n", e.Parsed.Canonical.Full)
+	}
+	return b.String()
+}
+
+func writeRuleNode(b *strings.Builder, n *RuleNode, depth int) {
+	fmt.Fprintf(b, "%s%s [%d:%d] %s\n", strings.Repeat("  ", depth),
+		n.Rule, n.Start, n.End, strconv.Quote(n.Value))
+	for _, c := range n.Children {
+		writeRuleNode(b, c, depth+1)
+	}
+}
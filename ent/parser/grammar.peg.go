@@ -19,23 +19,72 @@ const (
 	ruleUnknown pegRule = iota
 	ruleSciName
 	ruleTail
+	ruleCandidatus
+	ruleBacterialInfo
+	ruleBacterialSep
+	ruleBacterialElement
+	ruleBacterialDesignation
+	ruleDesignationRank
+	ruleDesignationRankWord
+	ruleDesignationValue
+	ruleStrain
+	ruleStrainCollected
+	ruleStrainCollection
+	ruleStrainNumber
+	ruleTypeStrain
+	ruleStrainNamed
+	ruleStrainPrefix
+	ruleStrainChar
 	ruleName
 	ruleHybridFormula
 	ruleHybridFormulaFull
 	ruleHybridFormulaPart
+	ruleHybridParent
+	ruleHybridFormulaParens
+	ruleHybridNameParens
+	ruleHybridFormulaChar
 	ruleNamedHybrid
 	ruleNamedSpeciesHybrid
 	ruleNamedGenusHybrid
 	ruleSingleName
+	ruleExtinctPrefix
+	ruleExtinctSuffix
+	ruleExtinctChar
+	ruleExtinctFossil
 	ruleNameUninomial
 	ruleNameApprox
 	ruleNameComp
+	ruleNameOpenNomen
+	ruleNamePhrase
+	rulePhraseRank
+	rulePhrase
+	rulePhraseChar
+	rulePhraseVoucher
+	ruleCollector
+	ruleCollectorChar
+	ruleVoucher
+	rulePhraseHerbarium
+	ruleNameOpenSpecies
+	ruleNameOpenInfrasp
+	ruleNameIndet
 	ruleNameSpecies
 	ruleGenusWord
 	ruleInfraspGroup
 	ruleInfraspEpithet
 	ruleSpeciesEpithet
+	ruleUncertainPrefix
+	ruleUncertainSuffix
 	ruleComparison
+	ruleOpenInfraspEpithet
+	ruleOpenQualifier
+	ruleAffinis
+	ruleNear
+	ruleQuestion
+	ruleIndeterminate
+	ruleIncertaeSedis
+	ruleGenSpIndet
+	ruleSpIndet
+	ruleSpInc
 	ruleRank
 	ruleRankNotho
 	ruleRankOtherUncommon
@@ -72,6 +121,7 @@ const (
 	ruleWord4
 	ruleMultiDashedWord
 	ruleHybridChar
+	ruleGraftChimaeraChar
 	ruleApproxNameIgnored
 	ruleApproximation
 	ruleAuthorship
@@ -93,7 +143,9 @@ const (
 	ruleAuthorSep2
 	ruleAuthorSepSpanish
 	ruleAuthorEx
+	ruleAuthorIn
 	ruleAuthorEmend
+	ruleAuthorCorrig
 	ruleAuthor
 	ruleAuthor0
 	ruleAuthor1
@@ -152,23 +204,72 @@ var rul3s = [...]string{
 	"Unknown",
 	"SciName",
 	"Tail",
+	"Candidatus",
+	"BacterialInfo",
+	"BacterialSep",
+	"BacterialElement",
+	"BacterialDesignation",
+	"DesignationRank",
+	"DesignationRankWord",
+	"DesignationValue",
+	"Strain",
+	"StrainCollected",
+	"StrainCollection",
+	"StrainNumber",
+	"TypeStrain",
+	"StrainNamed",
+	"StrainPrefix",
+	"StrainChar",
 	"Name",
 	"HybridFormula",
 	"HybridFormulaFull",
 	"HybridFormulaPart",
+	"HybridParent",
+	"HybridFormulaParens",
+	"HybridNameParens",
+	"HybridFormulaChar",
 	"NamedHybrid",
 	"NamedSpeciesHybrid",
 	"NamedGenusHybrid",
 	"SingleName",
+	"ExtinctPrefix",
+	"ExtinctSuffix",
+	"ExtinctChar",
+	"ExtinctFossil",
 	"NameUninomial",
 	"NameApprox",
 	"NameComp",
+	"NameOpenNomen",
+	"NamePhrase",
+	"PhraseRank",
+	"Phrase",
+	"PhraseChar",
+	"PhraseVoucher",
+	"Collector",
+	"CollectorChar",
+	"Voucher",
+	"PhraseHerbarium",
+	"NameOpenSpecies",
+	"NameOpenInfrasp",
+	"NameIndet",
 	"NameSpecies",
 	"GenusWord",
 	"InfraspGroup",
 	"InfraspEpithet",
 	"SpeciesEpithet",
+	"UncertainPrefix",
+	"UncertainSuffix",
 	"Comparison",
+	"OpenInfraspEpithet",
+	"OpenQualifier",
+	"Affinis",
+	"Near",
+	"Question",
+	"Indeterminate",
+	"IncertaeSedis",
+	"GenSpIndet",
+	"SpIndet",
+	"SpInc",
 	"Rank",
 	"RankNotho",
 	"RankOtherUncommon",
@@ -205,6 +306,7 @@ var rul3s = [...]string{
 	"Word4",
 	"MultiDashedWord",
 	"HybridChar",
+	"GraftChimaeraChar",
 	"ApproxNameIgnored",
 	"Approximation",
 	"Authorship",
@@ -226,7 +328,9 @@ var rul3s = [...]string{
 	"AuthorSep2",
 	"AuthorSepSpanish",
 	"AuthorEx",
+	"AuthorIn",
 	"AuthorEmend",
+	"AuthorCorrig",
 	"Author",
 	"Author0",
 	"Author1",
@@ -395,7 +499,7 @@ type Engine struct {
 
 	Buffer string
 	buffer []rune
-	rules  [130]func() bool
+	rules  [182]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -569,7 +673,7 @@ func (p *Engine) Init(options ...func(*Engine) error) error {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 SciName <- <(_? Name Tail END)> */
+		/* 0 SciName <- <(_? (Candidatus _)? Name (_ BacterialInfo)? Tail END)> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
@@ -584,9 +688,35 @@ func (p *Engine) Init(options ...func(*Engine) error) error {
 					position, tokenIndex = position2, tokenIndex2
 				}
 			l3:
+				{
+					position4, tokenIndex4 := position, tokenIndex
+					if !_rules[ruleCandidatus]() {
+						goto l4
+					}
+					if !_rules[rule_]() {
+						goto l4
+					}
+					goto l5
+				l4:
+					position, tokenIndex = position4, tokenIndex4
+				}
+			l5:
 				if !_rules[ruleName]() {
 					goto l0
 				}
+				{
+					position6, tokenIndex6 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l6
+					}
+					if !_rules[ruleBacterialInfo]() {
+						goto l6
+					}
+					goto l7
+				l6:
+					position, tokenIndex = position6, tokenIndex6
+				}
+			l7:
 				if !_rules[ruleTail]() {
 					goto l0
 				}
@@ -603,8683 +733,12143 @@ func (p *Engine) Init(options ...func(*Engine) error) error {
 		/* 1 Tail <- <((_ / ';' / ',') .*)?> */
 		func() bool {
 			{
-				position5 := position
+				position9 := position
 				{
-					position6, tokenIndex6 := position, tokenIndex
+					position10, tokenIndex10 := position, tokenIndex
 					{
-						position8, tokenIndex8 := position, tokenIndex
+						position12, tokenIndex12 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l9
+							goto l13
 						}
-						goto l8
-					l9:
-						position, tokenIndex = position8, tokenIndex8
+						goto l12
+					l13:
+						position, tokenIndex = position12, tokenIndex12
 						if buffer[position] != rune(';') {
-							goto l10
+							goto l14
 						}
 						position++
-						goto l8
-					l10:
-						position, tokenIndex = position8, tokenIndex8
+						goto l12
+					l14:
+						position, tokenIndex = position12, tokenIndex12
 						if buffer[position] != rune(',') {
-							goto l6
+							goto l10
 						}
 						position++
 					}
-				l8:
-				l11:
+				l12:
+				l15:
 					{
-						position12, tokenIndex12 := position, tokenIndex
+						position16, tokenIndex16 := position, tokenIndex
 						if !matchDot() {
-							goto l12
+							goto l16
 						}
-						goto l11
-					l12:
-						position, tokenIndex = position12, tokenIndex12
+						goto l15
+					l16:
+						position, tokenIndex = position16, tokenIndex16
 					}
-					goto l7
-				l6:
-					position, tokenIndex = position6, tokenIndex6
+					goto l11
+				l10:
+					position, tokenIndex = position10, tokenIndex10
 				}
-			l7:
-				add(ruleTail, position5)
+			l11:
+				add(ruleTail, position9)
 			}
 			return true
 		},
-		/* 2 Name <- <(NamedHybrid / HybridFormula / SingleName)> */
+		/* 2 Candidatus <- <('C' 'a' 'n' 'd' 'i' 'd' 'a' 't' 'u' 's')> */
 		func() bool {
-			position13, tokenIndex13 := position, tokenIndex
+			position17, tokenIndex17 := position, tokenIndex
 			{
-				position14 := position
-				{
-					position15, tokenIndex15 := position, tokenIndex
-					if !_rules[ruleNamedHybrid]() {
-						goto l16
-					}
-					goto l15
-				l16:
-					position, tokenIndex = position15, tokenIndex15
-					if !_rules[ruleHybridFormula]() {
-						goto l17
-					}
-					goto l15
-				l17:
-					position, tokenIndex = position15, tokenIndex15
-					if !_rules[ruleSingleName]() {
-						goto l13
-					}
+				position18 := position
+				if buffer[position] != rune('C') {
+					goto l17
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l17
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l17
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l17
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l17
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l17
 				}
-			l15:
-				add(ruleName, position14)
+				position++
+				if buffer[position] != rune('a') {
+					goto l17
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l17
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l17
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l17
+				}
+				position++
+				add(ruleCandidatus, position18)
 			}
 			return true
-		l13:
-			position, tokenIndex = position13, tokenIndex13
+		l17:
+			position, tokenIndex = position17, tokenIndex17
 			return false
 		},
-		/* 3 HybridFormula <- <(SingleName (_ (HybridFormulaPart / HybridFormulaFull))+)> */
+		/* 3 BacterialInfo <- <(BacterialElement (BacterialSep BacterialElement)*)> */
 		func() bool {
-			position18, tokenIndex18 := position, tokenIndex
+			position19, tokenIndex19 := position, tokenIndex
 			{
-				position19 := position
-				if !_rules[ruleSingleName]() {
-					goto l18
-				}
-				if !_rules[rule_]() {
-					goto l18
+				position20 := position
+				if !_rules[ruleBacterialElement]() {
+					goto l19
 				}
+			l21:
 				{
 					position22, tokenIndex22 := position, tokenIndex
-					if !_rules[ruleHybridFormulaPart]() {
-						goto l23
+					if !_rules[ruleBacterialSep]() {
+						goto l22
 					}
-					goto l22
-				l23:
-					position, tokenIndex = position22, tokenIndex22
-					if !_rules[ruleHybridFormulaFull]() {
-						goto l18
+					if !_rules[ruleBacterialElement]() {
+						goto l22
 					}
+					goto l21
+				l22:
+					position, tokenIndex = position22, tokenIndex22
 				}
-			l22:
-			l20:
+				add(ruleBacterialInfo, position20)
+			}
+			return true
+		l19:
+			position, tokenIndex = position19, tokenIndex19
+			return false
+		},
+		/* 4 BacterialSep <- <((_? ('=' / ',') _?) / _)> */
+		func() bool {
+			position23, tokenIndex23 := position, tokenIndex
+			{
+				position24 := position
 				{
-					position21, tokenIndex21 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l21
+					position25, tokenIndex25 := position, tokenIndex
+					{
+						position27, tokenIndex27 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l27
+						}
+						goto l28
+					l27:
+						position, tokenIndex = position27, tokenIndex27
 					}
+				l28:
 					{
-						position24, tokenIndex24 := position, tokenIndex
-						if !_rules[ruleHybridFormulaPart]() {
-							goto l25
+						position29, tokenIndex29 := position, tokenIndex
+						if buffer[position] != rune('=') {
+							goto l30
 						}
-						goto l24
-					l25:
-						position, tokenIndex = position24, tokenIndex24
-						if !_rules[ruleHybridFormulaFull]() {
-							goto l21
+						position++
+						goto l29
+					l30:
+						position, tokenIndex = position29, tokenIndex29
+						if buffer[position] != rune(',') {
+							goto l26
+						}
+						position++
+					}
+				l29:
+					{
+						position31, tokenIndex31 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l31
 						}
+						goto l32
+					l31:
+						position, tokenIndex = position31, tokenIndex31
+					}
+				l32:
+					goto l25
+				l26:
+					position, tokenIndex = position25, tokenIndex25
+					if !_rules[rule_]() {
+						goto l23
 					}
-				l24:
-					goto l20
-				l21:
-					position, tokenIndex = position21, tokenIndex21
 				}
-				add(ruleHybridFormula, position19)
+			l25:
+				add(ruleBacterialSep, position24)
 			}
 			return true
-		l18:
-			position, tokenIndex = position18, tokenIndex18
+		l23:
+			position, tokenIndex = position23, tokenIndex23
 			return false
 		},
-		/* 4 HybridFormulaFull <- <(HybridChar (_ SingleName)?)> */
+		/* 5 BacterialElement <- <(BacterialDesignation / Strain)> */
 		func() bool {
-			position26, tokenIndex26 := position, tokenIndex
+			position33, tokenIndex33 := position, tokenIndex
 			{
-				position27 := position
-				if !_rules[ruleHybridChar]() {
-					goto l26
-				}
+				position34 := position
 				{
-					position28, tokenIndex28 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l28
+					position35, tokenIndex35 := position, tokenIndex
+					if !_rules[ruleBacterialDesignation]() {
+						goto l36
 					}
-					if !_rules[ruleSingleName]() {
-						goto l28
+					goto l35
+				l36:
+					position, tokenIndex = position35, tokenIndex35
+					if !_rules[ruleStrain]() {
+						goto l33
 					}
-					goto l29
-				l28:
-					position, tokenIndex = position28, tokenIndex28
 				}
-			l29:
-				add(ruleHybridFormulaFull, position27)
+			l35:
+				add(ruleBacterialElement, position34)
 			}
 			return true
-		l26:
-			position, tokenIndex = position26, tokenIndex26
+		l33:
+			position, tokenIndex = position33, tokenIndex33
 			return false
 		},
-		/* 5 HybridFormulaPart <- <(HybridChar _ SpeciesEpithet (_ InfraspGroup)?)> */
+		/* 6 BacterialDesignation <- <(DesignationRank _ DesignationValue)> */
 		func() bool {
-			position30, tokenIndex30 := position, tokenIndex
+			position37, tokenIndex37 := position, tokenIndex
 			{
-				position31 := position
-				if !_rules[ruleHybridChar]() {
-					goto l30
+				position38 := position
+				if !_rules[ruleDesignationRank]() {
+					goto l37
 				}
 				if !_rules[rule_]() {
-					goto l30
-				}
-				if !_rules[ruleSpeciesEpithet]() {
-					goto l30
+					goto l37
 				}
-				{
-					position32, tokenIndex32 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l32
-					}
-					if !_rules[ruleInfraspGroup]() {
-						goto l32
-					}
-					goto l33
-				l32:
-					position, tokenIndex = position32, tokenIndex32
+				if !_rules[ruleDesignationValue]() {
+					goto l37
 				}
-			l33:
-				add(ruleHybridFormulaPart, position31)
+				add(ruleBacterialDesignation, position38)
 			}
 			return true
-		l30:
-			position, tokenIndex = position30, tokenIndex30
+		l37:
+			position, tokenIndex = position37, tokenIndex37
 			return false
 		},
-		/* 6 NamedHybrid <- <(NamedGenusHybrid / NamedSpeciesHybrid)> */
+		/* 7 DesignationRank <- <((DesignationRankWord &SpaceCharEOI) / ((('b' 'v') / ('s' 'v')) '.'))> */
 		func() bool {
-			position34, tokenIndex34 := position, tokenIndex
+			position39, tokenIndex39 := position, tokenIndex
 			{
-				position35 := position
+				position40 := position
 				{
-					position36, tokenIndex36 := position, tokenIndex
-					if !_rules[ruleNamedGenusHybrid]() {
-						goto l37
+					position41, tokenIndex41 := position, tokenIndex
+					if !_rules[ruleDesignationRankWord]() {
+						goto l42
 					}
-					goto l36
-				l37:
-					position, tokenIndex = position36, tokenIndex36
-					if !_rules[ruleNamedSpeciesHybrid]() {
-						goto l34
+					{
+						position43, tokenIndex43 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l42
+						}
+						position, tokenIndex = position43, tokenIndex43
+					}
+					goto l41
+				l42:
+					position, tokenIndex = position41, tokenIndex41
+					{
+						position44, tokenIndex44 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l45
+						}
+						position++
+						if buffer[position] != rune('v') {
+							goto l45
+						}
+						position++
+						goto l44
+					l45:
+						position, tokenIndex = position44, tokenIndex44
+						if buffer[position] != rune('s') {
+							goto l39
+						}
+						position++
+						if buffer[position] != rune('v') {
+							goto l39
+						}
+						position++
+					}
+				l44:
+					if buffer[position] != rune('.') {
+						goto l39
 					}
+					position++
 				}
-			l36:
-				add(ruleNamedHybrid, position35)
+			l41:
+				add(ruleDesignationRank, position40)
 			}
 			return true
-		l34:
-			position, tokenIndex = position34, tokenIndex34
+		l39:
+			position, tokenIndex = position39, tokenIndex39
 			return false
 		},
-		/* 7 NamedSpeciesHybrid <- <(GenusWord (_ Subgenus)? (_ Comparison)? _ HybridChar _? SpeciesEpithet (_ InfraspGroup)?)> */
+		/* 8 DesignationRankWord <- <(('s' 'e' 'r' 'o' 'v' 'a' 'r') / ('s' 'e' 'r' 'o' 't' 'y' 'p' 'e') / ('s' 'e' 'r' 'o' 'g' 'r' 'o' 'u' 'p') / ('b' 'i' 'o' 'v' 'a' 'r') / ('c' 'h' 'e' 'm' 'o' 'v' 'a' 'r') / ('m' 'o' 'r' 'p' 'h' 'o' 'v' 'a' 'r') / ('p' 'h' 'a' 'g' 'o' 'v' 'a' 'r'))> */
 		func() bool {
-			position38, tokenIndex38 := position, tokenIndex
+			position46, tokenIndex46 := position, tokenIndex
 			{
-				position39 := position
-				if !_rules[ruleGenusWord]() {
-					goto l38
-				}
+				position47 := position
 				{
-					position40, tokenIndex40 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l40
+					position48, tokenIndex48 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l49
 					}
-					if !_rules[ruleSubgenus]() {
-						goto l40
+					position++
+					if buffer[position] != rune('e') {
+						goto l49
 					}
-					goto l41
-				l40:
-					position, tokenIndex = position40, tokenIndex40
-				}
-			l41:
-				{
-					position42, tokenIndex42 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l42
+					position++
+					if buffer[position] != rune('r') {
+						goto l49
 					}
-					if !_rules[ruleComparison]() {
-						goto l42
+					position++
+					if buffer[position] != rune('o') {
+						goto l49
 					}
-					goto l43
-				l42:
-					position, tokenIndex = position42, tokenIndex42
-				}
-			l43:
-				if !_rules[rule_]() {
-					goto l38
-				}
-				if !_rules[ruleHybridChar]() {
-					goto l38
-				}
-				{
-					position44, tokenIndex44 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l44
+					position++
+					if buffer[position] != rune('v') {
+						goto l49
 					}
-					goto l45
-				l44:
-					position, tokenIndex = position44, tokenIndex44
-				}
-			l45:
-				if !_rules[ruleSpeciesEpithet]() {
-					goto l38
-				}
-				{
-					position46, tokenIndex46 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l46
+					position++
+					if buffer[position] != rune('a') {
+						goto l49
 					}
-					if !_rules[ruleInfraspGroup]() {
-						goto l46
+					position++
+					if buffer[position] != rune('r') {
+						goto l49
 					}
-					goto l47
-				l46:
-					position, tokenIndex = position46, tokenIndex46
-				}
-			l47:
-				add(ruleNamedSpeciesHybrid, position39)
-			}
-			return true
-		l38:
-			position, tokenIndex = position38, tokenIndex38
-			return false
-		},
-		/* 8 NamedGenusHybrid <- <(HybridChar _? SingleName)> */
-		func() bool {
-			position48, tokenIndex48 := position, tokenIndex
-			{
-				position49 := position
-				if !_rules[ruleHybridChar]() {
+					position++
 					goto l48
-				}
-				{
-					position50, tokenIndex50 := position, tokenIndex
-					if !_rules[rule_]() {
+				l49:
+					position, tokenIndex = position48, tokenIndex48
+					if buffer[position] != rune('s') {
 						goto l50
 					}
-					goto l51
-				l50:
-					position, tokenIndex = position50, tokenIndex50
-				}
-			l51:
-				if !_rules[ruleSingleName]() {
-					goto l48
-				}
-				add(ruleNamedGenusHybrid, position49)
-			}
-			return true
-		l48:
-			position, tokenIndex = position48, tokenIndex48
-			return false
-		},
-		/* 9 SingleName <- <(NameComp / NameApprox / NameSpecies / NameUninomial)> */
-		func() bool {
-			position52, tokenIndex52 := position, tokenIndex
-			{
-				position53 := position
-				{
-					position54, tokenIndex54 := position, tokenIndex
-					if !_rules[ruleNameComp]() {
-						goto l55
+					position++
+					if buffer[position] != rune('e') {
+						goto l50
 					}
-					goto l54
-				l55:
-					position, tokenIndex = position54, tokenIndex54
-					if !_rules[ruleNameApprox]() {
-						goto l56
+					position++
+					if buffer[position] != rune('r') {
+						goto l50
 					}
-					goto l54
-				l56:
-					position, tokenIndex = position54, tokenIndex54
-					if !_rules[ruleNameSpecies]() {
-						goto l57
+					position++
+					if buffer[position] != rune('o') {
+						goto l50
 					}
-					goto l54
-				l57:
-					position, tokenIndex = position54, tokenIndex54
-					if !_rules[ruleNameUninomial]() {
-						goto l52
+					position++
+					if buffer[position] != rune('t') {
+						goto l50
 					}
-				}
-			l54:
-				add(ruleSingleName, position53)
-			}
-			return true
-		l52:
-			position, tokenIndex = position52, tokenIndex52
-			return false
-		},
-		/* 10 NameUninomial <- <(UninomialCombo / Uninomial)> */
-		func() bool {
-			position58, tokenIndex58 := position, tokenIndex
-			{
-				position59 := position
-				{
-					position60, tokenIndex60 := position, tokenIndex
-					if !_rules[ruleUninomialCombo]() {
-						goto l61
+					position++
+					if buffer[position] != rune('y') {
+						goto l50
 					}
-					goto l60
-				l61:
-					position, tokenIndex = position60, tokenIndex60
-					if !_rules[ruleUninomial]() {
-						goto l58
+					position++
+					if buffer[position] != rune('p') {
+						goto l50
 					}
-				}
-			l60:
-				add(ruleNameUninomial, position59)
-			}
-			return true
-		l58:
-			position, tokenIndex = position58, tokenIndex58
-			return false
-		},
-		/* 11 NameApprox <- <(GenusWord (_ SpeciesEpithet)? _ Approximation ApproxNameIgnored)> */
-		func() bool {
-			position62, tokenIndex62 := position, tokenIndex
-			{
-				position63 := position
-				if !_rules[ruleGenusWord]() {
-					goto l62
-				}
-				{
-					position64, tokenIndex64 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l64
+					position++
+					if buffer[position] != rune('e') {
+						goto l50
 					}
-					if !_rules[ruleSpeciesEpithet]() {
-						goto l64
+					position++
+					goto l48
+				l50:
+					position, tokenIndex = position48, tokenIndex48
+					if buffer[position] != rune('s') {
+						goto l51
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l51
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l51
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l51
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l51
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l51
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l51
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l51
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l51
+					}
+					position++
+					goto l48
+				l51:
+					position, tokenIndex = position48, tokenIndex48
+					if buffer[position] != rune('b') {
+						goto l52
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l52
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l52
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l52
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l52
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l52
+					}
+					position++
+					goto l48
+				l52:
+					position, tokenIndex = position48, tokenIndex48
+					if buffer[position] != rune('c') {
+						goto l53
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l53
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l53
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l53
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l53
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l53
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l53
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l53
+					}
+					position++
+					goto l48
+				l53:
+					position, tokenIndex = position48, tokenIndex48
+					if buffer[position] != rune('m') {
+						goto l54
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l54
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l54
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l54
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l54
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l54
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l54
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l54
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l54
+					}
+					position++
+					goto l48
+				l54:
+					position, tokenIndex = position48, tokenIndex48
+					if buffer[position] != rune('p') {
+						goto l46
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l46
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l46
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l46
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l46
+					}
+					position++
+					if buffer[position] != rune('v') {
+						goto l46
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l46
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l46
+					}
+					position++
+				}
+			l48:
+				add(ruleDesignationRankWord, position47)
+			}
+			return true
+		l46:
+			position, tokenIndex = position46, tokenIndex46
+			return false
+		},
+		/* 9 DesignationValue <- <StrainChar+> */
+		func() bool {
+			position55, tokenIndex55 := position, tokenIndex
+			{
+				position56 := position
+				if !_rules[ruleStrainChar]() {
+					goto l55
+				}
+			l57:
+				{
+					position58, tokenIndex58 := position, tokenIndex
+					if !_rules[ruleStrainChar]() {
+						goto l58
+					}
+					goto l57
+				l58:
+					position, tokenIndex = position58, tokenIndex58
+				}
+				add(ruleDesignationValue, position56)
+			}
+			return true
+		l55:
+			position, tokenIndex = position55, tokenIndex55
+			return false
+		},
+		/* 10 Strain <- <(StrainCollected / StrainNamed)> */
+		func() bool {
+			position59, tokenIndex59 := position, tokenIndex
+			{
+				position60 := position
+				{
+					position61, tokenIndex61 := position, tokenIndex
+					if !_rules[ruleStrainCollected]() {
+						goto l62
+					}
+					goto l61
+				l62:
+					position, tokenIndex = position61, tokenIndex61
+					if !_rules[ruleStrainNamed]() {
+						goto l59
+					}
+				}
+			l61:
+				add(ruleStrain, position60)
+			}
+			return true
+		l59:
+			position, tokenIndex = position59, tokenIndex59
+			return false
+		},
+		/* 11 StrainCollected <- <(StrainCollection (_ / Dash)? StrainNumber TypeStrain? &(SpaceCharEOI / ',' / ';' / '=' / ')'))> */
+		func() bool {
+			position63, tokenIndex63 := position, tokenIndex
+			{
+				position64 := position
+				if !_rules[ruleStrainCollection]() {
+					goto l63
+				}
+				{
+					position65, tokenIndex65 := position, tokenIndex
+					{
+						position67, tokenIndex67 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l68
+						}
+						goto l67
+					l68:
+						position, tokenIndex = position67, tokenIndex67
+						if !_rules[ruleDash]() {
+							goto l65
+						}
+					}
+				l67:
+					goto l66
+				l65:
+					position, tokenIndex = position65, tokenIndex65
+				}
+			l66:
+				if !_rules[ruleStrainNumber]() {
+					goto l63
+				}
+				{
+					position69, tokenIndex69 := position, tokenIndex
+					if !_rules[ruleTypeStrain]() {
+						goto l69
+					}
+					goto l70
+				l69:
+					position, tokenIndex = position69, tokenIndex69
+				}
+			l70:
+				{
+					position71, tokenIndex71 := position, tokenIndex
+					{
+						position72, tokenIndex72 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l73
+						}
+						goto l72
+					l73:
+						position, tokenIndex = position72, tokenIndex72
+						if buffer[position] != rune(',') {
+							goto l74
+						}
+						position++
+						goto l72
+					l74:
+						position, tokenIndex = position72, tokenIndex72
+						if buffer[position] != rune(';') {
+							goto l75
+						}
+						position++
+						goto l72
+					l75:
+						position, tokenIndex = position72, tokenIndex72
+						if buffer[position] != rune('=') {
+							goto l76
+						}
+						position++
+						goto l72
+					l76:
+						position, tokenIndex = position72, tokenIndex72
+						if buffer[position] != rune(')') {
+							goto l63
+						}
+						position++
+					}
+				l72:
+					position, tokenIndex = position71, tokenIndex71
+				}
+				add(ruleStrainCollected, position64)
+			}
+			return true
+		l63:
+			position, tokenIndex = position63, tokenIndex63
+			return false
+		},
+		/* 12 StrainCollection <- <(('A' 'T' 'C' 'C') / ('C' 'C' 'U' 'G') / ('C' 'E' 'C' 'T') / ('C' 'I' 'P') / ('D' 'S' 'M' 'Z') / ('D' 'S' 'M') / ('I' 'C' 'M' 'P') / ('I' 'F' 'O') / ('J' 'C' 'M') / ('K' 'C' 'T' 'C') / ('L' 'M' 'G') / ('N' 'B' 'R' 'C') / ('N' 'C' 'C' 'B') / ('N' 'C' 'I' 'M' 'B') / ('N' 'C' 'P' 'P' 'B') / ('N' 'C' 'T' 'C') / ('N' 'R' 'R' 'L') / ('V' 'K' 'M'))> */
+		func() bool {
+			position77, tokenIndex77 := position, tokenIndex
+			{
+				position78 := position
+				{
+					position79, tokenIndex79 := position, tokenIndex
+					if buffer[position] != rune('A') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('T') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l80
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l80
+					}
+					position++
+					goto l79
+				l80:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('C') {
+						goto l81
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l81
+					}
+					position++
+					if buffer[position] != rune('U') {
+						goto l81
+					}
+					position++
+					if buffer[position] != rune('G') {
+						goto l81
+					}
+					position++
+					goto l79
+				l81:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('C') {
+						goto l82
+					}
+					position++
+					if buffer[position] != rune('E') {
+						goto l82
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l82
+					}
+					position++
+					if buffer[position] != rune('T') {
+						goto l82
+					}
+					position++
+					goto l79
+				l82:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('C') {
+						goto l83
+					}
+					position++
+					if buffer[position] != rune('I') {
+						goto l83
+					}
+					position++
+					if buffer[position] != rune('P') {
+						goto l83
+					}
+					position++
+					goto l79
+				l83:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('D') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('S') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('M') {
+						goto l84
+					}
+					position++
+					if buffer[position] != rune('Z') {
+						goto l84
+					}
+					position++
+					goto l79
+				l84:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('D') {
+						goto l85
+					}
+					position++
+					if buffer[position] != rune('S') {
+						goto l85
+					}
+					position++
+					if buffer[position] != rune('M') {
+						goto l85
+					}
+					position++
+					goto l79
+				l85:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('I') {
+						goto l86
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l86
+					}
+					position++
+					if buffer[position] != rune('M') {
+						goto l86
+					}
+					position++
+					if buffer[position] != rune('P') {
+						goto l86
+					}
+					position++
+					goto l79
+				l86:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('I') {
+						goto l87
+					}
+					position++
+					if buffer[position] != rune('F') {
+						goto l87
+					}
+					position++
+					if buffer[position] != rune('O') {
+						goto l87
+					}
+					position++
+					goto l79
+				l87:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('J') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l88
+					}
+					position++
+					if buffer[position] != rune('M') {
+						goto l88
+					}
+					position++
+					goto l79
+				l88:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('K') {
+						goto l89
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l89
+					}
+					position++
+					if buffer[position] != rune('T') {
+						goto l89
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l89
+					}
+					position++
+					goto l79
+				l89:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('L') {
+						goto l90
+					}
+					position++
+					if buffer[position] != rune('M') {
+						goto l90
+					}
+					position++
+					if buffer[position] != rune('G') {
+						goto l90
+					}
+					position++
+					goto l79
+				l90:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('N') {
+						goto l91
+					}
+					position++
+					if buffer[position] != rune('B') {
+						goto l91
+					}
+					position++
+					if buffer[position] != rune('R') {
+						goto l91
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l91
+					}
+					position++
+					goto l79
+				l91:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('N') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l92
+					}
+					position++
+					if buffer[position] != rune('B') {
+						goto l92
+					}
+					position++
+					goto l79
+				l92:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('N') {
+						goto l93
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l93
+					}
+					position++
+					if buffer[position] != rune('I') {
+						goto l93
+					}
+					position++
+					if buffer[position] != rune('M') {
+						goto l93
+					}
+					position++
+					if buffer[position] != rune('B') {
+						goto l93
+					}
+					position++
+					goto l79
+				l93:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('N') {
+						goto l94
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l94
+					}
+					position++
+					if buffer[position] != rune('P') {
+						goto l94
+					}
+					position++
+					if buffer[position] != rune('P') {
+						goto l94
+					}
+					position++
+					if buffer[position] != rune('B') {
+						goto l94
+					}
+					position++
+					goto l79
+				l94:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('N') {
+						goto l95
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l95
+					}
+					position++
+					if buffer[position] != rune('T') {
+						goto l95
+					}
+					position++
+					if buffer[position] != rune('C') {
+						goto l95
+					}
+					position++
+					goto l79
+				l95:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('N') {
+						goto l96
+					}
+					position++
+					if buffer[position] != rune('R') {
+						goto l96
+					}
+					position++
+					if buffer[position] != rune('R') {
+						goto l96
+					}
+					position++
+					if buffer[position] != rune('L') {
+						goto l96
+					}
+					position++
+					goto l79
+				l96:
+					position, tokenIndex = position79, tokenIndex79
+					if buffer[position] != rune('V') {
+						goto l77
+					}
+					position++
+					if buffer[position] != rune('K') {
+						goto l77
+					}
+					position++
+					if buffer[position] != rune('M') {
+						goto l77
+					}
+					position++
+				}
+			l79:
+				add(ruleStrainCollection, position78)
+			}
+			return true
+		l77:
+			position, tokenIndex = position77, tokenIndex77
+			return false
+		},
+		/* 13 StrainNumber <- <((UpperASCII+ Dash?)? Nums+ ((Dash / Slash / '.') Nums+)*)> */
+		func() bool {
+			position97, tokenIndex97 := position, tokenIndex
+			{
+				position98 := position
+				{
+					position99, tokenIndex99 := position, tokenIndex
+					if !_rules[ruleUpperASCII]() {
+						goto l99
+					}
+				l101:
+					{
+						position102, tokenIndex102 := position, tokenIndex
+						if !_rules[ruleUpperASCII]() {
+							goto l102
+						}
+						goto l101
+					l102:
+						position, tokenIndex = position102, tokenIndex102
+					}
+					{
+						position103, tokenIndex103 := position, tokenIndex
+						if !_rules[ruleDash]() {
+							goto l103
+						}
+						goto l104
+					l103:
+						position, tokenIndex = position103, tokenIndex103
+					}
+				l104:
+					goto l100
+				l99:
+					position, tokenIndex = position99, tokenIndex99
+				}
+			l100:
+				if !_rules[ruleNums]() {
+					goto l97
+				}
+			l105:
+				{
+					position106, tokenIndex106 := position, tokenIndex
+					if !_rules[ruleNums]() {
+						goto l106
+					}
+					goto l105
+				l106:
+					position, tokenIndex = position106, tokenIndex106
+				}
+			l107:
+				{
+					position108, tokenIndex108 := position, tokenIndex
+					{
+						position109, tokenIndex109 := position, tokenIndex
+						if !_rules[ruleDash]() {
+							goto l110
+						}
+						goto l109
+					l110:
+						position, tokenIndex = position109, tokenIndex109
+						if !_rules[ruleSlash]() {
+							goto l111
+						}
+						goto l109
+					l111:
+						position, tokenIndex = position109, tokenIndex109
+						if buffer[position] != rune('.') {
+							goto l108
+						}
+						position++
+					}
+				l109:
+					if !_rules[ruleNums]() {
+						goto l108
+					}
+				l112:
+					{
+						position113, tokenIndex113 := position, tokenIndex
+						if !_rules[ruleNums]() {
+							goto l113
+						}
+						goto l112
+					l113:
+						position, tokenIndex = position113, tokenIndex113
+					}
+					goto l107
+				l108:
+					position, tokenIndex = position108, tokenIndex108
+				}
+				add(ruleStrainNumber, position98)
+			}
+			return true
+		l97:
+			position, tokenIndex = position97, tokenIndex97
+			return false
+		},
+		/* 14 TypeStrain <- <('T' / ('^' 'T') / 'ᵀ')> */
+		func() bool {
+			position114, tokenIndex114 := position, tokenIndex
+			{
+				position115 := position
+				{
+					position116, tokenIndex116 := position, tokenIndex
+					if buffer[position] != rune('T') {
+						goto l117
+					}
+					position++
+					goto l116
+				l117:
+					position, tokenIndex = position116, tokenIndex116
+					if buffer[position] != rune('^') {
+						goto l118
+					}
+					position++
+					if buffer[position] != rune('T') {
+						goto l118
+					}
+					position++
+					goto l116
+				l118:
+					position, tokenIndex = position116, tokenIndex116
+					if buffer[position] != rune('ᵀ') {
+						goto l114
+					}
+					position++
+				}
+			l116:
+				add(ruleTypeStrain, position115)
+			}
+			return true
+		l114:
+			position, tokenIndex = position114, tokenIndex114
+			return false
+		},
+		/* 15 StrainNamed <- <(StrainPrefix _ StrainChar+)> */
+		func() bool {
+			position119, tokenIndex119 := position, tokenIndex
+			{
+				position120 := position
+				if !_rules[ruleStrainPrefix]() {
+					goto l119
+				}
+				if !_rules[rule_]() {
+					goto l119
+				}
+				if !_rules[ruleStrainChar]() {
+					goto l119
+				}
+			l121:
+				{
+					position122, tokenIndex122 := position, tokenIndex
+					if !_rules[ruleStrainChar]() {
+						goto l122
+					}
+					goto l121
+				l122:
+					position, tokenIndex = position122, tokenIndex122
+				}
+				add(ruleStrainNamed, position120)
+			}
+			return true
+		l119:
+			position, tokenIndex = position119, tokenIndex119
+			return false
+		},
+		/* 16 StrainPrefix <- <((('s' 't' 'r' 'a' 'i' 'n') / ('s' 't' 'r' '.'?)) &_)> */
+		func() bool {
+			position123, tokenIndex123 := position, tokenIndex
+			{
+				position124 := position
+				{
+					position125, tokenIndex125 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l126
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l126
+					}
+					position++
+					goto l125
+				l126:
+					position, tokenIndex = position125, tokenIndex125
+					if buffer[position] != rune('s') {
+						goto l123
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l123
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l123
+					}
+					position++
+					{
+						position127, tokenIndex127 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l127
+						}
+						position++
+						goto l128
+					l127:
+						position, tokenIndex = position127, tokenIndex127
+					}
+				l128:
+				}
+			l125:
+				{
+					position129, tokenIndex129 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l123
+					}
+					position, tokenIndex = position129, tokenIndex129
+				}
+				add(ruleStrainPrefix, position124)
+			}
+			return true
+		l123:
+			position, tokenIndex = position123, tokenIndex123
+			return false
+		},
+		/* 17 StrainChar <- <(!(_ / ',' / ';' / '=' / '(' / ')') .)> */
+		func() bool {
+			position130, tokenIndex130 := position, tokenIndex
+			{
+				position131 := position
+				{
+					position132, tokenIndex132 := position, tokenIndex
+					{
+						position133, tokenIndex133 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l134
+						}
+						goto l133
+					l134:
+						position, tokenIndex = position133, tokenIndex133
+						if buffer[position] != rune(',') {
+							goto l135
+						}
+						position++
+						goto l133
+					l135:
+						position, tokenIndex = position133, tokenIndex133
+						if buffer[position] != rune(';') {
+							goto l136
+						}
+						position++
+						goto l133
+					l136:
+						position, tokenIndex = position133, tokenIndex133
+						if buffer[position] != rune('=') {
+							goto l137
+						}
+						position++
+						goto l133
+					l137:
+						position, tokenIndex = position133, tokenIndex133
+						if buffer[position] != rune('(') {
+							goto l138
+						}
+						position++
+						goto l133
+					l138:
+						position, tokenIndex = position133, tokenIndex133
+						if buffer[position] != rune(')') {
+							goto l132
+						}
+						position++
+					}
+				l133:
+					goto l130
+				l132:
+					position, tokenIndex = position132, tokenIndex132
+				}
+				if !matchDot() {
+					goto l130
+				}
+				add(ruleStrainChar, position131)
+			}
+			return true
+		l130:
+			position, tokenIndex = position130, tokenIndex130
+			return false
+		},
+		/* 18 Name <- <(NamedHybrid / HybridFormula / SingleName)> */
+		func() bool {
+			position139, tokenIndex139 := position, tokenIndex
+			{
+				position140 := position
+				{
+					position141, tokenIndex141 := position, tokenIndex
+					if !_rules[ruleNamedHybrid]() {
+						goto l142
+					}
+					goto l141
+				l142:
+					position, tokenIndex = position141, tokenIndex141
+					if !_rules[ruleHybridFormula]() {
+						goto l143
+					}
+					goto l141
+				l143:
+					position, tokenIndex = position141, tokenIndex141
+					if !_rules[ruleSingleName]() {
+						goto l139
+					}
+				}
+			l141:
+				add(ruleName, position140)
+			}
+			return true
+		l139:
+			position, tokenIndex = position139, tokenIndex139
+			return false
+		},
+		/* 19 HybridFormula <- <(HybridParent (_ (HybridFormulaPart / HybridFormulaFull))+)> */
+		func() bool {
+			position144, tokenIndex144 := position, tokenIndex
+			{
+				position145 := position
+				if !_rules[ruleHybridParent]() {
+					goto l144
+				}
+				if !_rules[rule_]() {
+					goto l144
+				}
+				{
+					position148, tokenIndex148 := position, tokenIndex
+					if !_rules[ruleHybridFormulaPart]() {
+						goto l149
+					}
+					goto l148
+				l149:
+					position, tokenIndex = position148, tokenIndex148
+					if !_rules[ruleHybridFormulaFull]() {
+						goto l144
+					}
+				}
+			l148:
+			l146:
+				{
+					position147, tokenIndex147 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l147
+					}
+					{
+						position150, tokenIndex150 := position, tokenIndex
+						if !_rules[ruleHybridFormulaPart]() {
+							goto l151
+						}
+						goto l150
+					l151:
+						position, tokenIndex = position150, tokenIndex150
+						if !_rules[ruleHybridFormulaFull]() {
+							goto l147
+						}
+					}
+				l150:
+					goto l146
+				l147:
+					position, tokenIndex = position147, tokenIndex147
+				}
+				add(ruleHybridFormula, position145)
+			}
+			return true
+		l144:
+			position, tokenIndex = position144, tokenIndex144
+			return false
+		},
+		/* 20 HybridFormulaFull <- <(HybridFormulaChar (_ HybridParent)?)> */
+		func() bool {
+			position152, tokenIndex152 := position, tokenIndex
+			{
+				position153 := position
+				if !_rules[ruleHybridFormulaChar]() {
+					goto l152
+				}
+				{
+					position154, tokenIndex154 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l154
+					}
+					if !_rules[ruleHybridParent]() {
+						goto l154
+					}
+					goto l155
+				l154:
+					position, tokenIndex = position154, tokenIndex154
+				}
+			l155:
+				add(ruleHybridFormulaFull, position153)
+			}
+			return true
+		l152:
+			position, tokenIndex = position152, tokenIndex152
+			return false
+		},
+		/* 21 HybridFormulaPart <- <(HybridChar _ SpeciesEpithet (_ InfraspGroup)?)> */
+		func() bool {
+			position156, tokenIndex156 := position, tokenIndex
+			{
+				position157 := position
+				if !_rules[ruleHybridChar]() {
+					goto l156
+				}
+				if !_rules[rule_]() {
+					goto l156
+				}
+				if !_rules[ruleSpeciesEpithet]() {
+					goto l156
+				}
+				{
+					position158, tokenIndex158 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l158
+					}
+					if !_rules[ruleInfraspGroup]() {
+						goto l158
+					}
+					goto l159
+				l158:
+					position, tokenIndex = position158, tokenIndex158
+				}
+			l159:
+				add(ruleHybridFormulaPart, position157)
+			}
+			return true
+		l156:
+			position, tokenIndex = position156, tokenIndex156
+			return false
+		},
+		/* 22 HybridParent <- <(HybridFormulaParens / HybridNameParens / SingleName)> */
+		func() bool {
+			position160, tokenIndex160 := position, tokenIndex
+			{
+				position161 := position
+				{
+					position162, tokenIndex162 := position, tokenIndex
+					if !_rules[ruleHybridFormulaParens]() {
+						goto l163
+					}
+					goto l162
+				l163:
+					position, tokenIndex = position162, tokenIndex162
+					if !_rules[ruleHybridNameParens]() {
+						goto l164
+					}
+					goto l162
+				l164:
+					position, tokenIndex = position162, tokenIndex162
+					if !_rules[ruleSingleName]() {
+						goto l160
+					}
+				}
+			l162:
+				add(ruleHybridParent, position161)
+			}
+			return true
+		l160:
+			position, tokenIndex = position160, tokenIndex160
+			return false
+		},
+		/* 23 HybridFormulaParens <- <('(' _? HybridFormula _? ')')> */
+		func() bool {
+			position165, tokenIndex165 := position, tokenIndex
+			{
+				position166 := position
+				if buffer[position] != rune('(') {
+					goto l165
+				}
+				position++
+				{
+					position167, tokenIndex167 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l167
+					}
+					goto l168
+				l167:
+					position, tokenIndex = position167, tokenIndex167
+				}
+			l168:
+				if !_rules[ruleHybridFormula]() {
+					goto l165
+				}
+				{
+					position169, tokenIndex169 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l169
+					}
+					goto l170
+				l169:
+					position, tokenIndex = position169, tokenIndex169
+				}
+			l170:
+				if buffer[position] != rune(')') {
+					goto l165
+				}
+				position++
+				add(ruleHybridFormulaParens, position166)
+			}
+			return true
+		l165:
+			position, tokenIndex = position165, tokenIndex165
+			return false
+		},
+		/* 24 HybridNameParens <- <('(' _? SingleName _? ')')> */
+		func() bool {
+			position171, tokenIndex171 := position, tokenIndex
+			{
+				position172 := position
+				if buffer[position] != rune('(') {
+					goto l171
+				}
+				position++
+				{
+					position173, tokenIndex173 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l173
+					}
+					goto l174
+				l173:
+					position, tokenIndex = position173, tokenIndex173
+				}
+			l174:
+				if !_rules[ruleSingleName]() {
+					goto l171
+				}
+				{
+					position175, tokenIndex175 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l175
+					}
+					goto l176
+				l175:
+					position, tokenIndex = position175, tokenIndex175
+				}
+			l176:
+				if buffer[position] != rune(')') {
+					goto l171
+				}
+				position++
+				add(ruleHybridNameParens, position172)
+			}
+			return true
+		l171:
+			position, tokenIndex = position171, tokenIndex171
+			return false
+		},
+		/* 25 HybridFormulaChar <- <(HybridChar / GraftChimaeraChar)> */
+		func() bool {
+			position177, tokenIndex177 := position, tokenIndex
+			{
+				position178 := position
+				{
+					position179, tokenIndex179 := position, tokenIndex
+					if !_rules[ruleHybridChar]() {
+						goto l180
+					}
+					goto l179
+				l180:
+					position, tokenIndex = position179, tokenIndex179
+					if !_rules[ruleGraftChimaeraChar]() {
+						goto l177
+					}
+				}
+			l179:
+				add(ruleHybridFormulaChar, position178)
+			}
+			return true
+		l177:
+			position, tokenIndex = position177, tokenIndex177
+			return false
+		},
+		/* 26 NamedHybrid <- <(NamedGenusHybrid / NamedSpeciesHybrid)> */
+		func() bool {
+			position181, tokenIndex181 := position, tokenIndex
+			{
+				position182 := position
+				{
+					position183, tokenIndex183 := position, tokenIndex
+					if !_rules[ruleNamedGenusHybrid]() {
+						goto l184
+					}
+					goto l183
+				l184:
+					position, tokenIndex = position183, tokenIndex183
+					if !_rules[ruleNamedSpeciesHybrid]() {
+						goto l181
+					}
+				}
+			l183:
+				add(ruleNamedHybrid, position182)
+			}
+			return true
+		l181:
+			position, tokenIndex = position181, tokenIndex181
+			return false
+		},
+		/* 27 NamedSpeciesHybrid <- <(ExtinctPrefix? GenusWord (_ Subgenus)? (_ Comparison)? _ HybridChar _? SpeciesEpithet (_ InfraspGroup)? ExtinctSuffix?)> */
+		func() bool {
+			position185, tokenIndex185 := position, tokenIndex
+			{
+				position186 := position
+				{
+					position187, tokenIndex187 := position, tokenIndex
+					if !_rules[ruleExtinctPrefix]() {
+						goto l187
+					}
+					goto l188
+				l187:
+					position, tokenIndex = position187, tokenIndex187
+				}
+			l188:
+				if !_rules[ruleGenusWord]() {
+					goto l185
+				}
+				{
+					position189, tokenIndex189 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l189
+					}
+					if !_rules[ruleSubgenus]() {
+						goto l189
+					}
+					goto l190
+				l189:
+					position, tokenIndex = position189, tokenIndex189
+				}
+			l190:
+				{
+					position191, tokenIndex191 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l191
+					}
+					if !_rules[ruleComparison]() {
+						goto l191
+					}
+					goto l192
+				l191:
+					position, tokenIndex = position191, tokenIndex191
+				}
+			l192:
+				if !_rules[rule_]() {
+					goto l185
+				}
+				if !_rules[ruleHybridChar]() {
+					goto l185
+				}
+				{
+					position193, tokenIndex193 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l193
+					}
+					goto l194
+				l193:
+					position, tokenIndex = position193, tokenIndex193
+				}
+			l194:
+				if !_rules[ruleSpeciesEpithet]() {
+					goto l185
+				}
+				{
+					position195, tokenIndex195 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l195
+					}
+					if !_rules[ruleInfraspGroup]() {
+						goto l195
+					}
+					goto l196
+				l195:
+					position, tokenIndex = position195, tokenIndex195
+				}
+			l196:
+				{
+					position197, tokenIndex197 := position, tokenIndex
+					if !_rules[ruleExtinctSuffix]() {
+						goto l197
+					}
+					goto l198
+				l197:
+					position, tokenIndex = position197, tokenIndex197
+				}
+			l198:
+				add(ruleNamedSpeciesHybrid, position186)
+			}
+			return true
+		l185:
+			position, tokenIndex = position185, tokenIndex185
+			return false
+		},
+		/* 28 NamedGenusHybrid <- <(HybridFormulaChar _? SingleName)> */
+		func() bool {
+			position199, tokenIndex199 := position, tokenIndex
+			{
+				position200 := position
+				if !_rules[ruleHybridFormulaChar]() {
+					goto l199
+				}
+				{
+					position201, tokenIndex201 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l201
+					}
+					goto l202
+				l201:
+					position, tokenIndex = position201, tokenIndex201
+				}
+			l202:
+				if !_rules[ruleSingleName]() {
+					goto l199
+				}
+				add(ruleNamedGenusHybrid, position200)
+			}
+			return true
+		l199:
+			position, tokenIndex = position199, tokenIndex199
+			return false
+		},
+		/* 29 SingleName <- <(ExtinctPrefix? (NameComp / NameOpenNomen / NamePhrase / NameApprox / NameSpecies / NameUninomial) ExtinctSuffix?)> */
+		func() bool {
+			position203, tokenIndex203 := position, tokenIndex
+			{
+				position204 := position
+				{
+					position205, tokenIndex205 := position, tokenIndex
+					if !_rules[ruleExtinctPrefix]() {
+						goto l205
+					}
+					goto l206
+				l205:
+					position, tokenIndex = position205, tokenIndex205
+				}
+			l206:
+				{
+					position207, tokenIndex207 := position, tokenIndex
+					if !_rules[ruleNameComp]() {
+						goto l208
+					}
+					goto l207
+				l208:
+					position, tokenIndex = position207, tokenIndex207
+					if !_rules[ruleNameOpenNomen]() {
+						goto l209
+					}
+					goto l207
+				l209:
+					position, tokenIndex = position207, tokenIndex207
+					if !_rules[ruleNamePhrase]() {
+						goto l210
+					}
+					goto l207
+				l210:
+					position, tokenIndex = position207, tokenIndex207
+					if !_rules[ruleNameApprox]() {
+						goto l211
+					}
+					goto l207
+				l211:
+					position, tokenIndex = position207, tokenIndex207
+					if !_rules[ruleNameSpecies]() {
+						goto l212
+					}
+					goto l207
+				l212:
+					position, tokenIndex = position207, tokenIndex207
+					if !_rules[ruleNameUninomial]() {
+						goto l203
+					}
+				}
+			l207:
+				{
+					position213, tokenIndex213 := position, tokenIndex
+					if !_rules[ruleExtinctSuffix]() {
+						goto l213
+					}
+					goto l214
+				l213:
+					position, tokenIndex = position213, tokenIndex213
+				}
+			l214:
+				add(ruleSingleName, position204)
+			}
+			return true
+		l203:
+			position, tokenIndex = position203, tokenIndex203
+			return false
+		},
+		/* 30 ExtinctPrefix <- <(ExtinctChar _?)> */
+		func() bool {
+			position215, tokenIndex215 := position, tokenIndex
+			{
+				position216 := position
+				if !_rules[ruleExtinctChar]() {
+					goto l215
+				}
+				{
+					position217, tokenIndex217 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l217
+					}
+					goto l218
+				l217:
+					position, tokenIndex = position217, tokenIndex217
+				}
+			l218:
+				add(ruleExtinctPrefix, position216)
+			}
+			return true
+		l215:
+			position, tokenIndex = position215, tokenIndex215
+			return false
+		},
+		/* 31 ExtinctSuffix <- <(_? (ExtinctChar / ExtinctFossil))> */
+		func() bool {
+			position219, tokenIndex219 := position, tokenIndex
+			{
+				position220 := position
+				{
+					position221, tokenIndex221 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l221
+					}
+					goto l222
+				l221:
+					position, tokenIndex = position221, tokenIndex221
+				}
+			l222:
+				{
+					position223, tokenIndex223 := position, tokenIndex
+					if !_rules[ruleExtinctChar]() {
+						goto l224
+					}
+					goto l223
+				l224:
+					position, tokenIndex = position223, tokenIndex223
+					if !_rules[ruleExtinctFossil]() {
+						goto l219
+					}
+				}
+			l223:
+				add(ruleExtinctSuffix, position220)
+			}
+			return true
+		l219:
+			position, tokenIndex = position219, tokenIndex219
+			return false
+		},
+		/* 32 ExtinctChar <- <'†'> */
+		func() bool {
+			position225, tokenIndex225 := position, tokenIndex
+			{
+				position226 := position
+				if buffer[position] != rune('†') {
+					goto l225
+				}
+				position++
+				add(ruleExtinctChar, position226)
+			}
+			return true
+		l225:
+			position, tokenIndex = position225, tokenIndex225
+			return false
+		},
+		/* 33 ExtinctFossil <- <(('(' _? (('f' 'o' 's' 's' 'i' 'l') / '†') _? ')') / ('[' _? ('f' 'o' 's' 's' 'i' 'l') _? ']'))> */
+		func() bool {
+			position227, tokenIndex227 := position, tokenIndex
+			{
+				position228 := position
+				{
+					position229, tokenIndex229 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l230
+					}
+					position++
+					{
+						position231, tokenIndex231 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l231
+						}
+						goto l232
+					l231:
+						position, tokenIndex = position231, tokenIndex231
+					}
+				l232:
+					{
+						position233, tokenIndex233 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l234
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l234
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l234
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l234
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l234
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l234
+						}
+						position++
+						goto l233
+					l234:
+						position, tokenIndex = position233, tokenIndex233
+						if buffer[position] != rune('†') {
+							goto l230
+						}
+						position++
+					}
+				l233:
+					{
+						position235, tokenIndex235 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l235
+						}
+						goto l236
+					l235:
+						position, tokenIndex = position235, tokenIndex235
+					}
+				l236:
+					if buffer[position] != rune(')') {
+						goto l230
+					}
+					position++
+					goto l229
+				l230:
+					position, tokenIndex = position229, tokenIndex229
+					if buffer[position] != rune('[') {
+						goto l227
+					}
+					position++
+					{
+						position237, tokenIndex237 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l237
+						}
+						goto l238
+					l237:
+						position, tokenIndex = position237, tokenIndex237
+					}
+				l238:
+					if buffer[position] != rune('f') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l227
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l227
+					}
+					position++
+					{
+						position239, tokenIndex239 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l239
+						}
+						goto l240
+					l239:
+						position, tokenIndex = position239, tokenIndex239
+					}
+				l240:
+					if buffer[position] != rune(']') {
+						goto l227
+					}
+					position++
+				}
+			l229:
+				add(ruleExtinctFossil, position228)
+			}
+			return true
+		l227:
+			position, tokenIndex = position227, tokenIndex227
+			return false
+		},
+		/* 34 NameUninomial <- <(UninomialCombo / Uninomial)> */
+		func() bool {
+			position241, tokenIndex241 := position, tokenIndex
+			{
+				position242 := position
+				{
+					position243, tokenIndex243 := position, tokenIndex
+					if !_rules[ruleUninomialCombo]() {
+						goto l244
+					}
+					goto l243
+				l244:
+					position, tokenIndex = position243, tokenIndex243
+					if !_rules[ruleUninomial]() {
+						goto l241
+					}
+				}
+			l243:
+				add(ruleNameUninomial, position242)
+			}
+			return true
+		l241:
+			position, tokenIndex = position241, tokenIndex241
+			return false
+		},
+		/* 35 NameApprox <- <(GenusWord (_ SpeciesEpithet)? _ Approximation ApproxNameIgnored)> */
+		func() bool {
+			position245, tokenIndex245 := position, tokenIndex
+			{
+				position246 := position
+				if !_rules[ruleGenusWord]() {
+					goto l245
+				}
+				{
+					position247, tokenIndex247 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l247
+					}
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l247
+					}
+					goto l248
+				l247:
+					position, tokenIndex = position247, tokenIndex247
+				}
+			l248:
+				if !_rules[rule_]() {
+					goto l245
+				}
+				if !_rules[ruleApproximation]() {
+					goto l245
+				}
+				if !_rules[ruleApproxNameIgnored]() {
+					goto l245
+				}
+				add(ruleNameApprox, position246)
+			}
+			return true
+		l245:
+			position, tokenIndex = position245, tokenIndex245
+			return false
+		},
+		/* 36 NameComp <- <(GenusWord _ Comparison (_ SpeciesEpithet)?)> */
+		func() bool {
+			position249, tokenIndex249 := position, tokenIndex
+			{
+				position250 := position
+				if !_rules[ruleGenusWord]() {
+					goto l249
+				}
+				if !_rules[rule_]() {
+					goto l249
+				}
+				if !_rules[ruleComparison]() {
+					goto l249
+				}
+				{
+					position251, tokenIndex251 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l251
+					}
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l251
+					}
+					goto l252
+				l251:
+					position, tokenIndex = position251, tokenIndex251
+				}
+			l252:
+				add(ruleNameComp, position250)
+			}
+			return true
+		l249:
+			position, tokenIndex = position249, tokenIndex249
+			return false
+		},
+		/* 37 NameOpenNomen <- <(NameOpenSpecies / NameOpenInfrasp / NameIndet)> */
+		func() bool {
+			position253, tokenIndex253 := position, tokenIndex
+			{
+				position254 := position
+				{
+					position255, tokenIndex255 := position, tokenIndex
+					if !_rules[ruleNameOpenSpecies]() {
+						goto l256
+					}
+					goto l255
+				l256:
+					position, tokenIndex = position255, tokenIndex255
+					if !_rules[ruleNameOpenInfrasp]() {
+						goto l257
+					}
+					goto l255
+				l257:
+					position, tokenIndex = position255, tokenIndex255
+					if !_rules[ruleNameIndet]() {
+						goto l253
+					}
+				}
+			l255:
+				add(ruleNameOpenNomen, position254)
+			}
+			return true
+		l253:
+			position, tokenIndex = position253, tokenIndex253
+			return false
+		},
+		/* 38 NamePhrase <- <(GenusWord _ PhraseRank _ Phrase _ PhraseVoucher (_ (PhraseHerbarium / ('[' _? PhraseHerbarium _? ']')))?)> */
+		func() bool {
+			position258, tokenIndex258 := position, tokenIndex
+			{
+				position259 := position
+				if !_rules[ruleGenusWord]() {
+					goto l258
+				}
+				if !_rules[rule_]() {
+					goto l258
+				}
+				if !_rules[rulePhraseRank]() {
+					goto l258
+				}
+				if !_rules[rule_]() {
+					goto l258
+				}
+				if !_rules[rulePhrase]() {
+					goto l258
+				}
+				if !_rules[rule_]() {
+					goto l258
+				}
+				if !_rules[rulePhraseVoucher]() {
+					goto l258
+				}
+				{
+					position260, tokenIndex260 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l260
+					}
+					{
+						position262, tokenIndex262 := position, tokenIndex
+						if !_rules[rulePhraseHerbarium]() {
+							goto l263
+						}
+						goto l262
+					l263:
+						position, tokenIndex = position262, tokenIndex262
+						if buffer[position] != rune('[') {
+							goto l260
+						}
+						position++
+						{
+							position264, tokenIndex264 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l264
+							}
+							goto l265
+						l264:
+							position, tokenIndex = position264, tokenIndex264
+						}
+					l265:
+						if !_rules[rulePhraseHerbarium]() {
+							goto l260
+						}
+						{
+							position266, tokenIndex266 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l266
+							}
+							goto l267
+						l266:
+							position, tokenIndex = position266, tokenIndex266
+						}
+					l267:
+						if buffer[position] != rune(']') {
+							goto l260
+						}
+						position++
+					}
+				l262:
+					goto l261
+				l260:
+					position, tokenIndex = position260, tokenIndex260
+				}
+			l261:
+				add(ruleNamePhrase, position259)
+			}
+			return true
+		l258:
+			position, tokenIndex = position258, tokenIndex258
+			return false
+		},
+		/* 39 PhraseRank <- <('s' 'p' '.')> */
+		func() bool {
+			position268, tokenIndex268 := position, tokenIndex
+			{
+				position269 := position
+				if buffer[position] != rune('s') {
+					goto l268
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l268
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l268
+				}
+				position++
+				add(rulePhraseRank, position269)
+			}
+			return true
+		l268:
+			position, tokenIndex = position268, tokenIndex268
+			return false
+		},
+		/* 40 Phrase <- <((UpperASCII / Nums) PhraseChar* (_ PhraseChar+)*)> */
+		func() bool {
+			position270, tokenIndex270 := position, tokenIndex
+			{
+				position271 := position
+				{
+					position272, tokenIndex272 := position, tokenIndex
+					if !_rules[ruleUpperASCII]() {
+						goto l273
+					}
+					goto l272
+				l273:
+					position, tokenIndex = position272, tokenIndex272
+					if !_rules[ruleNums]() {
+						goto l270
+					}
+				}
+			l272:
+			l274:
+				{
+					position275, tokenIndex275 := position, tokenIndex
+					if !_rules[rulePhraseChar]() {
+						goto l275
+					}
+					goto l274
+				l275:
+					position, tokenIndex = position275, tokenIndex275
+				}
+			l276:
+				{
+					position277, tokenIndex277 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l277
+					}
+					if !_rules[rulePhraseChar]() {
+						goto l277
+					}
+				l278:
+					{
+						position279, tokenIndex279 := position, tokenIndex
+						if !_rules[rulePhraseChar]() {
+							goto l279
+						}
+						goto l278
+					l279:
+						position, tokenIndex = position279, tokenIndex279
+					}
+					goto l276
+				l277:
+					position, tokenIndex = position277, tokenIndex277
+				}
+				add(rulePhrase, position271)
+			}
+			return true
+		l270:
+			position, tokenIndex = position270, tokenIndex270
+			return false
+		},
+		/* 41 PhraseChar <- <(UpperASCII / LowerASCII / Nums / '.' / Dash / Apostrophe)> */
+		func() bool {
+			position280, tokenIndex280 := position, tokenIndex
+			{
+				position281 := position
+				{
+					position282, tokenIndex282 := position, tokenIndex
+					if !_rules[ruleUpperASCII]() {
+						goto l283
+					}
+					goto l282
+				l283:
+					position, tokenIndex = position282, tokenIndex282
+					if !_rules[ruleLowerASCII]() {
+						goto l284
+					}
+					goto l282
+				l284:
+					position, tokenIndex = position282, tokenIndex282
+					if !_rules[ruleNums]() {
+						goto l285
+					}
+					goto l282
+				l285:
+					position, tokenIndex = position282, tokenIndex282
+					if buffer[position] != rune('.') {
+						goto l286
+					}
+					position++
+					goto l282
+				l286:
+					position, tokenIndex = position282, tokenIndex282
+					if !_rules[ruleDash]() {
+						goto l287
+					}
+					goto l282
+				l287:
+					position, tokenIndex = position282, tokenIndex282
+					if !_rules[ruleApostrophe]() {
+						goto l280
+					}
+				}
+			l282:
+				add(rulePhraseChar, position281)
+			}
+			return true
+		l280:
+			position, tokenIndex = position280, tokenIndex280
+			return false
+		},
+		/* 42 PhraseVoucher <- <('(' _? Collector _ Voucher _? ')')> */
+		func() bool {
+			position288, tokenIndex288 := position, tokenIndex
+			{
+				position289 := position
+				if buffer[position] != rune('(') {
+					goto l288
+				}
+				position++
+				{
+					position290, tokenIndex290 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l290
+					}
+					goto l291
+				l290:
+					position, tokenIndex = position290, tokenIndex290
+				}
+			l291:
+				if !_rules[ruleCollector]() {
+					goto l288
+				}
+				if !_rules[rule_]() {
+					goto l288
+				}
+				if !_rules[ruleVoucher]() {
+					goto l288
+				}
+				{
+					position292, tokenIndex292 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l292
+					}
+					goto l293
+				l292:
+					position, tokenIndex = position292, tokenIndex292
+				}
+			l293:
+				if buffer[position] != rune(')') {
+					goto l288
+				}
+				position++
+				add(rulePhraseVoucher, position289)
+			}
+			return true
+		l288:
+			position, tokenIndex = position288, tokenIndex288
+			return false
+		},
+		/* 43 Collector <- <(UpperASCII '.' CollectorChar* (_ !(Voucher _? ')') CollectorChar+)*)> */
+		func() bool {
+			position294, tokenIndex294 := position, tokenIndex
+			{
+				position295 := position
+				if !_rules[ruleUpperASCII]() {
+					goto l294
+				}
+				if buffer[position] != rune('.') {
+					goto l294
+				}
+				position++
+			l296:
+				{
+					position297, tokenIndex297 := position, tokenIndex
+					if !_rules[ruleCollectorChar]() {
+						goto l297
+					}
+					goto l296
+				l297:
+					position, tokenIndex = position297, tokenIndex297
+				}
+			l298:
+				{
+					position299, tokenIndex299 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l299
+					}
+					{
+						position300, tokenIndex300 := position, tokenIndex
+						if !_rules[ruleVoucher]() {
+							goto l300
+						}
+						{
+							position301, tokenIndex301 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l301
+							}
+							goto l302
+						l301:
+							position, tokenIndex = position301, tokenIndex301
+						}
+					l302:
+						if buffer[position] != rune(')') {
+							goto l300
+						}
+						position++
+						goto l299
+					l300:
+						position, tokenIndex = position300, tokenIndex300
+					}
+					if !_rules[ruleCollectorChar]() {
+						goto l299
+					}
+				l303:
+					{
+						position304, tokenIndex304 := position, tokenIndex
+						if !_rules[ruleCollectorChar]() {
+							goto l304
+						}
+						goto l303
+					l304:
+						position, tokenIndex = position304, tokenIndex304
+					}
+					goto l298
+				l299:
+					position, tokenIndex = position299, tokenIndex299
+				}
+				add(ruleCollector, position295)
+			}
+			return true
+		l294:
+			position, tokenIndex = position294, tokenIndex294
+			return false
+		},
+		/* 44 CollectorChar <- <(!(SingleSpace / '(' / ')') .)> */
+		func() bool {
+			position305, tokenIndex305 := position, tokenIndex
+			{
+				position306 := position
+				{
+					position307, tokenIndex307 := position, tokenIndex
+					{
+						position308, tokenIndex308 := position, tokenIndex
+						if !_rules[ruleSingleSpace]() {
+							goto l309
+						}
+						goto l308
+					l309:
+						position, tokenIndex = position308, tokenIndex308
+						if buffer[position] != rune('(') {
+							goto l310
+						}
+						position++
+						goto l308
+					l310:
+						position, tokenIndex = position308, tokenIndex308
+						if buffer[position] != rune(')') {
+							goto l307
+						}
+						position++
+					}
+				l308:
+					goto l305
+				l307:
+					position, tokenIndex = position307, tokenIndex307
+				}
+				if !matchDot() {
+					goto l305
+				}
+				add(ruleCollectorChar, position306)
+			}
+			return true
+		l305:
+			position, tokenIndex = position305, tokenIndex305
+			return false
+		},
+		/* 45 Voucher <- <(Nums (!(SingleSpace / ')') .)*)> */
+		func() bool {
+			position311, tokenIndex311 := position, tokenIndex
+			{
+				position312 := position
+				if !_rules[ruleNums]() {
+					goto l311
+				}
+			l313:
+				{
+					position314, tokenIndex314 := position, tokenIndex
+					{
+						position315, tokenIndex315 := position, tokenIndex
+						{
+							position316, tokenIndex316 := position, tokenIndex
+							if !_rules[ruleSingleSpace]() {
+								goto l317
+							}
+							goto l316
+						l317:
+							position, tokenIndex = position316, tokenIndex316
+							if buffer[position] != rune(')') {
+								goto l315
+							}
+							position++
+						}
+					l316:
+						goto l314
+					l315:
+						position, tokenIndex = position315, tokenIndex315
+					}
+					if !matchDot() {
+						goto l314
+					}
+					goto l313
+				l314:
+					position, tokenIndex = position314, tokenIndex314
+				}
+				add(ruleVoucher, position312)
+			}
+			return true
+		l311:
+			position, tokenIndex = position311, tokenIndex311
+			return false
+		},
+		/* 46 PhraseHerbarium <- <((UpperASCII (UpperASCII / LowerASCII)* _ ('H' 'e' 'r' 'b' 'a' 'r' 'i' 'u' 'm')) / (UpperASCII UpperASCII+ &(SpaceCharEOI / ']')))> */
+		func() bool {
+			position318, tokenIndex318 := position, tokenIndex
+			{
+				position319 := position
+				{
+					position320, tokenIndex320 := position, tokenIndex
+					if !_rules[ruleUpperASCII]() {
+						goto l321
+					}
+				l322:
+					{
+						position323, tokenIndex323 := position, tokenIndex
+						{
+							position324, tokenIndex324 := position, tokenIndex
+							if !_rules[ruleUpperASCII]() {
+								goto l325
+							}
+							goto l324
+						l325:
+							position, tokenIndex = position324, tokenIndex324
+							if !_rules[ruleLowerASCII]() {
+								goto l323
+							}
+						}
+					l324:
+						goto l322
+					l323:
+						position, tokenIndex = position323, tokenIndex323
+					}
+					if !_rules[rule_]() {
+						goto l321
+					}
+					if buffer[position] != rune('H') {
+						goto l321
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l321
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l321
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l321
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l321
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l321
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l321
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l321
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l321
+					}
+					position++
+					goto l320
+				l321:
+					position, tokenIndex = position320, tokenIndex320
+					if !_rules[ruleUpperASCII]() {
+						goto l318
+					}
+					if !_rules[ruleUpperASCII]() {
+						goto l318
+					}
+				l326:
+					{
+						position327, tokenIndex327 := position, tokenIndex
+						if !_rules[ruleUpperASCII]() {
+							goto l327
+						}
+						goto l326
+					l327:
+						position, tokenIndex = position327, tokenIndex327
+					}
+					{
+						position328, tokenIndex328 := position, tokenIndex
+						{
+							position329, tokenIndex329 := position, tokenIndex
+							if !_rules[ruleSpaceCharEOI]() {
+								goto l330
+							}
+							goto l329
+						l330:
+							position, tokenIndex = position329, tokenIndex329
+							if buffer[position] != rune(']') {
+								goto l318
+							}
+							position++
+						}
+					l329:
+						position, tokenIndex = position328, tokenIndex328
+					}
+				}
+			l320:
+				add(rulePhraseHerbarium, position319)
+			}
+			return true
+		l318:
+			position, tokenIndex = position318, tokenIndex318
+			return false
+		},
+		/* 47 NameOpenSpecies <- <(GenusWord _ OpenQualifier _ SpeciesEpithet)> */
+		func() bool {
+			position331, tokenIndex331 := position, tokenIndex
+			{
+				position332 := position
+				if !_rules[ruleGenusWord]() {
+					goto l331
+				}
+				if !_rules[rule_]() {
+					goto l331
+				}
+				if !_rules[ruleOpenQualifier]() {
+					goto l331
+				}
+				if !_rules[rule_]() {
+					goto l331
+				}
+				if !_rules[ruleSpeciesEpithet]() {
+					goto l331
+				}
+				add(ruleNameOpenSpecies, position332)
+			}
+			return true
+		l331:
+			position, tokenIndex = position331, tokenIndex331
+			return false
+		},
+		/* 48 NameOpenInfrasp <- <(GenusWord _ SpeciesEpithet _ OpenInfraspEpithet)> */
+		func() bool {
+			position333, tokenIndex333 := position, tokenIndex
+			{
+				position334 := position
+				if !_rules[ruleGenusWord]() {
+					goto l333
+				}
+				if !_rules[rule_]() {
+					goto l333
+				}
+				if !_rules[ruleSpeciesEpithet]() {
+					goto l333
+				}
+				if !_rules[rule_]() {
+					goto l333
+				}
+				if !_rules[ruleOpenInfraspEpithet]() {
+					goto l333
+				}
+				add(ruleNameOpenInfrasp, position334)
+			}
+			return true
+		l333:
+			position, tokenIndex = position333, tokenIndex333
+			return false
+		},
+		/* 49 NameIndet <- <(GenusWord (_ !Indeterminate SpeciesEpithet)? _ Indeterminate)> */
+		func() bool {
+			position335, tokenIndex335 := position, tokenIndex
+			{
+				position336 := position
+				if !_rules[ruleGenusWord]() {
+					goto l335
+				}
+				{
+					position337, tokenIndex337 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l337
+					}
+					{
+						position339, tokenIndex339 := position, tokenIndex
+						if !_rules[ruleIndeterminate]() {
+							goto l339
+						}
+						goto l337
+					l339:
+						position, tokenIndex = position339, tokenIndex339
+					}
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l337
+					}
+					goto l338
+				l337:
+					position, tokenIndex = position337, tokenIndex337
+				}
+			l338:
+				if !_rules[rule_]() {
+					goto l335
+				}
+				if !_rules[ruleIndeterminate]() {
+					goto l335
+				}
+				add(ruleNameIndet, position336)
+			}
+			return true
+		l335:
+			position, tokenIndex = position335, tokenIndex335
+			return false
+		},
+		/* 50 NameSpecies <- <(GenusWord (_? (Subgenus / SubgenusOrSuperspecies))? _ SpeciesEpithet (_ InfraspGroup)?)> */
+		func() bool {
+			position340, tokenIndex340 := position, tokenIndex
+			{
+				position341 := position
+				if !_rules[ruleGenusWord]() {
+					goto l340
+				}
+				{
+					position342, tokenIndex342 := position, tokenIndex
+					{
+						position344, tokenIndex344 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l344
+						}
+						goto l345
+					l344:
+						position, tokenIndex = position344, tokenIndex344
+					}
+				l345:
+					{
+						position346, tokenIndex346 := position, tokenIndex
+						if !_rules[ruleSubgenus]() {
+							goto l347
+						}
+						goto l346
+					l347:
+						position, tokenIndex = position346, tokenIndex346
+						if !_rules[ruleSubgenusOrSuperspecies]() {
+							goto l342
+						}
+					}
+				l346:
+					goto l343
+				l342:
+					position, tokenIndex = position342, tokenIndex342
+				}
+			l343:
+				if !_rules[rule_]() {
+					goto l340
+				}
+				if !_rules[ruleSpeciesEpithet]() {
+					goto l340
+				}
+				{
+					position348, tokenIndex348 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l348
+					}
+					if !_rules[ruleInfraspGroup]() {
+						goto l348
+					}
+					goto l349
+				l348:
+					position, tokenIndex = position348, tokenIndex348
+				}
+			l349:
+				add(ruleNameSpecies, position341)
+			}
+			return true
+		l340:
+			position, tokenIndex = position340, tokenIndex340
+			return false
+		},
+		/* 51 GenusWord <- <((AbbrGenus / UninomialWord) !(_ AuthorWord))> */
+		func() bool {
+			position350, tokenIndex350 := position, tokenIndex
+			{
+				position351 := position
+				{
+					position352, tokenIndex352 := position, tokenIndex
+					if !_rules[ruleAbbrGenus]() {
+						goto l353
+					}
+					goto l352
+				l353:
+					position, tokenIndex = position352, tokenIndex352
+					if !_rules[ruleUninomialWord]() {
+						goto l350
+					}
+				}
+			l352:
+				{
+					position354, tokenIndex354 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l354
+					}
+					if !_rules[ruleAuthorWord]() {
+						goto l354
+					}
+					goto l350
+				l354:
+					position, tokenIndex = position354, tokenIndex354
+				}
+				add(ruleGenusWord, position351)
+			}
+			return true
+		l350:
+			position, tokenIndex = position350, tokenIndex350
+			return false
+		},
+		/* 52 InfraspGroup <- <(InfraspEpithet (_ InfraspEpithet)? (_ InfraspEpithet)?)> */
+		func() bool {
+			position355, tokenIndex355 := position, tokenIndex
+			{
+				position356 := position
+				if !_rules[ruleInfraspEpithet]() {
+					goto l355
+				}
+				{
+					position357, tokenIndex357 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l357
+					}
+					if !_rules[ruleInfraspEpithet]() {
+						goto l357
+					}
+					goto l358
+				l357:
+					position, tokenIndex = position357, tokenIndex357
+				}
+			l358:
+				{
+					position359, tokenIndex359 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l359
+					}
+					if !_rules[ruleInfraspEpithet]() {
+						goto l359
+					}
+					goto l360
+				l359:
+					position, tokenIndex = position359, tokenIndex359
+				}
+			l360:
+				add(ruleInfraspGroup, position356)
+			}
+			return true
+		l355:
+			position, tokenIndex = position355, tokenIndex355
+			return false
+		},
+		/* 53 InfraspEpithet <- <((Rank _?)? !(AuthorEx / (AuthorIn _)) UncertainPrefix? ExtinctPrefix? Word ExtinctChar? UncertainSuffix? (_ Authorship)?)> */
+		func() bool {
+			position361, tokenIndex361 := position, tokenIndex
+			{
+				position362 := position
+				{
+					position363, tokenIndex363 := position, tokenIndex
+					if !_rules[ruleRank]() {
+						goto l363
+					}
+					{
+						position365, tokenIndex365 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l365
+						}
+						goto l366
+					l365:
+						position, tokenIndex = position365, tokenIndex365
+					}
+				l366:
+					goto l364
+				l363:
+					position, tokenIndex = position363, tokenIndex363
+				}
+			l364:
+				{
+					position367, tokenIndex367 := position, tokenIndex
+					{
+						position368, tokenIndex368 := position, tokenIndex
+						if !_rules[ruleAuthorEx]() {
+							goto l369
+						}
+						goto l368
+					l369:
+						position, tokenIndex = position368, tokenIndex368
+						if !_rules[ruleAuthorIn]() {
+							goto l367
+						}
+						if !_rules[rule_]() {
+							goto l367
+						}
+					}
+				l368:
+					goto l361
+				l367:
+					position, tokenIndex = position367, tokenIndex367
+				}
+				{
+					position370, tokenIndex370 := position, tokenIndex
+					if !_rules[ruleUncertainPrefix]() {
+						goto l370
+					}
+					goto l371
+				l370:
+					position, tokenIndex = position370, tokenIndex370
+				}
+			l371:
+				{
+					position372, tokenIndex372 := position, tokenIndex
+					if !_rules[ruleExtinctPrefix]() {
+						goto l372
+					}
+					goto l373
+				l372:
+					position, tokenIndex = position372, tokenIndex372
+				}
+			l373:
+				if !_rules[ruleWord]() {
+					goto l361
+				}
+				{
+					position374, tokenIndex374 := position, tokenIndex
+					if !_rules[ruleExtinctChar]() {
+						goto l374
+					}
+					goto l375
+				l374:
+					position, tokenIndex = position374, tokenIndex374
+				}
+			l375:
+				{
+					position376, tokenIndex376 := position, tokenIndex
+					if !_rules[ruleUncertainSuffix]() {
+						goto l376
+					}
+					goto l377
+				l376:
+					position, tokenIndex = position376, tokenIndex376
+				}
+			l377:
+				{
+					position378, tokenIndex378 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l378
+					}
+					if !_rules[ruleAuthorship]() {
+						goto l378
+					}
+					goto l379
+				l378:
+					position, tokenIndex = position378, tokenIndex378
+				}
+			l379:
+				add(ruleInfraspEpithet, position362)
+			}
+			return true
+		l361:
+			position, tokenIndex = position361, tokenIndex361
+			return false
+		},
+		/* 54 SpeciesEpithet <- <(!(AuthorEx / (AuthorIn _)) UncertainPrefix? ExtinctPrefix? Word ExtinctChar? UncertainSuffix? (_? !(Question _ NameLowerChar) Authorship)?)> */
+		func() bool {
+			position380, tokenIndex380 := position, tokenIndex
+			{
+				position381 := position
+				{
+					position382, tokenIndex382 := position, tokenIndex
+					{
+						position383, tokenIndex383 := position, tokenIndex
+						if !_rules[ruleAuthorEx]() {
+							goto l384
+						}
+						goto l383
+					l384:
+						position, tokenIndex = position383, tokenIndex383
+						if !_rules[ruleAuthorIn]() {
+							goto l382
+						}
+						if !_rules[rule_]() {
+							goto l382
+						}
+					}
+				l383:
+					goto l380
+				l382:
+					position, tokenIndex = position382, tokenIndex382
+				}
+				{
+					position385, tokenIndex385 := position, tokenIndex
+					if !_rules[ruleUncertainPrefix]() {
+						goto l385
+					}
+					goto l386
+				l385:
+					position, tokenIndex = position385, tokenIndex385
+				}
+			l386:
+				{
+					position387, tokenIndex387 := position, tokenIndex
+					if !_rules[ruleExtinctPrefix]() {
+						goto l387
+					}
+					goto l388
+				l387:
+					position, tokenIndex = position387, tokenIndex387
+				}
+			l388:
+				if !_rules[ruleWord]() {
+					goto l380
+				}
+				{
+					position389, tokenIndex389 := position, tokenIndex
+					if !_rules[ruleExtinctChar]() {
+						goto l389
+					}
+					goto l390
+				l389:
+					position, tokenIndex = position389, tokenIndex389
+				}
+			l390:
+				{
+					position391, tokenIndex391 := position, tokenIndex
+					if !_rules[ruleUncertainSuffix]() {
+						goto l391
 					}
-					goto l65
-				l64:
-					position, tokenIndex = position64, tokenIndex64
+					goto l392
+				l391:
+					position, tokenIndex = position391, tokenIndex391
+				}
+			l392:
+				{
+					position393, tokenIndex393 := position, tokenIndex
+					{
+						position395, tokenIndex395 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l395
+						}
+						goto l396
+					l395:
+						position, tokenIndex = position395, tokenIndex395
+					}
+				l396:
+					{
+						position397, tokenIndex397 := position, tokenIndex
+						if !_rules[ruleQuestion]() {
+							goto l397
+						}
+						if !_rules[rule_]() {
+							goto l397
+						}
+						if !_rules[ruleNameLowerChar]() {
+							goto l397
+						}
+						goto l393
+					l397:
+						position, tokenIndex = position397, tokenIndex397
+					}
+					if !_rules[ruleAuthorship]() {
+						goto l393
+					}
+					goto l394
+				l393:
+					position, tokenIndex = position393, tokenIndex393
+				}
+			l394:
+				add(ruleSpeciesEpithet, position381)
+			}
+			return true
+		l380:
+			position, tokenIndex = position380, tokenIndex380
+			return false
+		},
+		/* 55 UncertainPrefix <- <(('(' '?' ')' _) / ('?' &NameLowerChar))> */
+		func() bool {
+			position398, tokenIndex398 := position, tokenIndex
+			{
+				position399 := position
+				{
+					position400, tokenIndex400 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l401
+					}
+					position++
+					if buffer[position] != rune('?') {
+						goto l401
+					}
+					position++
+					if buffer[position] != rune(')') {
+						goto l401
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l401
+					}
+					goto l400
+				l401:
+					position, tokenIndex = position400, tokenIndex400
+					if buffer[position] != rune('?') {
+						goto l398
+					}
+					position++
+					{
+						position402, tokenIndex402 := position, tokenIndex
+						if !_rules[ruleNameLowerChar]() {
+							goto l398
+						}
+						position, tokenIndex = position402, tokenIndex402
+					}
+				}
+			l400:
+				add(ruleUncertainPrefix, position399)
+			}
+			return true
+		l398:
+			position, tokenIndex = position398, tokenIndex398
+			return false
+		},
+		/* 56 UncertainSuffix <- <('?' &(SpaceCharEOI / ','))> */
+		func() bool {
+			position403, tokenIndex403 := position, tokenIndex
+			{
+				position404 := position
+				if buffer[position] != rune('?') {
+					goto l403
+				}
+				position++
+				{
+					position405, tokenIndex405 := position, tokenIndex
+					{
+						position406, tokenIndex406 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l407
+						}
+						goto l406
+					l407:
+						position, tokenIndex = position406, tokenIndex406
+						if buffer[position] != rune(',') {
+							goto l403
+						}
+						position++
+					}
+				l406:
+					position, tokenIndex = position405, tokenIndex405
+				}
+				add(ruleUncertainSuffix, position404)
+			}
+			return true
+		l403:
+			position, tokenIndex = position403, tokenIndex403
+			return false
+		},
+		/* 57 Comparison <- <('c' 'f' '.'?)> */
+		func() bool {
+			position408, tokenIndex408 := position, tokenIndex
+			{
+				position409 := position
+				if buffer[position] != rune('c') {
+					goto l408
+				}
+				position++
+				if buffer[position] != rune('f') {
+					goto l408
+				}
+				position++
+				{
+					position410, tokenIndex410 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l410
+					}
+					position++
+					goto l411
+				l410:
+					position, tokenIndex = position410, tokenIndex410
+				}
+			l411:
+				add(ruleComparison, position409)
+			}
+			return true
+		l408:
+			position, tokenIndex = position408, tokenIndex408
+			return false
+		},
+		/* 58 OpenInfraspEpithet <- <((Rank _?)? OpenQualifier _ Word (_ Authorship)?)> */
+		func() bool {
+			position412, tokenIndex412 := position, tokenIndex
+			{
+				position413 := position
+				{
+					position414, tokenIndex414 := position, tokenIndex
+					if !_rules[ruleRank]() {
+						goto l414
+					}
+					{
+						position416, tokenIndex416 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l416
+						}
+						goto l417
+					l416:
+						position, tokenIndex = position416, tokenIndex416
+					}
+				l417:
+					goto l415
+				l414:
+					position, tokenIndex = position414, tokenIndex414
+				}
+			l415:
+				if !_rules[ruleOpenQualifier]() {
+					goto l412
 				}
-			l65:
 				if !_rules[rule_]() {
-					goto l62
+					goto l412
 				}
-				if !_rules[ruleApproximation]() {
-					goto l62
+				if !_rules[ruleWord]() {
+					goto l412
+				}
+				{
+					position418, tokenIndex418 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l418
+					}
+					if !_rules[ruleAuthorship]() {
+						goto l418
+					}
+					goto l419
+				l418:
+					position, tokenIndex = position418, tokenIndex418
 				}
-				if !_rules[ruleApproxNameIgnored]() {
-					goto l62
+			l419:
+				add(ruleOpenInfraspEpithet, position413)
+			}
+			return true
+		l412:
+			position, tokenIndex = position412, tokenIndex412
+			return false
+		},
+		/* 59 OpenQualifier <- <(Comparison / Affinis / Near / Question)> */
+		func() bool {
+			position420, tokenIndex420 := position, tokenIndex
+			{
+				position421 := position
+				{
+					position422, tokenIndex422 := position, tokenIndex
+					if !_rules[ruleComparison]() {
+						goto l423
+					}
+					goto l422
+				l423:
+					position, tokenIndex = position422, tokenIndex422
+					if !_rules[ruleAffinis]() {
+						goto l424
+					}
+					goto l422
+				l424:
+					position, tokenIndex = position422, tokenIndex422
+					if !_rules[ruleNear]() {
+						goto l425
+					}
+					goto l422
+				l425:
+					position, tokenIndex = position422, tokenIndex422
+					if !_rules[ruleQuestion]() {
+						goto l420
+					}
 				}
-				add(ruleNameApprox, position63)
+			l422:
+				add(ruleOpenQualifier, position421)
 			}
 			return true
-		l62:
-			position, tokenIndex = position62, tokenIndex62
+		l420:
+			position, tokenIndex = position420, tokenIndex420
 			return false
 		},
-		/* 12 NameComp <- <(GenusWord _ Comparison (_ SpeciesEpithet)?)> */
+		/* 60 Affinis <- <('a' 'f' 'f' ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position66, tokenIndex66 := position, tokenIndex
+			position426, tokenIndex426 := position, tokenIndex
 			{
-				position67 := position
-				if !_rules[ruleGenusWord]() {
-					goto l66
+				position427 := position
+				if buffer[position] != rune('a') {
+					goto l426
 				}
-				if !_rules[rule_]() {
-					goto l66
+				position++
+				if buffer[position] != rune('f') {
+					goto l426
 				}
-				if !_rules[ruleComparison]() {
-					goto l66
+				position++
+				if buffer[position] != rune('f') {
+					goto l426
 				}
+				position++
 				{
-					position68, tokenIndex68 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l68
+					position428, tokenIndex428 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l429
 					}
-					if !_rules[ruleSpeciesEpithet]() {
-						goto l68
+					position++
+					goto l428
+				l429:
+					position, tokenIndex = position428, tokenIndex428
+					{
+						position430, tokenIndex430 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l426
+						}
+						position, tokenIndex = position430, tokenIndex430
 					}
-					goto l69
-				l68:
-					position, tokenIndex = position68, tokenIndex68
 				}
-			l69:
-				add(ruleNameComp, position67)
+			l428:
+				add(ruleAffinis, position427)
 			}
 			return true
-		l66:
-			position, tokenIndex = position66, tokenIndex66
+		l426:
+			position, tokenIndex = position426, tokenIndex426
 			return false
 		},
-		/* 13 NameSpecies <- <(GenusWord (_? (Subgenus / SubgenusOrSuperspecies))? _ SpeciesEpithet (_ InfraspGroup)?)> */
+		/* 61 Near <- <('n' 'r' ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position70, tokenIndex70 := position, tokenIndex
+			position431, tokenIndex431 := position, tokenIndex
 			{
-				position71 := position
-				if !_rules[ruleGenusWord]() {
-					goto l70
+				position432 := position
+				if buffer[position] != rune('n') {
+					goto l431
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l431
 				}
+				position++
 				{
-					position72, tokenIndex72 := position, tokenIndex
-					{
-						position74, tokenIndex74 := position, tokenIndex
-						if !_rules[rule_]() {
-							goto l74
-						}
-						goto l75
-					l74:
-						position, tokenIndex = position74, tokenIndex74
+					position433, tokenIndex433 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l434
 					}
-				l75:
+					position++
+					goto l433
+				l434:
+					position, tokenIndex = position433, tokenIndex433
 					{
-						position76, tokenIndex76 := position, tokenIndex
-						if !_rules[ruleSubgenus]() {
-							goto l77
-						}
-						goto l76
-					l77:
-						position, tokenIndex = position76, tokenIndex76
-						if !_rules[ruleSubgenusOrSuperspecies]() {
-							goto l72
+						position435, tokenIndex435 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l431
 						}
+						position, tokenIndex = position435, tokenIndex435
 					}
-				l76:
-					goto l73
-				l72:
-					position, tokenIndex = position72, tokenIndex72
-				}
-			l73:
-				if !_rules[rule_]() {
-					goto l70
 				}
-				if !_rules[ruleSpeciesEpithet]() {
-					goto l70
+			l433:
+				add(ruleNear, position432)
+			}
+			return true
+		l431:
+			position, tokenIndex = position431, tokenIndex431
+			return false
+		},
+		/* 62 Question <- <('?' &_)> */
+		func() bool {
+			position436, tokenIndex436 := position, tokenIndex
+			{
+				position437 := position
+				if buffer[position] != rune('?') {
+					goto l436
 				}
+				position++
 				{
-					position78, tokenIndex78 := position, tokenIndex
+					position438, tokenIndex438 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l78
-					}
-					if !_rules[ruleInfraspGroup]() {
-						goto l78
+						goto l436
 					}
-					goto l79
-				l78:
-					position, tokenIndex = position78, tokenIndex78
+					position, tokenIndex = position438, tokenIndex438
 				}
-			l79:
-				add(ruleNameSpecies, position71)
+				add(ruleQuestion, position437)
 			}
 			return true
-		l70:
-			position, tokenIndex = position70, tokenIndex70
+		l436:
+			position, tokenIndex = position436, tokenIndex436
 			return false
 		},
-		/* 14 GenusWord <- <((AbbrGenus / UninomialWord) !(_ AuthorWord))> */
+		/* 63 Indeterminate <- <((IncertaeSedis / GenSpIndet / SpIndet / SpInc) &(SpaceCharEOI / ','))> */
 		func() bool {
-			position80, tokenIndex80 := position, tokenIndex
+			position439, tokenIndex439 := position, tokenIndex
 			{
-				position81 := position
+				position440 := position
 				{
-					position82, tokenIndex82 := position, tokenIndex
-					if !_rules[ruleAbbrGenus]() {
-						goto l83
+					position441, tokenIndex441 := position, tokenIndex
+					if !_rules[ruleIncertaeSedis]() {
+						goto l442
 					}
-					goto l82
-				l83:
-					position, tokenIndex = position82, tokenIndex82
-					if !_rules[ruleUninomialWord]() {
-						goto l80
+					goto l441
+				l442:
+					position, tokenIndex = position441, tokenIndex441
+					if !_rules[ruleGenSpIndet]() {
+						goto l443
+					}
+					goto l441
+				l443:
+					position, tokenIndex = position441, tokenIndex441
+					if !_rules[ruleSpIndet]() {
+						goto l444
+					}
+					goto l441
+				l444:
+					position, tokenIndex = position441, tokenIndex441
+					if !_rules[ruleSpInc]() {
+						goto l439
+					}
+				}
+			l441:
+				{
+					position445, tokenIndex445 := position, tokenIndex
+					{
+						position446, tokenIndex446 := position, tokenIndex
+						if !_rules[ruleSpaceCharEOI]() {
+							goto l447
+						}
+						goto l446
+					l447:
+						position, tokenIndex = position446, tokenIndex446
+						if buffer[position] != rune(',') {
+							goto l439
+						}
+						position++
 					}
+				l446:
+					position, tokenIndex = position445, tokenIndex445
 				}
-			l82:
+				add(ruleIndeterminate, position440)
+			}
+			return true
+		l439:
+			position, tokenIndex = position439, tokenIndex439
+			return false
+		},
+		/* 64 IncertaeSedis <- <(((('i' 'n' 'c' 'e' 'r' 't' 'a' 'e') / ('I' 'n' 'c' 'e' 'r' 't' 'a' 'e')) _ ('s' 'e' 'd' 'i' 's')) / ((('i' 'n' 'c' '.') / ('I' 'n' 'c' '.')) _? ('s' 'e' 'd' '.')))> */
+		func() bool {
+			position448, tokenIndex448 := position, tokenIndex
+			{
+				position449 := position
 				{
-					position84, tokenIndex84 := position, tokenIndex
+					position450, tokenIndex450 := position, tokenIndex
+					{
+						position452, tokenIndex452 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l453
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l453
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l453
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l453
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l453
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l453
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l453
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l453
+						}
+						position++
+						goto l452
+					l453:
+						position, tokenIndex = position452, tokenIndex452
+						if buffer[position] != rune('I') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l451
+						}
+						position++
+					}
+				l452:
 					if !_rules[rule_]() {
-						goto l84
+						goto l451
 					}
-					if !_rules[ruleAuthorWord]() {
-						goto l84
+					if buffer[position] != rune('s') {
+						goto l451
 					}
-					goto l80
-				l84:
-					position, tokenIndex = position84, tokenIndex84
+					position++
+					if buffer[position] != rune('e') {
+						goto l451
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l451
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l451
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l451
+					}
+					position++
+					goto l450
+				l451:
+					position, tokenIndex = position450, tokenIndex450
+					{
+						position454, tokenIndex454 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l455
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l455
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l455
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l455
+						}
+						position++
+						goto l454
+					l455:
+						position, tokenIndex = position454, tokenIndex454
+						if buffer[position] != rune('I') {
+							goto l448
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l448
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l448
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l448
+						}
+						position++
+					}
+				l454:
+					{
+						position456, tokenIndex456 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l456
+						}
+						goto l457
+					l456:
+						position, tokenIndex = position456, tokenIndex456
+					}
+				l457:
+					if buffer[position] != rune('s') {
+						goto l448
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l448
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l448
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l448
+					}
+					position++
 				}
-				add(ruleGenusWord, position81)
+			l450:
+				add(ruleIncertaeSedis, position449)
 			}
 			return true
-		l80:
-			position, tokenIndex = position80, tokenIndex80
+		l448:
+			position, tokenIndex = position448, tokenIndex448
 			return false
 		},
-		/* 15 InfraspGroup <- <(InfraspEpithet (_ InfraspEpithet)? (_ InfraspEpithet)?)> */
+		/* 65 GenSpIndet <- <('g' 'e' 'n' '.' _? ('e' 't') _ ('s' 'p' '.') _? ('i' 'n' 'd' 'e' 't') '.'?)> */
 		func() bool {
-			position85, tokenIndex85 := position, tokenIndex
+			position458, tokenIndex458 := position, tokenIndex
 			{
-				position86 := position
-				if !_rules[ruleInfraspEpithet]() {
-					goto l85
+				position459 := position
+				if buffer[position] != rune('g') {
+					goto l458
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l458
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l458
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l458
+				}
+				position++
+				{
+					position460, tokenIndex460 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l460
+					}
+					goto l461
+				l460:
+					position, tokenIndex = position460, tokenIndex460
 				}
+			l461:
+				if buffer[position] != rune('e') {
+					goto l458
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l458
+				}
+				position++
+				if !_rules[rule_]() {
+					goto l458
+				}
+				if buffer[position] != rune('s') {
+					goto l458
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l458
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l458
+				}
+				position++
 				{
-					position87, tokenIndex87 := position, tokenIndex
+					position462, tokenIndex462 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l87
-					}
-					if !_rules[ruleInfraspEpithet]() {
-						goto l87
+						goto l462
 					}
-					goto l88
-				l87:
-					position, tokenIndex = position87, tokenIndex87
+					goto l463
+				l462:
+					position, tokenIndex = position462, tokenIndex462
+				}
+			l463:
+				if buffer[position] != rune('i') {
+					goto l458
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l458
 				}
-			l88:
+				position++
+				if buffer[position] != rune('d') {
+					goto l458
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l458
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l458
+				}
+				position++
 				{
-					position89, tokenIndex89 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l89
-					}
-					if !_rules[ruleInfraspEpithet]() {
-						goto l89
+					position464, tokenIndex464 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l464
 					}
-					goto l90
-				l89:
-					position, tokenIndex = position89, tokenIndex89
+					position++
+					goto l465
+				l464:
+					position, tokenIndex = position464, tokenIndex464
 				}
-			l90:
-				add(ruleInfraspGroup, position86)
+			l465:
+				add(ruleGenSpIndet, position459)
 			}
 			return true
-		l85:
-			position, tokenIndex = position85, tokenIndex85
+		l458:
+			position, tokenIndex = position458, tokenIndex458
 			return false
 		},
-		/* 16 InfraspEpithet <- <((Rank _?)? !AuthorEx Word (_ Authorship)?)> */
+		/* 66 SpIndet <- <(((('s' 'p' 'p' '.') / ('s' 'p' '.')) _?)? ('i' 'n' 'd' 'e' 't') '.'?)> */
 		func() bool {
-			position91, tokenIndex91 := position, tokenIndex
+			position466, tokenIndex466 := position, tokenIndex
 			{
-				position92 := position
+				position467 := position
 				{
-					position93, tokenIndex93 := position, tokenIndex
-					if !_rules[ruleRank]() {
-						goto l93
+					position468, tokenIndex468 := position, tokenIndex
+					{
+						position470, tokenIndex470 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l471
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l471
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l471
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l471
+						}
+						position++
+						goto l470
+					l471:
+						position, tokenIndex = position470, tokenIndex470
+						if buffer[position] != rune('s') {
+							goto l468
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l468
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l468
+						}
+						position++
 					}
+				l470:
 					{
-						position95, tokenIndex95 := position, tokenIndex
+						position472, tokenIndex472 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l95
+							goto l472
 						}
-						goto l96
-					l95:
-						position, tokenIndex = position95, tokenIndex95
+						goto l473
+					l472:
+						position, tokenIndex = position472, tokenIndex472
 					}
-				l96:
-					goto l94
-				l93:
-					position, tokenIndex = position93, tokenIndex93
+				l473:
+					goto l469
+				l468:
+					position, tokenIndex = position468, tokenIndex468
 				}
-			l94:
-				{
-					position97, tokenIndex97 := position, tokenIndex
-					if !_rules[ruleAuthorEx]() {
-						goto l97
-					}
-					goto l91
-				l97:
-					position, tokenIndex = position97, tokenIndex97
+			l469:
+				if buffer[position] != rune('i') {
+					goto l466
 				}
-				if !_rules[ruleWord]() {
-					goto l91
+				position++
+				if buffer[position] != rune('n') {
+					goto l466
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l466
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l466
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l466
 				}
+				position++
 				{
-					position98, tokenIndex98 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l98
-					}
-					if !_rules[ruleAuthorship]() {
-						goto l98
+					position474, tokenIndex474 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l474
 					}
-					goto l99
-				l98:
-					position, tokenIndex = position98, tokenIndex98
+					position++
+					goto l475
+				l474:
+					position, tokenIndex = position474, tokenIndex474
 				}
-			l99:
-				add(ruleInfraspEpithet, position92)
+			l475:
+				add(ruleSpIndet, position467)
 			}
 			return true
-		l91:
-			position, tokenIndex = position91, tokenIndex91
+		l466:
+			position, tokenIndex = position466, tokenIndex466
 			return false
 		},
-		/* 17 SpeciesEpithet <- <(!AuthorEx Word (_? Authorship)?)> */
+		/* 67 SpInc <- <('s' 'p' '.' _? ('i' 'n' 'c') '.'?)> */
 		func() bool {
-			position100, tokenIndex100 := position, tokenIndex
+			position476, tokenIndex476 := position, tokenIndex
 			{
-				position101 := position
-				{
-					position102, tokenIndex102 := position, tokenIndex
-					if !_rules[ruleAuthorEx]() {
-						goto l102
-					}
-					goto l100
-				l102:
-					position, tokenIndex = position102, tokenIndex102
+				position477 := position
+				if buffer[position] != rune('s') {
+					goto l476
 				}
-				if !_rules[ruleWord]() {
-					goto l100
+				position++
+				if buffer[position] != rune('p') {
+					goto l476
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l476
 				}
+				position++
 				{
-					position103, tokenIndex103 := position, tokenIndex
-					{
-						position105, tokenIndex105 := position, tokenIndex
-						if !_rules[rule_]() {
-							goto l105
-						}
-						goto l106
-					l105:
-						position, tokenIndex = position105, tokenIndex105
-					}
-				l106:
-					if !_rules[ruleAuthorship]() {
-						goto l103
+					position478, tokenIndex478 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l478
 					}
-					goto l104
-				l103:
-					position, tokenIndex = position103, tokenIndex103
+					goto l479
+				l478:
+					position, tokenIndex = position478, tokenIndex478
 				}
-			l104:
-				add(ruleSpeciesEpithet, position101)
-			}
-			return true
-		l100:
-			position, tokenIndex = position100, tokenIndex100
-			return false
-		},
-		/* 18 Comparison <- <('c' 'f' '.'?)> */
-		func() bool {
-			position107, tokenIndex107 := position, tokenIndex
-			{
-				position108 := position
-				if buffer[position] != rune('c') {
-					goto l107
+			l479:
+				if buffer[position] != rune('i') {
+					goto l476
 				}
 				position++
-				if buffer[position] != rune('f') {
-					goto l107
+				if buffer[position] != rune('n') {
+					goto l476
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l476
 				}
 				position++
 				{
-					position109, tokenIndex109 := position, tokenIndex
+					position480, tokenIndex480 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l109
+						goto l480
 					}
 					position++
-					goto l110
-				l109:
-					position, tokenIndex = position109, tokenIndex109
+					goto l481
+				l480:
+					position, tokenIndex = position480, tokenIndex480
 				}
-			l110:
-				add(ruleComparison, position108)
+			l481:
+				add(ruleSpInc, position477)
 			}
 			return true
-		l107:
-			position, tokenIndex = position107, tokenIndex107
+		l476:
+			position, tokenIndex = position476, tokenIndex476
 			return false
 		},
-		/* 19 Rank <- <((RankForma / RankVar / RankSsp / RankOther / RankOtherUncommon / RankAgamo / RankNotho) (_? LowerGreek ('.' / &SpaceCharEOI))?)> */
+		/* 68 Rank <- <((RankForma / RankVar / RankSsp / RankOther / RankOtherUncommon / RankAgamo / RankNotho) (_? LowerGreek ('.' / &SpaceCharEOI))?)> */
 		func() bool {
-			position111, tokenIndex111 := position, tokenIndex
+			position482, tokenIndex482 := position, tokenIndex
 			{
-				position112 := position
+				position483 := position
 				{
-					position113, tokenIndex113 := position, tokenIndex
+					position484, tokenIndex484 := position, tokenIndex
 					if !_rules[ruleRankForma]() {
-						goto l114
+						goto l485
 					}
-					goto l113
-				l114:
-					position, tokenIndex = position113, tokenIndex113
+					goto l484
+				l485:
+					position, tokenIndex = position484, tokenIndex484
 					if !_rules[ruleRankVar]() {
-						goto l115
+						goto l486
 					}
-					goto l113
-				l115:
-					position, tokenIndex = position113, tokenIndex113
+					goto l484
+				l486:
+					position, tokenIndex = position484, tokenIndex484
 					if !_rules[ruleRankSsp]() {
-						goto l116
+						goto l487
 					}
-					goto l113
-				l116:
-					position, tokenIndex = position113, tokenIndex113
+					goto l484
+				l487:
+					position, tokenIndex = position484, tokenIndex484
 					if !_rules[ruleRankOther]() {
-						goto l117
+						goto l488
 					}
-					goto l113
-				l117:
-					position, tokenIndex = position113, tokenIndex113
+					goto l484
+				l488:
+					position, tokenIndex = position484, tokenIndex484
 					if !_rules[ruleRankOtherUncommon]() {
-						goto l118
+						goto l489
 					}
-					goto l113
-				l118:
-					position, tokenIndex = position113, tokenIndex113
+					goto l484
+				l489:
+					position, tokenIndex = position484, tokenIndex484
 					if !_rules[ruleRankAgamo]() {
-						goto l119
+						goto l490
 					}
-					goto l113
-				l119:
-					position, tokenIndex = position113, tokenIndex113
+					goto l484
+				l490:
+					position, tokenIndex = position484, tokenIndex484
 					if !_rules[ruleRankNotho]() {
-						goto l111
+						goto l482
 					}
 				}
-			l113:
+			l484:
 				{
-					position120, tokenIndex120 := position, tokenIndex
+					position491, tokenIndex491 := position, tokenIndex
 					{
-						position122, tokenIndex122 := position, tokenIndex
+						position493, tokenIndex493 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l122
+							goto l493
 						}
-						goto l123
-					l122:
-						position, tokenIndex = position122, tokenIndex122
+						goto l494
+					l493:
+						position, tokenIndex = position493, tokenIndex493
 					}
-				l123:
+				l494:
 					if !_rules[ruleLowerGreek]() {
-						goto l120
+						goto l491
 					}
 					{
-						position124, tokenIndex124 := position, tokenIndex
+						position495, tokenIndex495 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l125
+							goto l496
 						}
 						position++
-						goto l124
-					l125:
-						position, tokenIndex = position124, tokenIndex124
+						goto l495
+					l496:
+						position, tokenIndex = position495, tokenIndex495
 						{
-							position126, tokenIndex126 := position, tokenIndex
+							position497, tokenIndex497 := position, tokenIndex
 							if !_rules[ruleSpaceCharEOI]() {
-								goto l120
+								goto l491
 							}
-							position, tokenIndex = position126, tokenIndex126
+							position, tokenIndex = position497, tokenIndex497
 						}
 					}
-				l124:
-					goto l121
-				l120:
-					position, tokenIndex = position120, tokenIndex120
+				l495:
+					goto l492
+				l491:
+					position, tokenIndex = position491, tokenIndex491
 				}
-			l121:
-				add(ruleRank, position112)
+			l492:
+				add(ruleRank, position483)
 			}
 			return true
-		l111:
-			position, tokenIndex = position111, tokenIndex111
+		l482:
+			position, tokenIndex = position482, tokenIndex482
 			return false
 		},
-		/* 20 RankNotho <- <((('n' 'o' 't' 'h' 'o' (('v' 'a' 'r') / ('f' 'o') / 'f' / ('s' 'u' 'b' 's' 'p') / ('s' 's' 'p') / ('s' 'p') / ('m' 'o' 'r' 't' 'h') / ('s' 'u' 'p' 's' 'p') / ('s' 'u'))) / ('n' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		/* 69 RankNotho <- <((('n' 'o' 't' 'h' 'o' (('v' 'a' 'r') / ('f' 'o') / 'f' / ('s' 'u' 'b' 's' 'p') / ('s' 's' 'p') / ('s' 'p') / ('m' 'o' 'r' 't' 'h') / ('s' 'u' 'p' 's' 'p') / ('s' 'u'))) / ('n' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position127, tokenIndex127 := position, tokenIndex
+			position498, tokenIndex498 := position, tokenIndex
 			{
-				position128 := position
+				position499 := position
 				{
-					position129, tokenIndex129 := position, tokenIndex
+					position500, tokenIndex500 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l130
+						goto l501
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l130
+						goto l501
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l130
+						goto l501
 					}
 					position++
 					if buffer[position] != rune('h') {
-						goto l130
+						goto l501
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l130
+						goto l501
 					}
 					position++
 					{
-						position131, tokenIndex131 := position, tokenIndex
+						position502, tokenIndex502 := position, tokenIndex
 						if buffer[position] != rune('v') {
-							goto l132
+							goto l503
 						}
 						position++
 						if buffer[position] != rune('a') {
-							goto l132
+							goto l503
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l132
+							goto l503
 						}
 						position++
-						goto l131
-					l132:
-						position, tokenIndex = position131, tokenIndex131
+						goto l502
+					l503:
+						position, tokenIndex = position502, tokenIndex502
 						if buffer[position] != rune('f') {
-							goto l133
+							goto l504
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l133
+							goto l504
 						}
 						position++
-						goto l131
-					l133:
-						position, tokenIndex = position131, tokenIndex131
+						goto l502
+					l504:
+						position, tokenIndex = position502, tokenIndex502
 						if buffer[position] != rune('f') {
-							goto l134
+							goto l505
 						}
 						position++
-						goto l131
-					l134:
-						position, tokenIndex = position131, tokenIndex131
+						goto l502
+					l505:
+						position, tokenIndex = position502, tokenIndex502
 						if buffer[position] != rune('s') {
-							goto l135
+							goto l506
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l135
+							goto l506
 						}
 						position++
 						if buffer[position] != rune('b') {
-							goto l135
+							goto l506
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l135
+							goto l506
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l135
+							goto l506
 						}
 						position++
-						goto l131
-					l135:
-						position, tokenIndex = position131, tokenIndex131
+						goto l502
+					l506:
+						position, tokenIndex = position502, tokenIndex502
 						if buffer[position] != rune('s') {
-							goto l136
+							goto l507
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l136
+							goto l507
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l136
+							goto l507
 						}
 						position++
-						goto l131
-					l136:
-						position, tokenIndex = position131, tokenIndex131
+						goto l502
+					l507:
+						position, tokenIndex = position502, tokenIndex502
 						if buffer[position] != rune('s') {
-							goto l137
+							goto l508
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l137
+							goto l508
 						}
 						position++
-						goto l131
-					l137:
-						position, tokenIndex = position131, tokenIndex131
+						goto l502
+					l508:
+						position, tokenIndex = position502, tokenIndex502
 						if buffer[position] != rune('m') {
-							goto l138
+							goto l509
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l138
+							goto l509
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l138
+							goto l509
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l138
+							goto l509
 						}
 						position++
 						if buffer[position] != rune('h') {
-							goto l138
+							goto l509
 						}
 						position++
-						goto l131
-					l138:
-						position, tokenIndex = position131, tokenIndex131
+						goto l502
+					l509:
+						position, tokenIndex = position502, tokenIndex502
 						if buffer[position] != rune('s') {
-							goto l139
+							goto l510
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l139
+							goto l510
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l139
+							goto l510
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l139
+							goto l510
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l139
+							goto l510
 						}
 						position++
-						goto l131
-					l139:
-						position, tokenIndex = position131, tokenIndex131
+						goto l502
+					l510:
+						position, tokenIndex = position502, tokenIndex502
 						if buffer[position] != rune('s') {
-							goto l130
+							goto l501
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l130
+							goto l501
 						}
 						position++
 					}
-				l131:
-					goto l129
-				l130:
-					position, tokenIndex = position129, tokenIndex129
+				l502:
+					goto l500
+				l501:
+					position, tokenIndex = position500, tokenIndex500
 					if buffer[position] != rune('n') {
-						goto l127
+						goto l498
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l127
+						goto l498
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l127
+						goto l498
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l127
+						goto l498
 					}
 					position++
 				}
-			l129:
+			l500:
 				{
-					position140, tokenIndex140 := position, tokenIndex
+					position511, tokenIndex511 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l141
+						goto l512
 					}
 					position++
-					goto l140
-				l141:
-					position, tokenIndex = position140, tokenIndex140
+					goto l511
+				l512:
+					position, tokenIndex = position511, tokenIndex511
 					{
-						position142, tokenIndex142 := position, tokenIndex
+						position513, tokenIndex513 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l127
+							goto l498
 						}
-						position, tokenIndex = position142, tokenIndex142
+						position, tokenIndex = position513, tokenIndex513
 					}
 				}
-			l140:
-				add(ruleRankNotho, position128)
+			l511:
+				add(ruleRankNotho, position499)
 			}
 			return true
-		l127:
-			position, tokenIndex = position127, tokenIndex127
+		l498:
+			position, tokenIndex = position498, tokenIndex498
 			return false
 		},
-		/* 21 RankOtherUncommon <- <(('*' / ('n' 'a' 't' 'i' 'o') / ('n' 'a' 't' '.') / ('n' 'a' 't') / ('f' '.' 's' 'p') / 'α' / ('β' 'β') / 'β' / 'γ' / 'δ' / 'ε' / 'φ' / 'θ' / 'μ' / ('a' '.') / ('b' '.') / ('c' '.') / ('d' '.') / ('e' '.') / ('g' '.') / ('k' '.') / ('m' 'u' 't' '.')) &SpaceCharEOI)> */
+		/* 70 RankOtherUncommon <- <(('*' / ('n' 'a' 't' 'i' 'o') / ('n' 'a' 't' '.') / ('n' 'a' 't') / ('f' '.' 's' 'p') / 'α' / ('β' 'β') / 'β' / 'γ' / 'δ' / 'ε' / 'φ' / 'θ' / 'μ' / ('a' '.') / ('b' '.') / ('c' '.') / ('d' '.') / ('e' '.') / ('g' '.') / ('k' '.') / ('m' 'u' 't' '.')) &SpaceCharEOI)> */
 		func() bool {
-			position143, tokenIndex143 := position, tokenIndex
+			position514, tokenIndex514 := position, tokenIndex
 			{
-				position144 := position
+				position515 := position
 				{
-					position145, tokenIndex145 := position, tokenIndex
+					position516, tokenIndex516 := position, tokenIndex
 					if buffer[position] != rune('*') {
-						goto l146
+						goto l517
 					}
 					position++
-					goto l145
-				l146:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l517:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('n') {
-						goto l147
+						goto l518
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l147
+						goto l518
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l147
+						goto l518
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l147
+						goto l518
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l147
+						goto l518
 					}
 					position++
-					goto l145
-				l147:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l518:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('n') {
-						goto l148
+						goto l519
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l148
+						goto l519
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l148
+						goto l519
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l148
+						goto l519
 					}
 					position++
-					goto l145
-				l148:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l519:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('n') {
-						goto l149
+						goto l520
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l149
+						goto l520
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l149
+						goto l520
 					}
 					position++
-					goto l145
-				l149:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l520:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('f') {
-						goto l150
+						goto l521
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l150
+						goto l521
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l150
+						goto l521
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l150
+						goto l521
 					}
 					position++
-					goto l145
-				l150:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l521:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('α') {
-						goto l151
+						goto l522
 					}
 					position++
-					goto l145
-				l151:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l522:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('β') {
-						goto l152
+						goto l523
 					}
 					position++
 					if buffer[position] != rune('β') {
-						goto l152
+						goto l523
 					}
 					position++
-					goto l145
-				l152:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l523:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('β') {
-						goto l153
+						goto l524
 					}
 					position++
-					goto l145
-				l153:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l524:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('γ') {
-						goto l154
+						goto l525
 					}
 					position++
-					goto l145
-				l154:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l525:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('δ') {
-						goto l155
+						goto l526
 					}
 					position++
-					goto l145
-				l155:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l526:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('ε') {
-						goto l156
+						goto l527
 					}
 					position++
-					goto l145
-				l156:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l527:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('φ') {
-						goto l157
+						goto l528
 					}
 					position++
-					goto l145
-				l157:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l528:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('θ') {
-						goto l158
+						goto l529
 					}
 					position++
-					goto l145
-				l158:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l529:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('μ') {
-						goto l159
+						goto l530
 					}
 					position++
-					goto l145
-				l159:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l530:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('a') {
-						goto l160
+						goto l531
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l160
+						goto l531
 					}
 					position++
-					goto l145
-				l160:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l531:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('b') {
-						goto l161
+						goto l532
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l161
+						goto l532
 					}
 					position++
-					goto l145
-				l161:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l532:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('c') {
-						goto l162
+						goto l533
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l162
+						goto l533
 					}
 					position++
-					goto l145
-				l162:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l533:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('d') {
-						goto l163
+						goto l534
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l163
+						goto l534
 					}
 					position++
-					goto l145
-				l163:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l534:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('e') {
-						goto l164
+						goto l535
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l164
+						goto l535
 					}
 					position++
-					goto l145
-				l164:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l535:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('g') {
-						goto l165
+						goto l536
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l165
+						goto l536
 					}
 					position++
-					goto l145
-				l165:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l536:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('k') {
-						goto l166
+						goto l537
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l166
+						goto l537
 					}
 					position++
-					goto l145
-				l166:
-					position, tokenIndex = position145, tokenIndex145
+					goto l516
+				l537:
+					position, tokenIndex = position516, tokenIndex516
 					if buffer[position] != rune('m') {
-						goto l143
+						goto l514
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l143
+						goto l514
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l143
+						goto l514
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l143
+						goto l514
 					}
 					position++
 				}
-			l145:
+			l516:
 				{
-					position167, tokenIndex167 := position, tokenIndex
+					position538, tokenIndex538 := position, tokenIndex
 					if !_rules[ruleSpaceCharEOI]() {
-						goto l143
+						goto l514
 					}
-					position, tokenIndex = position167, tokenIndex167
+					position, tokenIndex = position538, tokenIndex538
 				}
-				add(ruleRankOtherUncommon, position144)
+				add(ruleRankOtherUncommon, position515)
 			}
 			return true
-		l143:
-			position, tokenIndex = position143, tokenIndex143
+		l514:
+			position, tokenIndex = position514, tokenIndex514
 			return false
 		},
-		/* 22 RankOther <- <((('m' 'o' 'r' 'p' 'h') / ('c' 'o' 'n' 'v' 'a' 'r') / ('p' 's' 'e' 'u' 'd' 'o' 'v' 'a' 'r') / ('s' 'e' 'c' 't') / ('s' 'e' 'r') / ('s' 'u' 'b' 'v' 'a' 'r') / ('s' 'u' 'b' 'f') / ('r' 'a' 'c' 'e') / ('p' 'v') / ('p' 'a' 't' 'h' 'o' 'v' 'a' 'r') / ('a' 'b' '.' (_? ('n' '.'))?) / ('s' 't')) ('.' / &SpaceCharEOI))> */
+		/* 71 RankOther <- <((('m' 'o' 'r' 'p' 'h') / ('c' 'o' 'n' 'v' 'a' 'r') / ('p' 's' 'e' 'u' 'd' 'o' 'v' 'a' 'r') / ('s' 'e' 'c' 't') / ('s' 'e' 'r') / ('s' 'u' 'b' 'v' 'a' 'r') / ('s' 'u' 'b' 'f') / ('r' 'a' 'c' 'e') / ('p' 'v') / ('p' 'a' 't' 'h' 'o' 'v' 'a' 'r') / ('a' 'b' '.' (_? ('n' '.'))?) / ('s' 't')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position168, tokenIndex168 := position, tokenIndex
+			position539, tokenIndex539 := position, tokenIndex
 			{
-				position169 := position
+				position540 := position
 				{
-					position170, tokenIndex170 := position, tokenIndex
+					position541, tokenIndex541 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l171
+						goto l542
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l171
+						goto l542
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l171
+						goto l542
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l171
+						goto l542
 					}
 					position++
 					if buffer[position] != rune('h') {
-						goto l171
+						goto l542
 					}
 					position++
-					goto l170
-				l171:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l542:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('c') {
-						goto l172
+						goto l543
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l172
+						goto l543
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l172
+						goto l543
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l172
+						goto l543
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l172
+						goto l543
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l172
+						goto l543
 					}
 					position++
-					goto l170
-				l172:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l543:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('p') {
-						goto l173
+						goto l544
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l173
+						goto l544
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l173
+						goto l544
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l173
+						goto l544
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l173
+						goto l544
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l173
+						goto l544
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l173
+						goto l544
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l173
+						goto l544
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l173
+						goto l544
 					}
 					position++
-					goto l170
-				l173:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l544:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('s') {
-						goto l174
+						goto l545
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l174
+						goto l545
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l174
+						goto l545
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l174
+						goto l545
 					}
 					position++
-					goto l170
-				l174:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l545:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('s') {
-						goto l175
+						goto l546
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l175
+						goto l546
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l175
+						goto l546
 					}
 					position++
-					goto l170
-				l175:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l546:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('s') {
-						goto l176
+						goto l547
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l176
+						goto l547
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l176
+						goto l547
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l176
+						goto l547
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l176
+						goto l547
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l176
+						goto l547
 					}
 					position++
-					goto l170
-				l176:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l547:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('s') {
-						goto l177
+						goto l548
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l177
+						goto l548
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l177
+						goto l548
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l177
+						goto l548
 					}
 					position++
-					goto l170
-				l177:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l548:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('r') {
-						goto l178
+						goto l549
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l178
+						goto l549
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l178
+						goto l549
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l178
+						goto l549
 					}
 					position++
-					goto l170
-				l178:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l549:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('p') {
-						goto l179
+						goto l550
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l179
+						goto l550
 					}
 					position++
-					goto l170
-				l179:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l550:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('p') {
-						goto l180
+						goto l551
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l180
+						goto l551
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l180
+						goto l551
 					}
 					position++
 					if buffer[position] != rune('h') {
-						goto l180
+						goto l551
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l180
+						goto l551
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l180
+						goto l551
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l180
+						goto l551
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l180
+						goto l551
 					}
 					position++
-					goto l170
-				l180:
-					position, tokenIndex = position170, tokenIndex170
+					goto l541
+				l551:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('a') {
-						goto l181
+						goto l552
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l181
+						goto l552
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l181
+						goto l552
 					}
 					position++
 					{
-						position182, tokenIndex182 := position, tokenIndex
+						position553, tokenIndex553 := position, tokenIndex
 						{
-							position184, tokenIndex184 := position, tokenIndex
+							position555, tokenIndex555 := position, tokenIndex
 							if !_rules[rule_]() {
-								goto l184
+								goto l555
 							}
-							goto l185
-						l184:
-							position, tokenIndex = position184, tokenIndex184
+							goto l556
+						l555:
+							position, tokenIndex = position555, tokenIndex555
 						}
-					l185:
+					l556:
 						if buffer[position] != rune('n') {
-							goto l182
+							goto l553
 						}
 						position++
 						if buffer[position] != rune('.') {
-							goto l182
+							goto l553
 						}
 						position++
-						goto l183
-					l182:
-						position, tokenIndex = position182, tokenIndex182
+						goto l554
+					l553:
+						position, tokenIndex = position553, tokenIndex553
 					}
-				l183:
-					goto l170
-				l181:
-					position, tokenIndex = position170, tokenIndex170
+				l554:
+					goto l541
+				l552:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('s') {
-						goto l168
+						goto l539
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l168
+						goto l539
 					}
 					position++
 				}
-			l170:
+			l541:
 				{
-					position186, tokenIndex186 := position, tokenIndex
+					position557, tokenIndex557 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l187
+						goto l558
 					}
 					position++
-					goto l186
-				l187:
-					position, tokenIndex = position186, tokenIndex186
+					goto l557
+				l558:
+					position, tokenIndex = position557, tokenIndex557
 					{
-						position188, tokenIndex188 := position, tokenIndex
+						position559, tokenIndex559 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l168
+							goto l539
 						}
-						position, tokenIndex = position188, tokenIndex188
+						position, tokenIndex = position559, tokenIndex559
 					}
 				}
-			l186:
-				add(ruleRankOther, position169)
+			l557:
+				add(ruleRankOther, position540)
 			}
 			return true
-		l168:
-			position, tokenIndex = position168, tokenIndex168
+		l539:
+			position, tokenIndex = position539, tokenIndex539
 			return false
 		},
-		/* 23 RankVar <- <((('v' 'a' 'r' 'i' 'e' 't' 'y') / ('[' 'v' 'a' 'r' '.' ']') / ('v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		/* 72 RankVar <- <((('v' 'a' 'r' 'i' 'e' 't' 'y') / ('[' 'v' 'a' 'r' '.' ']') / ('v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position189, tokenIndex189 := position, tokenIndex
+			position560, tokenIndex560 := position, tokenIndex
 			{
-				position190 := position
+				position561 := position
 				{
-					position191, tokenIndex191 := position, tokenIndex
+					position562, tokenIndex562 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l192
+						goto l563
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l192
+						goto l563
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l192
+						goto l563
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l192
+						goto l563
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l192
+						goto l563
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l192
+						goto l563
 					}
 					position++
 					if buffer[position] != rune('y') {
-						goto l192
+						goto l563
 					}
 					position++
-					goto l191
-				l192:
-					position, tokenIndex = position191, tokenIndex191
+					goto l562
+				l563:
+					position, tokenIndex = position562, tokenIndex562
 					if buffer[position] != rune('[') {
-						goto l193
+						goto l564
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l193
+						goto l564
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l193
+						goto l564
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l193
+						goto l564
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l193
+						goto l564
 					}
 					position++
 					if buffer[position] != rune(']') {
-						goto l193
+						goto l564
 					}
 					position++
-					goto l191
-				l193:
-					position, tokenIndex = position191, tokenIndex191
+					goto l562
+				l564:
+					position, tokenIndex = position562, tokenIndex562
 					if buffer[position] != rune('v') {
-						goto l189
+						goto l560
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l189
+						goto l560
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l189
+						goto l560
 					}
 					position++
 				}
-			l191:
+			l562:
 				{
-					position194, tokenIndex194 := position, tokenIndex
+					position565, tokenIndex565 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l195
+						goto l566
 					}
 					position++
-					goto l194
-				l195:
-					position, tokenIndex = position194, tokenIndex194
+					goto l565
+				l566:
+					position, tokenIndex = position565, tokenIndex565
 					{
-						position196, tokenIndex196 := position, tokenIndex
+						position567, tokenIndex567 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l189
+							goto l560
 						}
-						position, tokenIndex = position196, tokenIndex196
+						position, tokenIndex = position567, tokenIndex567
 					}
 				}
-			l194:
-				add(ruleRankVar, position190)
+			l565:
+				add(ruleRankVar, position561)
 			}
 			return true
-		l189:
-			position, tokenIndex = position189, tokenIndex189
+		l560:
+			position, tokenIndex = position560, tokenIndex560
 			return false
 		},
-		/* 24 RankForma <- <((('f' 'o' 'r' 'm' 'a') / ('f' 'm' 'a') / ('f' 'o' 'r' 'm') / ('f' 'o') / 'f') ('.' / &SpaceCharEOI))> */
+		/* 73 RankForma <- <((('f' 'o' 'r' 'm' 'a') / ('f' 'm' 'a') / ('f' 'o' 'r' 'm') / ('f' 'o') / 'f') ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position197, tokenIndex197 := position, tokenIndex
+			position568, tokenIndex568 := position, tokenIndex
 			{
-				position198 := position
+				position569 := position
 				{
-					position199, tokenIndex199 := position, tokenIndex
+					position570, tokenIndex570 := position, tokenIndex
 					if buffer[position] != rune('f') {
-						goto l200
+						goto l571
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l200
+						goto l571
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l200
+						goto l571
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l200
+						goto l571
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l200
+						goto l571
 					}
 					position++
-					goto l199
-				l200:
-					position, tokenIndex = position199, tokenIndex199
+					goto l570
+				l571:
+					position, tokenIndex = position570, tokenIndex570
 					if buffer[position] != rune('f') {
-						goto l201
+						goto l572
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l201
+						goto l572
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l201
+						goto l572
 					}
 					position++
-					goto l199
-				l201:
-					position, tokenIndex = position199, tokenIndex199
+					goto l570
+				l572:
+					position, tokenIndex = position570, tokenIndex570
 					if buffer[position] != rune('f') {
-						goto l202
+						goto l573
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l202
+						goto l573
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l202
+						goto l573
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l202
+						goto l573
 					}
 					position++
-					goto l199
-				l202:
-					position, tokenIndex = position199, tokenIndex199
+					goto l570
+				l573:
+					position, tokenIndex = position570, tokenIndex570
 					if buffer[position] != rune('f') {
-						goto l203
+						goto l574
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l203
+						goto l574
 					}
 					position++
-					goto l199
-				l203:
-					position, tokenIndex = position199, tokenIndex199
+					goto l570
+				l574:
+					position, tokenIndex = position570, tokenIndex570
 					if buffer[position] != rune('f') {
-						goto l197
+						goto l568
 					}
 					position++
 				}
-			l199:
+			l570:
 				{
-					position204, tokenIndex204 := position, tokenIndex
+					position575, tokenIndex575 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l205
+						goto l576
 					}
 					position++
-					goto l204
-				l205:
-					position, tokenIndex = position204, tokenIndex204
+					goto l575
+				l576:
+					position, tokenIndex = position575, tokenIndex575
 					{
-						position206, tokenIndex206 := position, tokenIndex
+						position577, tokenIndex577 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l197
+							goto l568
 						}
-						position, tokenIndex = position206, tokenIndex206
+						position, tokenIndex = position577, tokenIndex577
 					}
 				}
-			l204:
-				add(ruleRankForma, position198)
+			l575:
+				add(ruleRankForma, position569)
 			}
 			return true
-		l197:
-			position, tokenIndex = position197, tokenIndex197
+		l568:
+			position, tokenIndex = position568, tokenIndex568
 			return false
 		},
-		/* 25 RankSsp <- <((('s' 's' 'p') / ('s' 'u' 'b' 's' 'p' 'e' 'c') / ('s' 'u' 'b' 's' 'p')) ('.' / &SpaceCharEOI))> */
+		/* 74 RankSsp <- <((('s' 's' 'p') / ('s' 'u' 'b' 's' 'p' 'e' 'c') / ('s' 'u' 'b' 's' 'p')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position207, tokenIndex207 := position, tokenIndex
+			position578, tokenIndex578 := position, tokenIndex
 			{
-				position208 := position
+				position579 := position
 				{
-					position209, tokenIndex209 := position, tokenIndex
+					position580, tokenIndex580 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l210
+						goto l581
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l210
+						goto l581
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l210
+						goto l581
 					}
 					position++
-					goto l209
-				l210:
-					position, tokenIndex = position209, tokenIndex209
+					goto l580
+				l581:
+					position, tokenIndex = position580, tokenIndex580
 					if buffer[position] != rune('s') {
-						goto l211
+						goto l582
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l211
+						goto l582
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l211
+						goto l582
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l211
+						goto l582
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l211
+						goto l582
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l211
+						goto l582
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l211
+						goto l582
 					}
 					position++
-					goto l209
-				l211:
-					position, tokenIndex = position209, tokenIndex209
+					goto l580
+				l582:
+					position, tokenIndex = position580, tokenIndex580
 					if buffer[position] != rune('s') {
-						goto l207
+						goto l578
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l207
+						goto l578
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l207
+						goto l578
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l207
+						goto l578
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l207
+						goto l578
 					}
 					position++
 				}
-			l209:
+			l580:
 				{
-					position212, tokenIndex212 := position, tokenIndex
+					position583, tokenIndex583 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l213
+						goto l584
 					}
 					position++
-					goto l212
-				l213:
-					position, tokenIndex = position212, tokenIndex212
+					goto l583
+				l584:
+					position, tokenIndex = position583, tokenIndex583
 					{
-						position214, tokenIndex214 := position, tokenIndex
+						position585, tokenIndex585 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l207
+							goto l578
 						}
-						position, tokenIndex = position214, tokenIndex214
+						position, tokenIndex = position585, tokenIndex585
 					}
 				}
-			l212:
-				add(ruleRankSsp, position208)
+			l583:
+				add(ruleRankSsp, position579)
 			}
 			return true
-		l207:
-			position, tokenIndex = position207, tokenIndex207
+		l578:
+			position, tokenIndex = position578, tokenIndex578
 			return false
 		},
-		/* 26 RankAgamo <- <((('a' 'g' 'a' 'm' 'o' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 's' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		/* 75 RankAgamo <- <((('a' 'g' 'a' 'm' 'o' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 's' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position215, tokenIndex215 := position, tokenIndex
+			position586, tokenIndex586 := position, tokenIndex
 			{
-				position216 := position
+				position587 := position
 				{
-					position217, tokenIndex217 := position, tokenIndex
+					position588, tokenIndex588 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l218
+						goto l589
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l218
+						goto l589
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l218
+						goto l589
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l218
+						goto l589
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l218
+						goto l589
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l218
+						goto l589
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l218
+						goto l589
 					}
 					position++
-					goto l217
-				l218:
-					position, tokenIndex = position217, tokenIndex217
+					goto l588
+				l589:
+					position, tokenIndex = position588, tokenIndex588
 					if buffer[position] != rune('a') {
-						goto l219
+						goto l590
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l219
+						goto l590
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l219
+						goto l590
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l219
+						goto l590
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l219
+						goto l590
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l219
+						goto l590
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l219
+						goto l590
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l219
+						goto l590
 					}
 					position++
-					goto l217
-				l219:
-					position, tokenIndex = position217, tokenIndex217
+					goto l588
+				l590:
+					position, tokenIndex = position588, tokenIndex588
 					if buffer[position] != rune('a') {
-						goto l215
+						goto l586
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l215
+						goto l586
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l215
+						goto l586
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l215
+						goto l586
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l215
+						goto l586
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l215
+						goto l586
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l215
+						goto l586
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l215
+						goto l586
 					}
 					position++
 				}
-			l217:
+			l588:
 				{
-					position220, tokenIndex220 := position, tokenIndex
+					position591, tokenIndex591 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l221
+						goto l592
 					}
 					position++
-					goto l220
-				l221:
-					position, tokenIndex = position220, tokenIndex220
+					goto l591
+				l592:
+					position, tokenIndex = position591, tokenIndex591
 					{
-						position222, tokenIndex222 := position, tokenIndex
+						position593, tokenIndex593 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l215
+							goto l586
 						}
-						position, tokenIndex = position222, tokenIndex222
+						position, tokenIndex = position593, tokenIndex593
 					}
 				}
-			l220:
-				add(ruleRankAgamo, position216)
+			l591:
+				add(ruleRankAgamo, position587)
 			}
 			return true
-		l215:
-			position, tokenIndex = position215, tokenIndex215
+		l586:
+			position, tokenIndex = position586, tokenIndex586
 			return false
 		},
-		/* 27 SubgenusOrSuperspecies <- <('(' _? NameLowerChar+ _? ')')> */
+		/* 76 SubgenusOrSuperspecies <- <('(' _? NameLowerChar+ _? ')')> */
 		func() bool {
-			position223, tokenIndex223 := position, tokenIndex
+			position594, tokenIndex594 := position, tokenIndex
 			{
-				position224 := position
+				position595 := position
 				if buffer[position] != rune('(') {
-					goto l223
+					goto l594
 				}
 				position++
 				{
-					position225, tokenIndex225 := position, tokenIndex
+					position596, tokenIndex596 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l225
+						goto l596
 					}
-					goto l226
-				l225:
-					position, tokenIndex = position225, tokenIndex225
+					goto l597
+				l596:
+					position, tokenIndex = position596, tokenIndex596
 				}
-			l226:
+			l597:
 				if !_rules[ruleNameLowerChar]() {
-					goto l223
+					goto l594
 				}
-			l227:
+			l598:
 				{
-					position228, tokenIndex228 := position, tokenIndex
+					position599, tokenIndex599 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l228
+						goto l599
 					}
-					goto l227
-				l228:
-					position, tokenIndex = position228, tokenIndex228
+					goto l598
+				l599:
+					position, tokenIndex = position599, tokenIndex599
 				}
 				{
-					position229, tokenIndex229 := position, tokenIndex
+					position600, tokenIndex600 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l229
+						goto l600
 					}
-					goto l230
-				l229:
-					position, tokenIndex = position229, tokenIndex229
+					goto l601
+				l600:
+					position, tokenIndex = position600, tokenIndex600
 				}
-			l230:
+			l601:
 				if buffer[position] != rune(')') {
-					goto l223
+					goto l594
 				}
 				position++
-				add(ruleSubgenusOrSuperspecies, position224)
+				add(ruleSubgenusOrSuperspecies, position595)
 			}
 			return true
-		l223:
-			position, tokenIndex = position223, tokenIndex223
+		l594:
+			position, tokenIndex = position594, tokenIndex594
 			return false
 		},
-		/* 28 Subgenus <- <(Subgenus2 / Subgenus1)> */
+		/* 77 Subgenus <- <(Subgenus2 / Subgenus1)> */
 		func() bool {
-			position231, tokenIndex231 := position, tokenIndex
+			position602, tokenIndex602 := position, tokenIndex
 			{
-				position232 := position
+				position603 := position
 				{
-					position233, tokenIndex233 := position, tokenIndex
+					position604, tokenIndex604 := position, tokenIndex
 					if !_rules[ruleSubgenus2]() {
-						goto l234
+						goto l605
 					}
-					goto l233
-				l234:
-					position, tokenIndex = position233, tokenIndex233
+					goto l604
+				l605:
+					position, tokenIndex = position604, tokenIndex604
 					if !_rules[ruleSubgenus1]() {
-						goto l231
+						goto l602
 					}
 				}
-			l233:
-				add(ruleSubgenus, position232)
+			l604:
+				add(ruleSubgenus, position603)
 			}
 			return true
-		l231:
-			position, tokenIndex = position231, tokenIndex231
+		l602:
+			position, tokenIndex = position602, tokenIndex602
 			return false
 		},
-		/* 29 Subgenus2 <- <('(' _? AbbrSubgenus _? ')' !(_? NameUpperChar))> */
+		/* 78 Subgenus2 <- <('(' _? AbbrSubgenus _? ')' !(_? NameUpperChar))> */
 		func() bool {
-			position235, tokenIndex235 := position, tokenIndex
+			position606, tokenIndex606 := position, tokenIndex
 			{
-				position236 := position
+				position607 := position
 				if buffer[position] != rune('(') {
-					goto l235
+					goto l606
 				}
 				position++
 				{
-					position237, tokenIndex237 := position, tokenIndex
+					position608, tokenIndex608 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l237
+						goto l608
 					}
-					goto l238
-				l237:
-					position, tokenIndex = position237, tokenIndex237
+					goto l609
+				l608:
+					position, tokenIndex = position608, tokenIndex608
 				}
-			l238:
+			l609:
 				if !_rules[ruleAbbrSubgenus]() {
-					goto l235
+					goto l606
 				}
 				{
-					position239, tokenIndex239 := position, tokenIndex
+					position610, tokenIndex610 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l239
+						goto l610
 					}
-					goto l240
-				l239:
-					position, tokenIndex = position239, tokenIndex239
+					goto l611
+				l610:
+					position, tokenIndex = position610, tokenIndex610
 				}
-			l240:
+			l611:
 				if buffer[position] != rune(')') {
-					goto l235
+					goto l606
 				}
 				position++
 				{
-					position241, tokenIndex241 := position, tokenIndex
+					position612, tokenIndex612 := position, tokenIndex
 					{
-						position242, tokenIndex242 := position, tokenIndex
+						position613, tokenIndex613 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l242
+							goto l613
 						}
-						goto l243
-					l242:
-						position, tokenIndex = position242, tokenIndex242
+						goto l614
+					l613:
+						position, tokenIndex = position613, tokenIndex613
 					}
-				l243:
+				l614:
 					if !_rules[ruleNameUpperChar]() {
-						goto l241
+						goto l612
 					}
-					goto l235
-				l241:
-					position, tokenIndex = position241, tokenIndex241
+					goto l606
+				l612:
+					position, tokenIndex = position612, tokenIndex612
 				}
-				add(ruleSubgenus2, position236)
+				add(ruleSubgenus2, position607)
 			}
 			return true
-		l235:
-			position, tokenIndex = position235, tokenIndex235
+		l606:
+			position, tokenIndex = position606, tokenIndex606
 			return false
 		},
-		/* 30 Subgenus1 <- <('(' _? UninomialWord _? ')')> */
+		/* 79 Subgenus1 <- <('(' _? UninomialWord _? ')')> */
 		func() bool {
-			position244, tokenIndex244 := position, tokenIndex
+			position615, tokenIndex615 := position, tokenIndex
 			{
-				position245 := position
+				position616 := position
 				if buffer[position] != rune('(') {
-					goto l244
+					goto l615
 				}
 				position++
 				{
-					position246, tokenIndex246 := position, tokenIndex
+					position617, tokenIndex617 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l246
+						goto l617
 					}
-					goto l247
-				l246:
-					position, tokenIndex = position246, tokenIndex246
+					goto l618
+				l617:
+					position, tokenIndex = position617, tokenIndex617
 				}
-			l247:
+			l618:
 				if !_rules[ruleUninomialWord]() {
-					goto l244
+					goto l615
 				}
 				{
-					position248, tokenIndex248 := position, tokenIndex
+					position619, tokenIndex619 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l248
+						goto l619
 					}
-					goto l249
-				l248:
-					position, tokenIndex = position248, tokenIndex248
+					goto l620
+				l619:
+					position, tokenIndex = position619, tokenIndex619
 				}
-			l249:
+			l620:
 				if buffer[position] != rune(')') {
-					goto l244
+					goto l615
 				}
 				position++
-				add(ruleSubgenus1, position245)
+				add(ruleSubgenus1, position616)
 			}
 			return true
-		l244:
-			position, tokenIndex = position244, tokenIndex244
+		l615:
+			position, tokenIndex = position615, tokenIndex615
 			return false
 		},
-		/* 31 UninomialCombo <- <(UninomialCombo1 / UninomialCombo2)> */
+		/* 80 UninomialCombo <- <(UninomialCombo1 / UninomialCombo2)> */
 		func() bool {
-			position250, tokenIndex250 := position, tokenIndex
+			position621, tokenIndex621 := position, tokenIndex
 			{
-				position251 := position
+				position622 := position
 				{
-					position252, tokenIndex252 := position, tokenIndex
+					position623, tokenIndex623 := position, tokenIndex
 					if !_rules[ruleUninomialCombo1]() {
-						goto l253
+						goto l624
 					}
-					goto l252
-				l253:
-					position, tokenIndex = position252, tokenIndex252
+					goto l623
+				l624:
+					position, tokenIndex = position623, tokenIndex623
 					if !_rules[ruleUninomialCombo2]() {
-						goto l250
+						goto l621
 					}
 				}
-			l252:
-				add(ruleUninomialCombo, position251)
+			l623:
+				add(ruleUninomialCombo, position622)
 			}
 			return true
-		l250:
-			position, tokenIndex = position250, tokenIndex250
+		l621:
+			position, tokenIndex = position621, tokenIndex621
 			return false
 		},
-		/* 32 UninomialCombo1 <- <(UninomialWord _? Subgenus (_? Authorship)?)> */
+		/* 81 UninomialCombo1 <- <(UninomialWord _? Subgenus (_? Authorship)?)> */
 		func() bool {
-			position254, tokenIndex254 := position, tokenIndex
+			position625, tokenIndex625 := position, tokenIndex
 			{
-				position255 := position
+				position626 := position
 				if !_rules[ruleUninomialWord]() {
-					goto l254
+					goto l625
 				}
 				{
-					position256, tokenIndex256 := position, tokenIndex
+					position627, tokenIndex627 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l256
+						goto l627
 					}
-					goto l257
-				l256:
-					position, tokenIndex = position256, tokenIndex256
+					goto l628
+				l627:
+					position, tokenIndex = position627, tokenIndex627
 				}
-			l257:
+			l628:
 				if !_rules[ruleSubgenus]() {
-					goto l254
+					goto l625
 				}
 				{
-					position258, tokenIndex258 := position, tokenIndex
+					position629, tokenIndex629 := position, tokenIndex
 					{
-						position260, tokenIndex260 := position, tokenIndex
+						position631, tokenIndex631 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l260
+							goto l631
 						}
-						goto l261
-					l260:
-						position, tokenIndex = position260, tokenIndex260
+						goto l632
+					l631:
+						position, tokenIndex = position631, tokenIndex631
 					}
-				l261:
+				l632:
 					if !_rules[ruleAuthorship]() {
-						goto l258
+						goto l629
 					}
-					goto l259
-				l258:
-					position, tokenIndex = position258, tokenIndex258
+					goto l630
+				l629:
+					position, tokenIndex = position629, tokenIndex629
 				}
-			l259:
-				add(ruleUninomialCombo1, position255)
+			l630:
+				add(ruleUninomialCombo1, position626)
 			}
 			return true
-		l254:
-			position, tokenIndex = position254, tokenIndex254
+		l625:
+			position, tokenIndex = position625, tokenIndex625
 			return false
 		},
-		/* 33 UninomialCombo2 <- <(Uninomial _ RankUninomial _ Uninomial)> */
+		/* 82 UninomialCombo2 <- <(Uninomial _ RankUninomial _ Uninomial)> */
 		func() bool {
-			position262, tokenIndex262 := position, tokenIndex
+			position633, tokenIndex633 := position, tokenIndex
 			{
-				position263 := position
+				position634 := position
 				if !_rules[ruleUninomial]() {
-					goto l262
+					goto l633
 				}
 				if !_rules[rule_]() {
-					goto l262
+					goto l633
 				}
 				if !_rules[ruleRankUninomial]() {
-					goto l262
+					goto l633
 				}
 				if !_rules[rule_]() {
-					goto l262
+					goto l633
 				}
 				if !_rules[ruleUninomial]() {
-					goto l262
+					goto l633
 				}
-				add(ruleUninomialCombo2, position263)
+				add(ruleUninomialCombo2, position634)
 			}
 			return true
-		l262:
-			position, tokenIndex = position262, tokenIndex262
+		l633:
+			position, tokenIndex = position633, tokenIndex633
 			return false
 		},
-		/* 34 RankUninomial <- <(RankUninomialPlain / RankUninomialNotho)> */
+		/* 83 RankUninomial <- <(RankUninomialPlain / RankUninomialNotho)> */
 		func() bool {
-			position264, tokenIndex264 := position, tokenIndex
+			position635, tokenIndex635 := position, tokenIndex
 			{
-				position265 := position
+				position636 := position
 				{
-					position266, tokenIndex266 := position, tokenIndex
+					position637, tokenIndex637 := position, tokenIndex
 					if !_rules[ruleRankUninomialPlain]() {
-						goto l267
+						goto l638
 					}
-					goto l266
-				l267:
-					position, tokenIndex = position266, tokenIndex266
+					goto l637
+				l638:
+					position, tokenIndex = position637, tokenIndex637
 					if !_rules[ruleRankUninomialNotho]() {
-						goto l264
+						goto l635
 					}
 				}
-			l266:
-				add(ruleRankUninomial, position265)
+			l637:
+				add(ruleRankUninomial, position636)
 			}
 			return true
-		l264:
-			position, tokenIndex = position264, tokenIndex264
+		l635:
+			position, tokenIndex = position635, tokenIndex635
 			return false
 		},
-		/* 35 RankUninomialPlain <- <((('s' 'e' 'c' 't') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('t' 'r' 'i' 'b') / ('s' 'u' 'b' 't' 'r' 'i' 'b') / ('s' 'u' 'b' 's' 'e' 'r') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('f' 'a' 'm') / ('s' 'u' 'b' 'f' 'a' 'm') / ('s' 'u' 'p' 'e' 'r' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
+		/* 84 RankUninomialPlain <- <((('s' 'e' 'c' 't') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('t' 'r' 'i' 'b') / ('s' 'u' 'b' 't' 'r' 'i' 'b') / ('s' 'u' 'b' 's' 'e' 'r') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('f' 'a' 'm') / ('s' 'u' 'b' 'f' 'a' 'm') / ('s' 'u' 'p' 'e' 'r' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position268, tokenIndex268 := position, tokenIndex
+			position639, tokenIndex639 := position, tokenIndex
 			{
-				position269 := position
+				position640 := position
 				{
-					position270, tokenIndex270 := position, tokenIndex
+					position641, tokenIndex641 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l271
+						goto l642
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l271
+						goto l642
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l271
+						goto l642
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l271
+						goto l642
 					}
 					position++
-					goto l270
-				l271:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l642:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l272
+						goto l643
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l272
+						goto l643
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l272
+						goto l643
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l272
+						goto l643
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l272
+						goto l643
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l272
+						goto l643
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l272
+						goto l643
 					}
 					position++
-					goto l270
-				l272:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l643:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('t') {
-						goto l273
+						goto l644
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l273
+						goto l644
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l273
+						goto l644
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l273
+						goto l644
 					}
 					position++
-					goto l270
-				l273:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l644:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l274
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l274
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l274
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l274
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l274
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l274
+						goto l645
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l274
+						goto l645
 					}
 					position++
-					goto l270
-				l274:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l645:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l275
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l275
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l275
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l275
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l275
+						goto l646
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l275
+						goto l646
 					}
 					position++
-					goto l270
-				l275:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l646:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l276
+						goto l647
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l276
+						goto l647
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l276
+						goto l647
 					}
 					position++
-					goto l270
-				l276:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l647:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l277
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l277
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l277
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l277
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l277
+						goto l648
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l277
+						goto l648
 					}
 					position++
-					goto l270
-				l277:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l648:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l278
+						goto l649
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l278
+						goto l649
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l278
+						goto l649
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l278
+						goto l649
 					}
 					position++
-					goto l270
-				l278:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l649:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('f') {
-						goto l279
+						goto l650
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l279
+						goto l650
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l279
+						goto l650
 					}
 					position++
-					goto l270
-				l279:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l650:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l280
+						goto l651
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l280
+						goto l651
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l280
+						goto l651
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l280
+						goto l651
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l280
+						goto l651
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l280
+						goto l651
 					}
 					position++
-					goto l270
-				l280:
-					position, tokenIndex = position270, tokenIndex270
+					goto l641
+				l651:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('s') {
-						goto l268
+						goto l639
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l268
+						goto l639
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l268
+						goto l639
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l268
+						goto l639
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l268
+						goto l639
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l268
+						goto l639
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l268
+						goto l639
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l268
+						goto l639
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l268
+						goto l639
 					}
 					position++
 				}
-			l270:
+			l641:
 				{
-					position281, tokenIndex281 := position, tokenIndex
+					position652, tokenIndex652 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l282
+						goto l653
 					}
 					position++
-					goto l281
-				l282:
-					position, tokenIndex = position281, tokenIndex281
+					goto l652
+				l653:
+					position, tokenIndex = position652, tokenIndex652
 					{
-						position283, tokenIndex283 := position, tokenIndex
+						position654, tokenIndex654 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l268
+							goto l639
 						}
-						position, tokenIndex = position283, tokenIndex283
+						position, tokenIndex = position654, tokenIndex654
 					}
 				}
-			l281:
-				add(ruleRankUninomialPlain, position269)
+			l652:
+				add(ruleRankUninomialPlain, position640)
 			}
 			return true
-		l268:
-			position, tokenIndex = position268, tokenIndex268
+		l639:
+			position, tokenIndex = position639, tokenIndex639
 			return false
 		},
-		/* 36 RankUninomialNotho <- <('n' 'o' 't' 'h' 'o' _? (('s' 'e' 'c' 't') / ('g' 'e' 'n') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'e' 'n') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('s' 'u' 'b' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
+		/* 85 RankUninomialNotho <- <('n' 'o' 't' 'h' 'o' _? (('s' 'e' 'c' 't') / ('g' 'e' 'n') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'e' 'n') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('s' 'u' 'b' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position284, tokenIndex284 := position, tokenIndex
+			position655, tokenIndex655 := position, tokenIndex
 			{
-				position285 := position
+				position656 := position
 				if buffer[position] != rune('n') {
-					goto l284
+					goto l655
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l284
+					goto l655
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l284
+					goto l655
 				}
 				position++
 				if buffer[position] != rune('h') {
-					goto l284
+					goto l655
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l284
+					goto l655
 				}
 				position++
 				{
-					position286, tokenIndex286 := position, tokenIndex
+					position657, tokenIndex657 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l286
+						goto l657
 					}
-					goto l287
-				l286:
-					position, tokenIndex = position286, tokenIndex286
+					goto l658
+				l657:
+					position, tokenIndex = position657, tokenIndex657
 				}
-			l287:
+			l658:
 				{
-					position288, tokenIndex288 := position, tokenIndex
+					position659, tokenIndex659 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l289
+						goto l660
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l289
+						goto l660
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l289
+						goto l660
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l289
+						goto l660
 					}
 					position++
-					goto l288
-				l289:
-					position, tokenIndex = position288, tokenIndex288
+					goto l659
+				l660:
+					position, tokenIndex = position659, tokenIndex659
 					if buffer[position] != rune('g') {
-						goto l290
+						goto l661
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l290
+						goto l661
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l290
+						goto l661
 					}
 					position++
-					goto l288
-				l290:
-					position, tokenIndex = position288, tokenIndex288
+					goto l659
+				l661:
+					position, tokenIndex = position659, tokenIndex659
 					if buffer[position] != rune('s') {
-						goto l291
+						goto l662
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l291
+						goto l662
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l291
+						goto l662
 					}
 					position++
-					goto l288
-				l291:
-					position, tokenIndex = position288, tokenIndex288
+					goto l659
+				l662:
+					position, tokenIndex = position659, tokenIndex659
 					if buffer[position] != rune('s') {
-						goto l292
+						goto l663
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l292
+						goto l663
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l292
+						goto l663
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l292
+						goto l663
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l292
+						goto l663
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l292
+						goto l663
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l292
+						goto l663
 					}
 					position++
-					goto l288
-				l292:
-					position, tokenIndex = position288, tokenIndex288
+					goto l659
+				l663:
+					position, tokenIndex = position659, tokenIndex659
 					if buffer[position] != rune('s') {
-						goto l293
+						goto l664
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l293
+						goto l664
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l293
+						goto l664
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l293
+						goto l664
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l293
+						goto l664
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l293
+						goto l664
 					}
 					position++
-					goto l288
-				l293:
-					position, tokenIndex = position288, tokenIndex288
+					goto l659
+				l664:
+					position, tokenIndex = position659, tokenIndex659
 					if buffer[position] != rune('s') {
-						goto l294
+						goto l665
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l294
+						goto l665
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l294
+						goto l665
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l294
+						goto l665
 					}
 					position++
-					goto l288
-				l294:
-					position, tokenIndex = position288, tokenIndex288
+					goto l659
+				l665:
+					position, tokenIndex = position659, tokenIndex659
 					if buffer[position] != rune('s') {
-						goto l295
+						goto l666
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l295
+						goto l666
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l295
+						goto l666
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l295
+						goto l666
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l295
+						goto l666
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l295
+						goto l666
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l295
+						goto l666
 					}
 					position++
-					goto l288
-				l295:
-					position, tokenIndex = position288, tokenIndex288
+					goto l659
+				l666:
+					position, tokenIndex = position659, tokenIndex659
 					if buffer[position] != rune('s') {
-						goto l284
+						goto l655
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l284
+						goto l655
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l284
+						goto l655
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l284
+						goto l655
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l284
+						goto l655
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l284
+						goto l655
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l284
+						goto l655
 					}
 					position++
 				}
-			l288:
+			l659:
 				{
-					position296, tokenIndex296 := position, tokenIndex
+					position667, tokenIndex667 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l297
+						goto l668
 					}
 					position++
-					goto l296
-				l297:
-					position, tokenIndex = position296, tokenIndex296
+					goto l667
+				l668:
+					position, tokenIndex = position667, tokenIndex667
 					{
-						position298, tokenIndex298 := position, tokenIndex
+						position669, tokenIndex669 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l284
+							goto l655
 						}
-						position, tokenIndex = position298, tokenIndex298
+						position, tokenIndex = position669, tokenIndex669
 					}
 				}
-			l296:
-				add(ruleRankUninomialNotho, position285)
+			l667:
+				add(ruleRankUninomialNotho, position656)
 			}
 			return true
-		l284:
-			position, tokenIndex = position284, tokenIndex284
+		l655:
+			position, tokenIndex = position655, tokenIndex655
 			return false
 		},
-		/* 37 Uninomial <- <(UninomialWord (_ Authorship !(_ LowerCharExtended LowerCharExtended LowerCharExtended))?)> */
+		/* 86 Uninomial <- <(UninomialWord (_ Authorship !(_ LowerCharExtended LowerCharExtended LowerCharExtended))?)> */
 		func() bool {
-			position299, tokenIndex299 := position, tokenIndex
+			position670, tokenIndex670 := position, tokenIndex
 			{
-				position300 := position
+				position671 := position
 				if !_rules[ruleUninomialWord]() {
-					goto l299
+					goto l670
 				}
 				{
-					position301, tokenIndex301 := position, tokenIndex
+					position672, tokenIndex672 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l301
+						goto l672
 					}
 					if !_rules[ruleAuthorship]() {
-						goto l301
+						goto l672
 					}
 					{
-						position303, tokenIndex303 := position, tokenIndex
+						position674, tokenIndex674 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l303
+							goto l674
 						}
 						if !_rules[ruleLowerCharExtended]() {
-							goto l303
+							goto l674
 						}
 						if !_rules[ruleLowerCharExtended]() {
-							goto l303
+							goto l674
 						}
 						if !_rules[ruleLowerCharExtended]() {
-							goto l303
+							goto l674
 						}
-						goto l301
-					l303:
-						position, tokenIndex = position303, tokenIndex303
+						goto l672
+					l674:
+						position, tokenIndex = position674, tokenIndex674
 					}
-					goto l302
-				l301:
-					position, tokenIndex = position301, tokenIndex301
+					goto l673
+				l672:
+					position, tokenIndex = position672, tokenIndex672
 				}
-			l302:
-				add(ruleUninomial, position300)
+			l673:
+				add(ruleUninomial, position671)
 			}
 			return true
-		l299:
-			position, tokenIndex = position299, tokenIndex299
+		l670:
+			position, tokenIndex = position670, tokenIndex670
 			return false
 		},
-		/* 38 UninomialWord <- <(CapWord / TwoLetterGenus)> */
+		/* 87 UninomialWord <- <(CapWord / TwoLetterGenus)> */
 		func() bool {
-			position304, tokenIndex304 := position, tokenIndex
+			position675, tokenIndex675 := position, tokenIndex
 			{
-				position305 := position
+				position676 := position
 				{
-					position306, tokenIndex306 := position, tokenIndex
+					position677, tokenIndex677 := position, tokenIndex
 					if !_rules[ruleCapWord]() {
-						goto l307
+						goto l678
 					}
-					goto l306
-				l307:
-					position, tokenIndex = position306, tokenIndex306
+					goto l677
+				l678:
+					position, tokenIndex = position677, tokenIndex677
 					if !_rules[ruleTwoLetterGenus]() {
-						goto l304
+						goto l675
 					}
 				}
-			l306:
-				add(ruleUninomialWord, position305)
+			l677:
+				add(ruleUninomialWord, position676)
 			}
 			return true
-		l304:
-			position, tokenIndex = position304, tokenIndex304
+		l675:
+			position, tokenIndex = position675, tokenIndex675
 			return false
 		},
-		/* 39 AbbrSubgenus <- <(UpperChar LowerChar* '.')> */
+		/* 88 AbbrSubgenus <- <(UpperChar LowerChar* '.')> */
 		func() bool {
-			position308, tokenIndex308 := position, tokenIndex
+			position679, tokenIndex679 := position, tokenIndex
 			{
-				position309 := position
+				position680 := position
 				if !_rules[ruleUpperChar]() {
-					goto l308
+					goto l679
 				}
-			l310:
+			l681:
 				{
-					position311, tokenIndex311 := position, tokenIndex
+					position682, tokenIndex682 := position, tokenIndex
 					if !_rules[ruleLowerChar]() {
-						goto l311
+						goto l682
 					}
-					goto l310
-				l311:
-					position, tokenIndex = position311, tokenIndex311
+					goto l681
+				l682:
+					position, tokenIndex = position682, tokenIndex682
 				}
 				if buffer[position] != rune('.') {
-					goto l308
+					goto l679
 				}
 				position++
-				add(ruleAbbrSubgenus, position309)
+				add(ruleAbbrSubgenus, position680)
 			}
 			return true
-		l308:
-			position, tokenIndex = position308, tokenIndex308
+		l679:
+			position, tokenIndex = position679, tokenIndex679
 			return false
 		},
-		/* 40 AbbrGenus <- <(UpperChar LowerChar? '.')> */
+		/* 89 AbbrGenus <- <(UpperChar LowerChar? '.')> */
 		func() bool {
-			position312, tokenIndex312 := position, tokenIndex
+			position683, tokenIndex683 := position, tokenIndex
 			{
-				position313 := position
+				position684 := position
 				if !_rules[ruleUpperChar]() {
-					goto l312
+					goto l683
 				}
 				{
-					position314, tokenIndex314 := position, tokenIndex
+					position685, tokenIndex685 := position, tokenIndex
 					if !_rules[ruleLowerChar]() {
-						goto l314
+						goto l685
 					}
-					goto l315
-				l314:
-					position, tokenIndex = position314, tokenIndex314
+					goto l686
+				l685:
+					position, tokenIndex = position685, tokenIndex685
 				}
-			l315:
+			l686:
 				if buffer[position] != rune('.') {
-					goto l312
+					goto l683
 				}
 				position++
-				add(ruleAbbrGenus, position313)
+				add(ruleAbbrGenus, position684)
 			}
 			return true
-		l312:
-			position, tokenIndex = position312, tokenIndex312
+		l683:
+			position, tokenIndex = position683, tokenIndex683
 			return false
 		},
-		/* 41 CapWord <- <(CapWordWithDash / CapWord1)> */
+		/* 90 CapWord <- <(CapWordWithDash / CapWord1)> */
 		func() bool {
-			position316, tokenIndex316 := position, tokenIndex
+			position687, tokenIndex687 := position, tokenIndex
 			{
-				position317 := position
+				position688 := position
 				{
-					position318, tokenIndex318 := position, tokenIndex
+					position689, tokenIndex689 := position, tokenIndex
 					if !_rules[ruleCapWordWithDash]() {
-						goto l319
+						goto l690
 					}
-					goto l318
-				l319:
-					position, tokenIndex = position318, tokenIndex318
+					goto l689
+				l690:
+					position, tokenIndex = position689, tokenIndex689
 					if !_rules[ruleCapWord1]() {
-						goto l316
+						goto l687
 					}
 				}
-			l318:
-				add(ruleCapWord, position317)
+			l689:
+				add(ruleCapWord, position688)
 			}
 			return true
-		l316:
-			position, tokenIndex = position316, tokenIndex316
+		l687:
+			position, tokenIndex = position687, tokenIndex687
 			return false
 		},
-		/* 42 CapWord1 <- <(NameUpperChar NameLowerChar NameLowerChar+ '?'?)> */
+		/* 91 CapWord1 <- <(NameUpperChar NameLowerChar NameLowerChar+ '?'?)> */
 		func() bool {
-			position320, tokenIndex320 := position, tokenIndex
+			position691, tokenIndex691 := position, tokenIndex
 			{
-				position321 := position
+				position692 := position
 				if !_rules[ruleNameUpperChar]() {
-					goto l320
+					goto l691
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l320
+					goto l691
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l320
+					goto l691
 				}
-			l322:
+			l693:
 				{
-					position323, tokenIndex323 := position, tokenIndex
+					position694, tokenIndex694 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l323
+						goto l694
 					}
-					goto l322
-				l323:
-					position, tokenIndex = position323, tokenIndex323
+					goto l693
+				l694:
+					position, tokenIndex = position694, tokenIndex694
 				}
 				{
-					position324, tokenIndex324 := position, tokenIndex
+					position695, tokenIndex695 := position, tokenIndex
 					if buffer[position] != rune('?') {
-						goto l324
+						goto l695
 					}
 					position++
-					goto l325
-				l324:
-					position, tokenIndex = position324, tokenIndex324
+					goto l696
+				l695:
+					position, tokenIndex = position695, tokenIndex695
 				}
-			l325:
-				add(ruleCapWord1, position321)
+			l696:
+				add(ruleCapWord1, position692)
 			}
 			return true
-		l320:
-			position, tokenIndex = position320, tokenIndex320
+		l691:
+			position, tokenIndex = position691, tokenIndex691
 			return false
 		},
-		/* 43 CapWordWithDash <- <(CapWord1 Dash (UpperAfterDash / LowerAfterDash))> */
+		/* 92 CapWordWithDash <- <(CapWord1 Dash (UpperAfterDash / LowerAfterDash))> */
 		func() bool {
-			position326, tokenIndex326 := position, tokenIndex
+			position697, tokenIndex697 := position, tokenIndex
 			{
-				position327 := position
+				position698 := position
 				if !_rules[ruleCapWord1]() {
-					goto l326
+					goto l697
 				}
 				if !_rules[ruleDash]() {
-					goto l326
+					goto l697
 				}
 				{
-					position328, tokenIndex328 := position, tokenIndex
+					position699, tokenIndex699 := position, tokenIndex
 					if !_rules[ruleUpperAfterDash]() {
-						goto l329
+						goto l700
 					}
-					goto l328
-				l329:
-					position, tokenIndex = position328, tokenIndex328
+					goto l699
+				l700:
+					position, tokenIndex = position699, tokenIndex699
 					if !_rules[ruleLowerAfterDash]() {
-						goto l326
+						goto l697
 					}
 				}
-			l328:
-				add(ruleCapWordWithDash, position327)
+			l699:
+				add(ruleCapWordWithDash, position698)
 			}
 			return true
-		l326:
-			position, tokenIndex = position326, tokenIndex326
+		l697:
+			position, tokenIndex = position697, tokenIndex697
 			return false
 		},
-		/* 44 UpperAfterDash <- <CapWord1> */
+		/* 93 UpperAfterDash <- <CapWord1> */
 		func() bool {
-			position330, tokenIndex330 := position, tokenIndex
+			position701, tokenIndex701 := position, tokenIndex
 			{
-				position331 := position
+				position702 := position
 				if !_rules[ruleCapWord1]() {
-					goto l330
+					goto l701
 				}
-				add(ruleUpperAfterDash, position331)
+				add(ruleUpperAfterDash, position702)
 			}
 			return true
-		l330:
-			position, tokenIndex = position330, tokenIndex330
+		l701:
+			position, tokenIndex = position701, tokenIndex701
 			return false
 		},
-		/* 45 LowerAfterDash <- <Word1> */
+		/* 94 LowerAfterDash <- <Word1> */
 		func() bool {
-			position332, tokenIndex332 := position, tokenIndex
+			position703, tokenIndex703 := position, tokenIndex
 			{
-				position333 := position
+				position704 := position
 				if !_rules[ruleWord1]() {
-					goto l332
+					goto l703
 				}
-				add(ruleLowerAfterDash, position333)
+				add(ruleLowerAfterDash, position704)
 			}
 			return true
-		l332:
-			position, tokenIndex = position332, tokenIndex332
+		l703:
+			position, tokenIndex = position703, tokenIndex703
 			return false
 		},
-		/* 46 TwoLetterGenus <- <(('C' 'a') / ('E' 'a') / ('G' 'e') / ('I' 'a') / ('I' 'o') / ('I' 'x') / ('L' 'o') / ('O' 'a') / ('R' 'a') / ('T' 'y') / ('U' 'a') / ('A' 'a') / ('J' 'a') / ('Z' 'u') / ('L' 'a') / ('Q' 'u') / ('A' 's') / ('B' 'a'))> */
+		/* 95 TwoLetterGenus <- <(('C' 'a') / ('E' 'a') / ('G' 'e') / ('I' 'a') / ('I' 'o') / ('I' 'x') / ('L' 'o') / ('O' 'a') / ('R' 'a') / ('T' 'y') / ('U' 'a') / ('A' 'a') / ('J' 'a') / ('Z' 'u') / ('L' 'a') / ('Q' 'u') / ('A' 's') / ('B' 'a'))> */
 		func() bool {
-			position334, tokenIndex334 := position, tokenIndex
+			position705, tokenIndex705 := position, tokenIndex
 			{
-				position335 := position
+				position706 := position
 				{
-					position336, tokenIndex336 := position, tokenIndex
+					position707, tokenIndex707 := position, tokenIndex
 					if buffer[position] != rune('C') {
-						goto l337
+						goto l708
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l337
+						goto l708
 					}
 					position++
-					goto l336
-				l337:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l708:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('E') {
-						goto l338
+						goto l709
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l338
+						goto l709
 					}
 					position++
-					goto l336
-				l338:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l709:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('G') {
-						goto l339
+						goto l710
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l339
+						goto l710
 					}
 					position++
-					goto l336
-				l339:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l710:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('I') {
-						goto l340
+						goto l711
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l340
+						goto l711
 					}
 					position++
-					goto l336
-				l340:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l711:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('I') {
-						goto l341
+						goto l712
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l341
+						goto l712
 					}
 					position++
-					goto l336
-				l341:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l712:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('I') {
-						goto l342
+						goto l713
 					}
 					position++
 					if buffer[position] != rune('x') {
-						goto l342
+						goto l713
 					}
 					position++
-					goto l336
-				l342:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l713:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('L') {
-						goto l343
+						goto l714
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l343
+						goto l714
 					}
 					position++
-					goto l336
-				l343:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l714:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('O') {
-						goto l344
+						goto l715
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l344
+						goto l715
 					}
 					position++
-					goto l336
-				l344:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l715:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('R') {
-						goto l345
+						goto l716
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l345
+						goto l716
 					}
 					position++
-					goto l336
-				l345:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l716:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('T') {
-						goto l346
+						goto l717
 					}
 					position++
 					if buffer[position] != rune('y') {
-						goto l346
+						goto l717
 					}
 					position++
-					goto l336
-				l346:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l717:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('U') {
-						goto l347
+						goto l718
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l347
+						goto l718
 					}
 					position++
-					goto l336
-				l347:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l718:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('A') {
-						goto l348
+						goto l719
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l348
+						goto l719
 					}
 					position++
-					goto l336
-				l348:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l719:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('J') {
-						goto l349
+						goto l720
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l349
+						goto l720
 					}
 					position++
-					goto l336
-				l349:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l720:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('Z') {
-						goto l350
+						goto l721
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l350
+						goto l721
 					}
 					position++
-					goto l336
-				l350:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l721:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('L') {
-						goto l351
+						goto l722
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l351
+						goto l722
 					}
 					position++
-					goto l336
-				l351:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l722:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('Q') {
-						goto l352
+						goto l723
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l352
+						goto l723
 					}
 					position++
-					goto l336
-				l352:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l723:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('A') {
-						goto l353
+						goto l724
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l353
+						goto l724
 					}
 					position++
-					goto l336
-				l353:
-					position, tokenIndex = position336, tokenIndex336
+					goto l707
+				l724:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('B') {
-						goto l334
+						goto l705
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l334
+						goto l705
 					}
 					position++
 				}
-			l336:
-				add(ruleTwoLetterGenus, position335)
+			l707:
+				add(ruleTwoLetterGenus, position706)
 			}
 			return true
-		l334:
-			position, tokenIndex = position334, tokenIndex334
+		l705:
+			position, tokenIndex = position705, tokenIndex705
 			return false
 		},
-		/* 47 Word <- <(!((('e' 'x') / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd') / ('p' 'r' 'o') / AuthorPrefix / RankUninomial / Approximation / Word4) SpaceCharEOI) (WordApostr / WordStartsWithDigit / MultiDashedWord / Word2 / Word1) &(SpaceCharEOI / '('))> */
+		/* 96 Word <- <(!((('e' 'x') / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd') / ('p' 'r' 'o') / AuthorPrefix / RankUninomial / Approximation / Word4 / DesignationRankWord) SpaceCharEOI) !('s' 't' 'r' 'a' 'i' 'n' _ StrainChar) (WordApostr / WordStartsWithDigit / MultiDashedWord / Word2 / Word1) &(SpaceCharEOI / '(' / ')' / '?' / '†'))> */
 		func() bool {
-			position354, tokenIndex354 := position, tokenIndex
+			position725, tokenIndex725 := position, tokenIndex
 			{
-				position355 := position
+				position726 := position
 				{
-					position356, tokenIndex356 := position, tokenIndex
+					position727, tokenIndex727 := position, tokenIndex
 					{
-						position357, tokenIndex357 := position, tokenIndex
+						position728, tokenIndex728 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l358
+							goto l729
 						}
 						position++
 						if buffer[position] != rune('x') {
-							goto l358
+							goto l729
 						}
 						position++
-						goto l357
-					l358:
-						position, tokenIndex = position357, tokenIndex357
+						goto l728
+					l729:
+						position, tokenIndex = position728, tokenIndex728
 						if buffer[position] != rune('e') {
-							goto l359
+							goto l730
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l359
+							goto l730
 						}
 						position++
-						goto l357
-					l359:
-						position, tokenIndex = position357, tokenIndex357
+						goto l728
+					l730:
+						position, tokenIndex = position728, tokenIndex728
 						if buffer[position] != rune('a') {
-							goto l360
+							goto l731
 						}
 						position++
 						if buffer[position] != rune('n') {
-							goto l360
+							goto l731
 						}
 						position++
 						if buffer[position] != rune('d') {
-							goto l360
+							goto l731
 						}
 						position++
-						goto l357
-					l360:
-						position, tokenIndex = position357, tokenIndex357
+						goto l728
+					l731:
+						position, tokenIndex = position728, tokenIndex728
 						if buffer[position] != rune('a') {
-							goto l361
+							goto l732
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l361
+							goto l732
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l361
+							goto l732
 						}
 						position++
 						if buffer[position] != rune('d') {
-							goto l361
+							goto l732
 						}
 						position++
-						goto l357
-					l361:
-						position, tokenIndex = position357, tokenIndex357
+						goto l728
+					l732:
+						position, tokenIndex = position728, tokenIndex728
 						if buffer[position] != rune('p') {
-							goto l362
+							goto l733
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l362
+							goto l733
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l362
+							goto l733
 						}
 						position++
-						goto l357
-					l362:
-						position, tokenIndex = position357, tokenIndex357
+						goto l728
+					l733:
+						position, tokenIndex = position728, tokenIndex728
 						if !_rules[ruleAuthorPrefix]() {
-							goto l363
+							goto l734
 						}
-						goto l357
-					l363:
-						position, tokenIndex = position357, tokenIndex357
+						goto l728
+					l734:
+						position, tokenIndex = position728, tokenIndex728
 						if !_rules[ruleRankUninomial]() {
-							goto l364
+							goto l735
 						}
-						goto l357
-					l364:
-						position, tokenIndex = position357, tokenIndex357
+						goto l728
+					l735:
+						position, tokenIndex = position728, tokenIndex728
 						if !_rules[ruleApproximation]() {
-							goto l365
+							goto l736
 						}
-						goto l357
-					l365:
-						position, tokenIndex = position357, tokenIndex357
+						goto l728
+					l736:
+						position, tokenIndex = position728, tokenIndex728
 						if !_rules[ruleWord4]() {
-							goto l356
+							goto l737
 						}
+						goto l728
+					l737:
+						position, tokenIndex = position728, tokenIndex728
+						if !_rules[ruleDesignationRankWord]() {
+							goto l727
+						}
+					}
+				l728:
+					if !_rules[ruleSpaceCharEOI]() {
+						goto l727
+					}
+					goto l725
+				l727:
+					position, tokenIndex = position727, tokenIndex727
+				}
+				{
+					position738, tokenIndex738 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l738
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l738
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l738
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l738
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l738
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l738
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l738
 					}
-				l357:
-					if !_rules[ruleSpaceCharEOI]() {
-						goto l356
+					if !_rules[ruleStrainChar]() {
+						goto l738
 					}
-					goto l354
-				l356:
-					position, tokenIndex = position356, tokenIndex356
+					goto l725
+				l738:
+					position, tokenIndex = position738, tokenIndex738
 				}
 				{
-					position366, tokenIndex366 := position, tokenIndex
+					position739, tokenIndex739 := position, tokenIndex
 					if !_rules[ruleWordApostr]() {
-						goto l367
+						goto l740
 					}
-					goto l366
-				l367:
-					position, tokenIndex = position366, tokenIndex366
+					goto l739
+				l740:
+					position, tokenIndex = position739, tokenIndex739
 					if !_rules[ruleWordStartsWithDigit]() {
-						goto l368
+						goto l741
 					}
-					goto l366
-				l368:
-					position, tokenIndex = position366, tokenIndex366
+					goto l739
+				l741:
+					position, tokenIndex = position739, tokenIndex739
 					if !_rules[ruleMultiDashedWord]() {
-						goto l369
+						goto l742
 					}
-					goto l366
-				l369:
-					position, tokenIndex = position366, tokenIndex366
+					goto l739
+				l742:
+					position, tokenIndex = position739, tokenIndex739
 					if !_rules[ruleWord2]() {
-						goto l370
+						goto l743
 					}
-					goto l366
-				l370:
-					position, tokenIndex = position366, tokenIndex366
+					goto l739
+				l743:
+					position, tokenIndex = position739, tokenIndex739
 					if !_rules[ruleWord1]() {
-						goto l354
+						goto l725
 					}
 				}
-			l366:
+			l739:
 				{
-					position371, tokenIndex371 := position, tokenIndex
+					position744, tokenIndex744 := position, tokenIndex
 					{
-						position372, tokenIndex372 := position, tokenIndex
+						position745, tokenIndex745 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l373
+							goto l746
 						}
-						goto l372
-					l373:
-						position, tokenIndex = position372, tokenIndex372
+						goto l745
+					l746:
+						position, tokenIndex = position745, tokenIndex745
 						if buffer[position] != rune('(') {
-							goto l354
+							goto l747
+						}
+						position++
+						goto l745
+					l747:
+						position, tokenIndex = position745, tokenIndex745
+						if buffer[position] != rune(')') {
+							goto l748
+						}
+						position++
+						goto l745
+					l748:
+						position, tokenIndex = position745, tokenIndex745
+						if buffer[position] != rune('?') {
+							goto l749
+						}
+						position++
+						goto l745
+					l749:
+						position, tokenIndex = position745, tokenIndex745
+						if buffer[position] != rune('†') {
+							goto l725
 						}
 						position++
 					}
-				l372:
-					position, tokenIndex = position371, tokenIndex371
+				l745:
+					position, tokenIndex = position744, tokenIndex744
 				}
-				add(ruleWord, position355)
+				add(ruleWord, position726)
 			}
 			return true
-		l354:
-			position, tokenIndex = position354, tokenIndex354
+		l725:
+			position, tokenIndex = position725, tokenIndex725
 			return false
 		},
-		/* 48 Word1 <- <((LowerASCII Dash)? NameLowerChar NameLowerChar+)> */
+		/* 97 Word1 <- <((LowerASCII Dash)? NameLowerChar NameLowerChar+)> */
 		func() bool {
-			position374, tokenIndex374 := position, tokenIndex
+			position750, tokenIndex750 := position, tokenIndex
 			{
-				position375 := position
+				position751 := position
 				{
-					position376, tokenIndex376 := position, tokenIndex
+					position752, tokenIndex752 := position, tokenIndex
 					if !_rules[ruleLowerASCII]() {
-						goto l376
+						goto l752
 					}
 					if !_rules[ruleDash]() {
-						goto l376
+						goto l752
 					}
-					goto l377
-				l376:
-					position, tokenIndex = position376, tokenIndex376
+					goto l753
+				l752:
+					position, tokenIndex = position752, tokenIndex752
 				}
-			l377:
+			l753:
 				if !_rules[ruleNameLowerChar]() {
-					goto l374
+					goto l750
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l374
+					goto l750
 				}
-			l378:
+			l754:
 				{
-					position379, tokenIndex379 := position, tokenIndex
+					position755, tokenIndex755 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l379
+						goto l755
 					}
-					goto l378
-				l379:
-					position, tokenIndex = position379, tokenIndex379
+					goto l754
+				l755:
+					position, tokenIndex = position755, tokenIndex755
 				}
-				add(ruleWord1, position375)
+				add(ruleWord1, position751)
 			}
 			return true
-		l374:
-			position, tokenIndex = position374, tokenIndex374
+		l750:
+			position, tokenIndex = position750, tokenIndex750
 			return false
 		},
-		/* 49 WordStartsWithDigit <- <(('1' / '2' / '3' / '4' / '5' / '6' / '7' / '8' / '9') Nums? ('.' / Dash)? NameLowerChar NameLowerChar NameLowerChar NameLowerChar+)> */
+		/* 98 WordStartsWithDigit <- <(('1' / '2' / '3' / '4' / '5' / '6' / '7' / '8' / '9') Nums? ('.' / Dash)? NameLowerChar NameLowerChar NameLowerChar NameLowerChar+)> */
 		func() bool {
-			position380, tokenIndex380 := position, tokenIndex
+			position756, tokenIndex756 := position, tokenIndex
 			{
-				position381 := position
+				position757 := position
 				{
-					position382, tokenIndex382 := position, tokenIndex
+					position758, tokenIndex758 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l383
+						goto l759
 					}
 					position++
-					goto l382
-				l383:
-					position, tokenIndex = position382, tokenIndex382
+					goto l758
+				l759:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('2') {
-						goto l384
+						goto l760
 					}
 					position++
-					goto l382
-				l384:
-					position, tokenIndex = position382, tokenIndex382
+					goto l758
+				l760:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('3') {
-						goto l385
+						goto l761
 					}
 					position++
-					goto l382
-				l385:
-					position, tokenIndex = position382, tokenIndex382
+					goto l758
+				l761:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('4') {
-						goto l386
+						goto l762
 					}
 					position++
-					goto l382
-				l386:
-					position, tokenIndex = position382, tokenIndex382
+					goto l758
+				l762:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('5') {
-						goto l387
+						goto l763
 					}
 					position++
-					goto l382
-				l387:
-					position, tokenIndex = position382, tokenIndex382
+					goto l758
+				l763:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('6') {
-						goto l388
+						goto l764
 					}
 					position++
-					goto l382
-				l388:
-					position, tokenIndex = position382, tokenIndex382
+					goto l758
+				l764:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('7') {
-						goto l389
+						goto l765
 					}
 					position++
-					goto l382
-				l389:
-					position, tokenIndex = position382, tokenIndex382
+					goto l758
+				l765:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('8') {
-						goto l390
+						goto l766
 					}
 					position++
-					goto l382
-				l390:
-					position, tokenIndex = position382, tokenIndex382
+					goto l758
+				l766:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('9') {
-						goto l380
+						goto l756
 					}
 					position++
 				}
-			l382:
+			l758:
 				{
-					position391, tokenIndex391 := position, tokenIndex
+					position767, tokenIndex767 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l391
+						goto l767
 					}
-					goto l392
-				l391:
-					position, tokenIndex = position391, tokenIndex391
+					goto l768
+				l767:
+					position, tokenIndex = position767, tokenIndex767
 				}
-			l392:
+			l768:
 				{
-					position393, tokenIndex393 := position, tokenIndex
+					position769, tokenIndex769 := position, tokenIndex
 					{
-						position395, tokenIndex395 := position, tokenIndex
+						position771, tokenIndex771 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l396
+							goto l772
 						}
 						position++
-						goto l395
-					l396:
-						position, tokenIndex = position395, tokenIndex395
+						goto l771
+					l772:
+						position, tokenIndex = position771, tokenIndex771
 						if !_rules[ruleDash]() {
-							goto l393
+							goto l769
 						}
 					}
-				l395:
-					goto l394
-				l393:
-					position, tokenIndex = position393, tokenIndex393
+				l771:
+					goto l770
+				l769:
+					position, tokenIndex = position769, tokenIndex769
 				}
-			l394:
+			l770:
 				if !_rules[ruleNameLowerChar]() {
-					goto l380
+					goto l756
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l380
+					goto l756
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l380
+					goto l756
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l380
+					goto l756
 				}
-			l397:
+			l773:
 				{
-					position398, tokenIndex398 := position, tokenIndex
+					position774, tokenIndex774 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l398
+						goto l774
 					}
-					goto l397
-				l398:
-					position, tokenIndex = position398, tokenIndex398
+					goto l773
+				l774:
+					position, tokenIndex = position774, tokenIndex774
 				}
-				add(ruleWordStartsWithDigit, position381)
+				add(ruleWordStartsWithDigit, position757)
 			}
 			return true
-		l380:
-			position, tokenIndex = position380, tokenIndex380
+		l756:
+			position, tokenIndex = position756, tokenIndex756
 			return false
 		},
-		/* 50 Word2 <- <(NameLowerChar+ Dash? NameLowerChar+)> */
+		/* 99 Word2 <- <(NameLowerChar+ Dash? NameLowerChar+)> */
 		func() bool {
-			position399, tokenIndex399 := position, tokenIndex
+			position775, tokenIndex775 := position, tokenIndex
 			{
-				position400 := position
+				position776 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l399
+					goto l775
 				}
-			l401:
+			l777:
 				{
-					position402, tokenIndex402 := position, tokenIndex
+					position778, tokenIndex778 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l402
+						goto l778
 					}
-					goto l401
-				l402:
-					position, tokenIndex = position402, tokenIndex402
+					goto l777
+				l778:
+					position, tokenIndex = position778, tokenIndex778
 				}
 				{
-					position403, tokenIndex403 := position, tokenIndex
+					position779, tokenIndex779 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l403
+						goto l779
 					}
-					goto l404
-				l403:
-					position, tokenIndex = position403, tokenIndex403
+					goto l780
+				l779:
+					position, tokenIndex = position779, tokenIndex779
 				}
-			l404:
+			l780:
 				if !_rules[ruleNameLowerChar]() {
-					goto l399
+					goto l775
 				}
-			l405:
+			l781:
 				{
-					position406, tokenIndex406 := position, tokenIndex
+					position782, tokenIndex782 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l406
+						goto l782
 					}
-					goto l405
-				l406:
-					position, tokenIndex = position406, tokenIndex406
+					goto l781
+				l782:
+					position, tokenIndex = position782, tokenIndex782
 				}
-				add(ruleWord2, position400)
+				add(ruleWord2, position776)
 			}
 			return true
-		l399:
-			position, tokenIndex = position399, tokenIndex399
+		l775:
+			position, tokenIndex = position775, tokenIndex775
 			return false
 		},
-		/* 51 WordApostr <- <(NameLowerChar NameLowerChar* Apostrophe Word1)> */
+		/* 100 WordApostr <- <(NameLowerChar NameLowerChar* Apostrophe Word1)> */
 		func() bool {
-			position407, tokenIndex407 := position, tokenIndex
+			position783, tokenIndex783 := position, tokenIndex
 			{
-				position408 := position
+				position784 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l407
+					goto l783
 				}
-			l409:
+			l785:
 				{
-					position410, tokenIndex410 := position, tokenIndex
+					position786, tokenIndex786 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l410
+						goto l786
 					}
-					goto l409
-				l410:
-					position, tokenIndex = position410, tokenIndex410
+					goto l785
+				l786:
+					position, tokenIndex = position786, tokenIndex786
 				}
 				if !_rules[ruleApostrophe]() {
-					goto l407
+					goto l783
 				}
 				if !_rules[ruleWord1]() {
-					goto l407
+					goto l783
 				}
-				add(ruleWordApostr, position408)
+				add(ruleWordApostr, position784)
 			}
 			return true
-		l407:
-			position, tokenIndex = position407, tokenIndex407
+		l783:
+			position, tokenIndex = position783, tokenIndex783
 			return false
 		},
-		/* 52 Word4 <- <(NameLowerChar+ '.' NameLowerChar)> */
+		/* 101 Word4 <- <(NameLowerChar+ '.' NameLowerChar)> */
 		func() bool {
-			position411, tokenIndex411 := position, tokenIndex
+			position787, tokenIndex787 := position, tokenIndex
 			{
-				position412 := position
+				position788 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l411
+					goto l787
 				}
-			l413:
+			l789:
 				{
-					position414, tokenIndex414 := position, tokenIndex
+					position790, tokenIndex790 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l414
+						goto l790
 					}
-					goto l413
-				l414:
-					position, tokenIndex = position414, tokenIndex414
+					goto l789
+				l790:
+					position, tokenIndex = position790, tokenIndex790
 				}
 				if buffer[position] != rune('.') {
-					goto l411
+					goto l787
 				}
 				position++
 				if !_rules[ruleNameLowerChar]() {
-					goto l411
+					goto l787
 				}
-				add(ruleWord4, position412)
+				add(ruleWord4, position788)
 			}
 			return true
-		l411:
-			position, tokenIndex = position411, tokenIndex411
+		l787:
+			position, tokenIndex = position787, tokenIndex787
 			return false
 		},
-		/* 53 MultiDashedWord <- <(NameLowerChar+ Dash NameLowerChar+ Dash NameLowerChar+ (Dash NameLowerChar+)?)> */
+		/* 102 MultiDashedWord <- <(NameLowerChar+ Dash NameLowerChar+ Dash NameLowerChar+ (Dash NameLowerChar+)?)> */
 		func() bool {
-			position415, tokenIndex415 := position, tokenIndex
+			position791, tokenIndex791 := position, tokenIndex
 			{
-				position416 := position
+				position792 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l415
+					goto l791
 				}
-			l417:
+			l793:
 				{
-					position418, tokenIndex418 := position, tokenIndex
+					position794, tokenIndex794 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l418
+						goto l794
 					}
-					goto l417
-				l418:
-					position, tokenIndex = position418, tokenIndex418
+					goto l793
+				l794:
+					position, tokenIndex = position794, tokenIndex794
 				}
 				if !_rules[ruleDash]() {
-					goto l415
+					goto l791
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l415
+					goto l791
 				}
-			l419:
+			l795:
 				{
-					position420, tokenIndex420 := position, tokenIndex
+					position796, tokenIndex796 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l420
+						goto l796
 					}
-					goto l419
-				l420:
-					position, tokenIndex = position420, tokenIndex420
+					goto l795
+				l796:
+					position, tokenIndex = position796, tokenIndex796
 				}
 				if !_rules[ruleDash]() {
-					goto l415
+					goto l791
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l415
+					goto l791
 				}
-			l421:
+			l797:
 				{
-					position422, tokenIndex422 := position, tokenIndex
+					position798, tokenIndex798 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l422
+						goto l798
 					}
-					goto l421
-				l422:
-					position, tokenIndex = position422, tokenIndex422
+					goto l797
+				l798:
+					position, tokenIndex = position798, tokenIndex798
 				}
 				{
-					position423, tokenIndex423 := position, tokenIndex
+					position799, tokenIndex799 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l423
+						goto l799
 					}
 					if !_rules[ruleNameLowerChar]() {
-						goto l423
+						goto l799
 					}
-				l425:
+				l801:
 					{
-						position426, tokenIndex426 := position, tokenIndex
+						position802, tokenIndex802 := position, tokenIndex
 						if !_rules[ruleNameLowerChar]() {
-							goto l426
+							goto l802
 						}
-						goto l425
-					l426:
-						position, tokenIndex = position426, tokenIndex426
+						goto l801
+					l802:
+						position, tokenIndex = position802, tokenIndex802
 					}
-					goto l424
-				l423:
-					position, tokenIndex = position423, tokenIndex423
+					goto l800
+				l799:
+					position, tokenIndex = position799, tokenIndex799
 				}
-			l424:
-				add(ruleMultiDashedWord, position416)
+			l800:
+				add(ruleMultiDashedWord, position792)
 			}
 			return true
-		l415:
-			position, tokenIndex = position415, tokenIndex415
+		l791:
+			position, tokenIndex = position791, tokenIndex791
 			return false
 		},
-		/* 54 HybridChar <- <'×'> */
+		/* 103 HybridChar <- <'×'> */
 		func() bool {
-			position427, tokenIndex427 := position, tokenIndex
+			position803, tokenIndex803 := position, tokenIndex
 			{
-				position428 := position
+				position804 := position
 				if buffer[position] != rune('×') {
-					goto l427
+					goto l803
+				}
+				position++
+				add(ruleHybridChar, position804)
+			}
+			return true
+		l803:
+			position, tokenIndex = position803, tokenIndex803
+			return false
+		},
+		/* 104 GraftChimaeraChar <- <'+'> */
+		func() bool {
+			position805, tokenIndex805 := position, tokenIndex
+			{
+				position806 := position
+				if buffer[position] != rune('+') {
+					goto l805
 				}
 				position++
-				add(ruleHybridChar, position428)
+				add(ruleGraftChimaeraChar, position806)
 			}
 			return true
-		l427:
-			position, tokenIndex = position427, tokenIndex427
+		l805:
+			position, tokenIndex = position805, tokenIndex805
 			return false
 		},
-		/* 55 ApproxNameIgnored <- <.*> */
+		/* 105 ApproxNameIgnored <- <.*> */
 		func() bool {
 			{
-				position430 := position
-			l431:
+				position808 := position
+			l809:
 				{
-					position432, tokenIndex432 := position, tokenIndex
+					position810, tokenIndex810 := position, tokenIndex
 					if !matchDot() {
-						goto l432
+						goto l810
 					}
-					goto l431
-				l432:
-					position, tokenIndex = position432, tokenIndex432
+					goto l809
+				l810:
+					position, tokenIndex = position810, tokenIndex810
 				}
-				add(ruleApproxNameIgnored, position430)
+				add(ruleApproxNameIgnored, position808)
 			}
 			return true
 		},
-		/* 56 Approximation <- <(('s' 'p' '.' _? ('n' 'r' '.')) / ('s' 'p' '.' _? ('a' 'f' 'f' '.')) / ('m' 'o' 'n' 's' 't' '.') / '?' / ((('s' 'p' 'p') / ('n' 'r') / ('s' 'p') / ('a' 'f' 'f') / ('s' 'p' 'e' 'c' 'i' 'e' 's')) (&SpaceCharEOI / '.')))> */
+		/* 106 Approximation <- <(('s' 'p' '.' _? ('n' 'r' '.')) / ('s' 'p' '.' _? ('a' 'f' 'f' '.')) / ('m' 'o' 'n' 's' 't' '.') / ('?' !NameLowerChar) / ((('s' 'p' 'p') / ('n' 'r') / ('s' 'p') / ('a' 'f' 'f') / ('s' 'p' 'e' 'c' 'i' 'e' 's')) (&SpaceCharEOI / '.')))> */
 		func() bool {
-			position433, tokenIndex433 := position, tokenIndex
+			position811, tokenIndex811 := position, tokenIndex
 			{
-				position434 := position
+				position812 := position
 				{
-					position435, tokenIndex435 := position, tokenIndex
+					position813, tokenIndex813 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l436
+						goto l814
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l436
+						goto l814
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l436
+						goto l814
 					}
 					position++
 					{
-						position437, tokenIndex437 := position, tokenIndex
+						position815, tokenIndex815 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l437
+							goto l815
 						}
-						goto l438
-					l437:
-						position, tokenIndex = position437, tokenIndex437
+						goto l816
+					l815:
+						position, tokenIndex = position815, tokenIndex815
 					}
-				l438:
+				l816:
 					if buffer[position] != rune('n') {
-						goto l436
+						goto l814
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l436
+						goto l814
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l436
+						goto l814
 					}
 					position++
-					goto l435
-				l436:
-					position, tokenIndex = position435, tokenIndex435
+					goto l813
+				l814:
+					position, tokenIndex = position813, tokenIndex813
 					if buffer[position] != rune('s') {
-						goto l439
+						goto l817
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l439
+						goto l817
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l439
+						goto l817
 					}
 					position++
 					{
-						position440, tokenIndex440 := position, tokenIndex
+						position818, tokenIndex818 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l440
+							goto l818
 						}
-						goto l441
-					l440:
-						position, tokenIndex = position440, tokenIndex440
+						goto l819
+					l818:
+						position, tokenIndex = position818, tokenIndex818
 					}
-				l441:
+				l819:
 					if buffer[position] != rune('a') {
-						goto l439
+						goto l817
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l439
+						goto l817
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l439
+						goto l817
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l439
+						goto l817
 					}
 					position++
-					goto l435
-				l439:
-					position, tokenIndex = position435, tokenIndex435
+					goto l813
+				l817:
+					position, tokenIndex = position813, tokenIndex813
 					if buffer[position] != rune('m') {
-						goto l442
+						goto l820
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l442
+						goto l820
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l442
+						goto l820
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l442
+						goto l820
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l442
+						goto l820
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l442
+						goto l820
 					}
 					position++
-					goto l435
-				l442:
-					position, tokenIndex = position435, tokenIndex435
+					goto l813
+				l820:
+					position, tokenIndex = position813, tokenIndex813
 					if buffer[position] != rune('?') {
-						goto l443
+						goto l821
 					}
 					position++
-					goto l435
-				l443:
-					position, tokenIndex = position435, tokenIndex435
 					{
-						position444, tokenIndex444 := position, tokenIndex
+						position822, tokenIndex822 := position, tokenIndex
+						if !_rules[ruleNameLowerChar]() {
+							goto l822
+						}
+						goto l821
+					l822:
+						position, tokenIndex = position822, tokenIndex822
+					}
+					goto l813
+				l821:
+					position, tokenIndex = position813, tokenIndex813
+					{
+						position823, tokenIndex823 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l445
+							goto l824
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l445
+							goto l824
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l445
+							goto l824
 						}
 						position++
-						goto l444
-					l445:
-						position, tokenIndex = position444, tokenIndex444
+						goto l823
+					l824:
+						position, tokenIndex = position823, tokenIndex823
 						if buffer[position] != rune('n') {
-							goto l446
+							goto l825
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l446
+							goto l825
 						}
 						position++
-						goto l444
-					l446:
-						position, tokenIndex = position444, tokenIndex444
+						goto l823
+					l825:
+						position, tokenIndex = position823, tokenIndex823
 						if buffer[position] != rune('s') {
-							goto l447
+							goto l826
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l447
+							goto l826
 						}
 						position++
-						goto l444
-					l447:
-						position, tokenIndex = position444, tokenIndex444
+						goto l823
+					l826:
+						position, tokenIndex = position823, tokenIndex823
 						if buffer[position] != rune('a') {
-							goto l448
+							goto l827
 						}
 						position++
 						if buffer[position] != rune('f') {
-							goto l448
+							goto l827
 						}
 						position++
 						if buffer[position] != rune('f') {
-							goto l448
+							goto l827
 						}
 						position++
-						goto l444
-					l448:
-						position, tokenIndex = position444, tokenIndex444
+						goto l823
+					l827:
+						position, tokenIndex = position823, tokenIndex823
 						if buffer[position] != rune('s') {
-							goto l433
+							goto l811
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l433
+							goto l811
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l433
+							goto l811
 						}
 						position++
 						if buffer[position] != rune('c') {
-							goto l433
+							goto l811
 						}
 						position++
 						if buffer[position] != rune('i') {
-							goto l433
+							goto l811
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l433
+							goto l811
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l433
+							goto l811
 						}
 						position++
 					}
-				l444:
+				l823:
 					{
-						position449, tokenIndex449 := position, tokenIndex
+						position828, tokenIndex828 := position, tokenIndex
 						{
-							position451, tokenIndex451 := position, tokenIndex
+							position830, tokenIndex830 := position, tokenIndex
 							if !_rules[ruleSpaceCharEOI]() {
-								goto l450
+								goto l829
 							}
-							position, tokenIndex = position451, tokenIndex451
+							position, tokenIndex = position830, tokenIndex830
 						}
-						goto l449
-					l450:
-						position, tokenIndex = position449, tokenIndex449
+						goto l828
+					l829:
+						position, tokenIndex = position828, tokenIndex828
 						if buffer[position] != rune('.') {
-							goto l433
+							goto l811
 						}
 						position++
 					}
-				l449:
+				l828:
 				}
-			l435:
-				add(ruleApproximation, position434)
+			l813:
+				add(ruleApproximation, position812)
 			}
 			return true
-		l433:
-			position, tokenIndex = position433, tokenIndex433
+		l811:
+			position, tokenIndex = position811, tokenIndex811
 			return false
 		},
-		/* 57 Authorship <- <((AuthorshipCombo / OriginalAuthorship) &(SpaceCharEOI / ';' / ','))> */
+		/* 107 Authorship <- <((AuthorshipCombo / OriginalAuthorship) (_ AuthorCorrig)? &(SpaceCharEOI / ';' / ',' / ')'))> */
 		func() bool {
-			position452, tokenIndex452 := position, tokenIndex
+			position831, tokenIndex831 := position, tokenIndex
 			{
-				position453 := position
+				position832 := position
 				{
-					position454, tokenIndex454 := position, tokenIndex
+					position833, tokenIndex833 := position, tokenIndex
 					if !_rules[ruleAuthorshipCombo]() {
-						goto l455
+						goto l834
 					}
-					goto l454
-				l455:
-					position, tokenIndex = position454, tokenIndex454
+					goto l833
+				l834:
+					position, tokenIndex = position833, tokenIndex833
 					if !_rules[ruleOriginalAuthorship]() {
-						goto l452
+						goto l831
+					}
+				}
+			l833:
+				{
+					position835, tokenIndex835 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l835
+					}
+					if !_rules[ruleAuthorCorrig]() {
+						goto l835
 					}
+					goto l836
+				l835:
+					position, tokenIndex = position835, tokenIndex835
 				}
-			l454:
+			l836:
 				{
-					position456, tokenIndex456 := position, tokenIndex
+					position837, tokenIndex837 := position, tokenIndex
 					{
-						position457, tokenIndex457 := position, tokenIndex
+						position838, tokenIndex838 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l458
+							goto l839
 						}
-						goto l457
-					l458:
-						position, tokenIndex = position457, tokenIndex457
+						goto l838
+					l839:
+						position, tokenIndex = position838, tokenIndex838
 						if buffer[position] != rune(';') {
-							goto l459
+							goto l840
 						}
 						position++
-						goto l457
-					l459:
-						position, tokenIndex = position457, tokenIndex457
+						goto l838
+					l840:
+						position, tokenIndex = position838, tokenIndex838
 						if buffer[position] != rune(',') {
-							goto l452
+							goto l841
+						}
+						position++
+						goto l838
+					l841:
+						position, tokenIndex = position838, tokenIndex838
+						if buffer[position] != rune(')') {
+							goto l831
 						}
 						position++
 					}
-				l457:
-					position, tokenIndex = position456, tokenIndex456
+				l838:
+					position, tokenIndex = position837, tokenIndex837
 				}
-				add(ruleAuthorship, position453)
+				add(ruleAuthorship, position832)
 			}
 			return true
-		l452:
-			position, tokenIndex = position452, tokenIndex452
+		l831:
+			position, tokenIndex = position831, tokenIndex831
 			return false
 		},
-		/* 58 AuthorshipCombo <- <(OriginalAuthorshipComb (_? CombinationAuthorship)?)> */
+		/* 108 AuthorshipCombo <- <(OriginalAuthorshipComb (_? CombinationAuthorship)?)> */
 		func() bool {
-			position460, tokenIndex460 := position, tokenIndex
+			position842, tokenIndex842 := position, tokenIndex
 			{
-				position461 := position
+				position843 := position
 				if !_rules[ruleOriginalAuthorshipComb]() {
-					goto l460
+					goto l842
 				}
 				{
-					position462, tokenIndex462 := position, tokenIndex
+					position844, tokenIndex844 := position, tokenIndex
 					{
-						position464, tokenIndex464 := position, tokenIndex
+						position846, tokenIndex846 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l464
+							goto l846
 						}
-						goto l465
-					l464:
-						position, tokenIndex = position464, tokenIndex464
+						goto l847
+					l846:
+						position, tokenIndex = position846, tokenIndex846
 					}
-				l465:
+				l847:
 					if !_rules[ruleCombinationAuthorship]() {
-						goto l462
+						goto l844
 					}
-					goto l463
-				l462:
-					position, tokenIndex = position462, tokenIndex462
+					goto l845
+				l844:
+					position, tokenIndex = position844, tokenIndex844
 				}
-			l463:
-				add(ruleAuthorshipCombo, position461)
+			l845:
+				add(ruleAuthorshipCombo, position843)
 			}
 			return true
-		l460:
-			position, tokenIndex = position460, tokenIndex460
+		l842:
+			position, tokenIndex = position842, tokenIndex842
 			return false
 		},
-		/* 59 OriginalAuthorship <- <AuthorsGroup> */
+		/* 109 OriginalAuthorship <- <AuthorsGroup> */
 		func() bool {
-			position466, tokenIndex466 := position, tokenIndex
+			position848, tokenIndex848 := position, tokenIndex
 			{
-				position467 := position
+				position849 := position
 				if !_rules[ruleAuthorsGroup]() {
-					goto l466
+					goto l848
 				}
-				add(ruleOriginalAuthorship, position467)
+				add(ruleOriginalAuthorship, position849)
 			}
 			return true
-		l466:
-			position, tokenIndex = position466, tokenIndex466
+		l848:
+			position, tokenIndex = position848, tokenIndex848
 			return false
 		},
-		/* 60 OriginalAuthorshipComb <- <(BasionymAuthorshipYearMisformed / BasionymAuthorship / BasionymAuthorshipMissingParens)> */
+		/* 110 OriginalAuthorshipComb <- <(BasionymAuthorshipYearMisformed / BasionymAuthorship / BasionymAuthorshipMissingParens)> */
 		func() bool {
-			position468, tokenIndex468 := position, tokenIndex
+			position850, tokenIndex850 := position, tokenIndex
 			{
-				position469 := position
+				position851 := position
 				{
-					position470, tokenIndex470 := position, tokenIndex
+					position852, tokenIndex852 := position, tokenIndex
 					if !_rules[ruleBasionymAuthorshipYearMisformed]() {
-						goto l471
+						goto l853
 					}
-					goto l470
-				l471:
-					position, tokenIndex = position470, tokenIndex470
+					goto l852
+				l853:
+					position, tokenIndex = position852, tokenIndex852
 					if !_rules[ruleBasionymAuthorship]() {
-						goto l472
+						goto l854
 					}
-					goto l470
-				l472:
-					position, tokenIndex = position470, tokenIndex470
+					goto l852
+				l854:
+					position, tokenIndex = position852, tokenIndex852
 					if !_rules[ruleBasionymAuthorshipMissingParens]() {
-						goto l468
+						goto l850
 					}
 				}
-			l470:
-				add(ruleOriginalAuthorshipComb, position469)
+			l852:
+				add(ruleOriginalAuthorshipComb, position851)
 			}
 			return true
-		l468:
-			position, tokenIndex = position468, tokenIndex468
+		l850:
+			position, tokenIndex = position850, tokenIndex850
 			return false
 		},
-		/* 61 CombinationAuthorship <- <AuthorsGroup> */
+		/* 111 CombinationAuthorship <- <AuthorsGroup> */
 		func() bool {
-			position473, tokenIndex473 := position, tokenIndex
+			position855, tokenIndex855 := position, tokenIndex
 			{
-				position474 := position
+				position856 := position
 				if !_rules[ruleAuthorsGroup]() {
-					goto l473
+					goto l855
 				}
-				add(ruleCombinationAuthorship, position474)
+				add(ruleCombinationAuthorship, position856)
 			}
 			return true
-		l473:
-			position, tokenIndex = position473, tokenIndex473
+		l855:
+			position, tokenIndex = position855, tokenIndex855
 			return false
 		},
-		/* 62 BasionymAuthorshipMissingParens <- <(MissingParensStart / MissingParensEnd)> */
+		/* 112 BasionymAuthorshipMissingParens <- <(MissingParensStart / MissingParensEnd)> */
 		func() bool {
-			position475, tokenIndex475 := position, tokenIndex
+			position857, tokenIndex857 := position, tokenIndex
 			{
-				position476 := position
+				position858 := position
 				{
-					position477, tokenIndex477 := position, tokenIndex
+					position859, tokenIndex859 := position, tokenIndex
 					if !_rules[ruleMissingParensStart]() {
-						goto l478
+						goto l860
 					}
-					goto l477
-				l478:
-					position, tokenIndex = position477, tokenIndex477
+					goto l859
+				l860:
+					position, tokenIndex = position859, tokenIndex859
 					if !_rules[ruleMissingParensEnd]() {
-						goto l475
+						goto l857
 					}
 				}
-			l477:
-				add(ruleBasionymAuthorshipMissingParens, position476)
+			l859:
+				add(ruleBasionymAuthorshipMissingParens, position858)
 			}
 			return true
-		l475:
-			position, tokenIndex = position475, tokenIndex475
+		l857:
+			position, tokenIndex = position857, tokenIndex857
 			return false
 		},
-		/* 63 MissingParensStart <- <('(' _? AuthorsGroup)> */
+		/* 113 MissingParensStart <- <('(' _? AuthorsGroup)> */
 		func() bool {
-			position479, tokenIndex479 := position, tokenIndex
+			position861, tokenIndex861 := position, tokenIndex
 			{
-				position480 := position
+				position862 := position
 				if buffer[position] != rune('(') {
-					goto l479
+					goto l861
 				}
 				position++
 				{
-					position481, tokenIndex481 := position, tokenIndex
+					position863, tokenIndex863 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l481
+						goto l863
 					}
-					goto l482
-				l481:
-					position, tokenIndex = position481, tokenIndex481
+					goto l864
+				l863:
+					position, tokenIndex = position863, tokenIndex863
 				}
-			l482:
+			l864:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l479
+					goto l861
 				}
-				add(ruleMissingParensStart, position480)
+				add(ruleMissingParensStart, position862)
 			}
 			return true
-		l479:
-			position, tokenIndex = position479, tokenIndex479
+		l861:
+			position, tokenIndex = position861, tokenIndex861
 			return false
 		},
-		/* 64 MissingParensEnd <- <(AuthorsGroup _? ')')> */
+		/* 114 MissingParensEnd <- <(AuthorsGroup _? &{ !p.parensOpen(position) } ')' !(_? HybridFormulaChar))> */
 		func() bool {
-			position483, tokenIndex483 := position, tokenIndex
+			position865, tokenIndex865 := position, tokenIndex
 			{
-				position484 := position
+				position866 := position
 				if !_rules[ruleAuthorsGroup]() {
-					goto l483
+					goto l865
 				}
 				{
-					position485, tokenIndex485 := position, tokenIndex
+					position867, tokenIndex867 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l485
+						goto l867
 					}
-					goto l486
-				l485:
-					position, tokenIndex = position485, tokenIndex485
+					goto l868
+				l867:
+					position, tokenIndex = position867, tokenIndex867
+				}
+			l868:
+				if !(!p.parensOpen(position)) {
+					goto l865
 				}
-			l486:
 				if buffer[position] != rune(')') {
-					goto l483
+					goto l865
 				}
 				position++
-				add(ruleMissingParensEnd, position484)
+				{
+					position869, tokenIndex869 := position, tokenIndex
+					{
+						position870, tokenIndex870 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l870
+						}
+						goto l871
+					l870:
+						position, tokenIndex = position870, tokenIndex870
+					}
+				l871:
+					if !_rules[ruleHybridFormulaChar]() {
+						goto l869
+					}
+					goto l865
+				l869:
+					position, tokenIndex = position869, tokenIndex869
+				}
+				add(ruleMissingParensEnd, position866)
 			}
 			return true
-		l483:
-			position, tokenIndex = position483, tokenIndex483
+		l865:
+			position, tokenIndex = position865, tokenIndex865
 			return false
 		},
-		/* 65 BasionymAuthorshipYearMisformed <- <('(' _? AuthorsGroup _? ')' (_? ',')? _? Year)> */
+		/* 115 BasionymAuthorshipYearMisformed <- <('(' _? AuthorsGroup _? ')' (_? ',')? _? Year)> */
 		func() bool {
-			position487, tokenIndex487 := position, tokenIndex
+			position872, tokenIndex872 := position, tokenIndex
 			{
-				position488 := position
+				position873 := position
 				if buffer[position] != rune('(') {
-					goto l487
+					goto l872
 				}
 				position++
 				{
-					position489, tokenIndex489 := position, tokenIndex
+					position874, tokenIndex874 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l489
+						goto l874
 					}
-					goto l490
-				l489:
-					position, tokenIndex = position489, tokenIndex489
+					goto l875
+				l874:
+					position, tokenIndex = position874, tokenIndex874
 				}
-			l490:
+			l875:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l487
+					goto l872
 				}
 				{
-					position491, tokenIndex491 := position, tokenIndex
+					position876, tokenIndex876 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l491
+						goto l876
 					}
-					goto l492
-				l491:
-					position, tokenIndex = position491, tokenIndex491
+					goto l877
+				l876:
+					position, tokenIndex = position876, tokenIndex876
 				}
-			l492:
+			l877:
 				if buffer[position] != rune(')') {
-					goto l487
+					goto l872
 				}
 				position++
 				{
-					position493, tokenIndex493 := position, tokenIndex
+					position878, tokenIndex878 := position, tokenIndex
 					{
-						position495, tokenIndex495 := position, tokenIndex
+						position880, tokenIndex880 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l495
+							goto l880
 						}
-						goto l496
-					l495:
-						position, tokenIndex = position495, tokenIndex495
+						goto l881
+					l880:
+						position, tokenIndex = position880, tokenIndex880
 					}
-				l496:
+				l881:
 					if buffer[position] != rune(',') {
-						goto l493
+						goto l878
 					}
 					position++
-					goto l494
-				l493:
-					position, tokenIndex = position493, tokenIndex493
+					goto l879
+				l878:
+					position, tokenIndex = position878, tokenIndex878
 				}
-			l494:
+			l879:
 				{
-					position497, tokenIndex497 := position, tokenIndex
+					position882, tokenIndex882 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l497
+						goto l882
 					}
-					goto l498
-				l497:
-					position, tokenIndex = position497, tokenIndex497
+					goto l883
+				l882:
+					position, tokenIndex = position882, tokenIndex882
 				}
-			l498:
+			l883:
 				if !_rules[ruleYear]() {
-					goto l487
+					goto l872
 				}
-				add(ruleBasionymAuthorshipYearMisformed, position488)
+				add(ruleBasionymAuthorshipYearMisformed, position873)
 			}
 			return true
-		l487:
-			position, tokenIndex = position487, tokenIndex487
+		l872:
+			position, tokenIndex = position872, tokenIndex872
 			return false
 		},
-		/* 66 BasionymAuthorship <- <(BasionymAuthorship1 / BasionymAuthorship2Parens)> */
+		/* 116 BasionymAuthorship <- <(BasionymAuthorship1 / BasionymAuthorship2Parens)> */
 		func() bool {
-			position499, tokenIndex499 := position, tokenIndex
+			position884, tokenIndex884 := position, tokenIndex
 			{
-				position500 := position
+				position885 := position
 				{
-					position501, tokenIndex501 := position, tokenIndex
+					position886, tokenIndex886 := position, tokenIndex
 					if !_rules[ruleBasionymAuthorship1]() {
-						goto l502
+						goto l887
 					}
-					goto l501
-				l502:
-					position, tokenIndex = position501, tokenIndex501
+					goto l886
+				l887:
+					position, tokenIndex = position886, tokenIndex886
 					if !_rules[ruleBasionymAuthorship2Parens]() {
-						goto l499
+						goto l884
 					}
 				}
-			l501:
-				add(ruleBasionymAuthorship, position500)
+			l886:
+				add(ruleBasionymAuthorship, position885)
 			}
 			return true
-		l499:
-			position, tokenIndex = position499, tokenIndex499
+		l884:
+			position, tokenIndex = position884, tokenIndex884
 			return false
 		},
-		/* 67 BasionymAuthorship1 <- <('(' _? AuthorsGroup _? ')')> */
+		/* 117 BasionymAuthorship1 <- <('(' _? AuthorsGroup _? ')')> */
 		func() bool {
-			position503, tokenIndex503 := position, tokenIndex
+			position888, tokenIndex888 := position, tokenIndex
 			{
-				position504 := position
+				position889 := position
 				if buffer[position] != rune('(') {
-					goto l503
+					goto l888
 				}
 				position++
 				{
-					position505, tokenIndex505 := position, tokenIndex
+					position890, tokenIndex890 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l505
+						goto l890
 					}
-					goto l506
-				l505:
-					position, tokenIndex = position505, tokenIndex505
+					goto l891
+				l890:
+					position, tokenIndex = position890, tokenIndex890
 				}
-			l506:
+			l891:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l503
+					goto l888
 				}
 				{
-					position507, tokenIndex507 := position, tokenIndex
+					position892, tokenIndex892 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l507
+						goto l892
 					}
-					goto l508
-				l507:
-					position, tokenIndex = position507, tokenIndex507
+					goto l893
+				l892:
+					position, tokenIndex = position892, tokenIndex892
 				}
-			l508:
+			l893:
 				if buffer[position] != rune(')') {
-					goto l503
+					goto l888
 				}
 				position++
-				add(ruleBasionymAuthorship1, position504)
+				add(ruleBasionymAuthorship1, position889)
 			}
 			return true
-		l503:
-			position, tokenIndex = position503, tokenIndex503
+		l888:
+			position, tokenIndex = position888, tokenIndex888
 			return false
 		},
-		/* 68 BasionymAuthorship2Parens <- <('(' _? '(' _? AuthorsGroup _? ')' _? ')')> */
+		/* 118 BasionymAuthorship2Parens <- <('(' _? '(' _? AuthorsGroup _? ')' _? ')')> */
 		func() bool {
-			position509, tokenIndex509 := position, tokenIndex
+			position894, tokenIndex894 := position, tokenIndex
 			{
-				position510 := position
+				position895 := position
 				if buffer[position] != rune('(') {
-					goto l509
+					goto l894
 				}
 				position++
 				{
-					position511, tokenIndex511 := position, tokenIndex
+					position896, tokenIndex896 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l511
+						goto l896
 					}
-					goto l512
-				l511:
-					position, tokenIndex = position511, tokenIndex511
+					goto l897
+				l896:
+					position, tokenIndex = position896, tokenIndex896
 				}
-			l512:
+			l897:
 				if buffer[position] != rune('(') {
-					goto l509
+					goto l894
 				}
 				position++
 				{
-					position513, tokenIndex513 := position, tokenIndex
+					position898, tokenIndex898 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l513
+						goto l898
 					}
-					goto l514
-				l513:
-					position, tokenIndex = position513, tokenIndex513
+					goto l899
+				l898:
+					position, tokenIndex = position898, tokenIndex898
 				}
-			l514:
+			l899:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l509
+					goto l894
 				}
 				{
-					position515, tokenIndex515 := position, tokenIndex
+					position900, tokenIndex900 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l515
+						goto l900
 					}
-					goto l516
-				l515:
-					position, tokenIndex = position515, tokenIndex515
+					goto l901
+				l900:
+					position, tokenIndex = position900, tokenIndex900
 				}
-			l516:
+			l901:
 				if buffer[position] != rune(')') {
-					goto l509
+					goto l894
 				}
 				position++
 				{
-					position517, tokenIndex517 := position, tokenIndex
+					position902, tokenIndex902 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l517
+						goto l902
 					}
-					goto l518
-				l517:
-					position, tokenIndex = position517, tokenIndex517
+					goto l903
+				l902:
+					position, tokenIndex = position902, tokenIndex902
 				}
-			l518:
+			l903:
 				if buffer[position] != rune(')') {
-					goto l509
+					goto l894
 				}
 				position++
-				add(ruleBasionymAuthorship2Parens, position510)
+				add(ruleBasionymAuthorship2Parens, position895)
 			}
 			return true
-		l509:
-			position, tokenIndex = position509, tokenIndex509
+		l894:
+			position, tokenIndex = position894, tokenIndex894
 			return false
 		},
-		/* 69 AuthorsGroup <- <(AuthorsTeam (_ (AuthorEmend / AuthorEx) AuthorsTeam)?)> */
+		/* 119 AuthorsGroup <- <(AuthorsTeam (_ (AuthorEmend / AuthorEx) AuthorsTeam)? (_ AuthorIn _ AuthorsTeam)?)> */
 		func() bool {
-			position519, tokenIndex519 := position, tokenIndex
+			position904, tokenIndex904 := position, tokenIndex
 			{
-				position520 := position
+				position905 := position
 				if !_rules[ruleAuthorsTeam]() {
-					goto l519
+					goto l904
 				}
 				{
-					position521, tokenIndex521 := position, tokenIndex
+					position906, tokenIndex906 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l521
+						goto l906
 					}
 					{
-						position523, tokenIndex523 := position, tokenIndex
+						position908, tokenIndex908 := position, tokenIndex
 						if !_rules[ruleAuthorEmend]() {
-							goto l524
+							goto l909
 						}
-						goto l523
-					l524:
-						position, tokenIndex = position523, tokenIndex523
+						goto l908
+					l909:
+						position, tokenIndex = position908, tokenIndex908
 						if !_rules[ruleAuthorEx]() {
-							goto l521
+							goto l906
 						}
 					}
-				l523:
+				l908:
 					if !_rules[ruleAuthorsTeam]() {
-						goto l521
+						goto l906
 					}
-					goto l522
-				l521:
-					position, tokenIndex = position521, tokenIndex521
+					goto l907
+				l906:
+					position, tokenIndex = position906, tokenIndex906
+				}
+			l907:
+				{
+					position910, tokenIndex910 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l910
+					}
+					if !_rules[ruleAuthorIn]() {
+						goto l910
+					}
+					if !_rules[rule_]() {
+						goto l910
+					}
+					if !_rules[ruleAuthorsTeam]() {
+						goto l910
+					}
+					goto l911
+				l910:
+					position, tokenIndex = position910, tokenIndex910
 				}
-			l522:
-				add(ruleAuthorsGroup, position520)
+			l911:
+				add(ruleAuthorsGroup, position905)
 			}
 			return true
-		l519:
-			position, tokenIndex = position519, tokenIndex519
+		l904:
+			position, tokenIndex = position904, tokenIndex904
 			return false
 		},
-		/* 70 AuthorsTeam <- <(Author (AuthorSep Author)* (_? ','? _? Year)?)> */
+		/* 120 AuthorsTeam <- <(Author (AuthorSep Author)* (_? ','? _? Year)?)> */
 		func() bool {
-			position525, tokenIndex525 := position, tokenIndex
+			position912, tokenIndex912 := position, tokenIndex
 			{
-				position526 := position
+				position913 := position
 				if !_rules[ruleAuthor]() {
-					goto l525
+					goto l912
 				}
-			l527:
+			l914:
 				{
-					position528, tokenIndex528 := position, tokenIndex
+					position915, tokenIndex915 := position, tokenIndex
 					if !_rules[ruleAuthorSep]() {
-						goto l528
+						goto l915
 					}
 					if !_rules[ruleAuthor]() {
-						goto l528
+						goto l915
 					}
-					goto l527
-				l528:
-					position, tokenIndex = position528, tokenIndex528
+					goto l914
+				l915:
+					position, tokenIndex = position915, tokenIndex915
 				}
 				{
-					position529, tokenIndex529 := position, tokenIndex
+					position916, tokenIndex916 := position, tokenIndex
 					{
-						position531, tokenIndex531 := position, tokenIndex
+						position918, tokenIndex918 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l531
+							goto l918
 						}
-						goto l532
-					l531:
-						position, tokenIndex = position531, tokenIndex531
+						goto l919
+					l918:
+						position, tokenIndex = position918, tokenIndex918
 					}
-				l532:
+				l919:
 					{
-						position533, tokenIndex533 := position, tokenIndex
+						position920, tokenIndex920 := position, tokenIndex
 						if buffer[position] != rune(',') {
-							goto l533
+							goto l920
 						}
 						position++
-						goto l534
-					l533:
-						position, tokenIndex = position533, tokenIndex533
+						goto l921
+					l920:
+						position, tokenIndex = position920, tokenIndex920
 					}
-				l534:
+				l921:
 					{
-						position535, tokenIndex535 := position, tokenIndex
+						position922, tokenIndex922 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l535
+							goto l922
 						}
-						goto l536
-					l535:
-						position, tokenIndex = position535, tokenIndex535
+						goto l923
+					l922:
+						position, tokenIndex = position922, tokenIndex922
 					}
-				l536:
+				l923:
 					if !_rules[ruleYear]() {
-						goto l529
+						goto l916
 					}
-					goto l530
-				l529:
-					position, tokenIndex = position529, tokenIndex529
+					goto l917
+				l916:
+					position, tokenIndex = position916, tokenIndex916
 				}
-			l530:
-				add(ruleAuthorsTeam, position526)
+			l917:
+				add(ruleAuthorsTeam, position913)
 			}
 			return true
-		l525:
-			position, tokenIndex = position525, tokenIndex525
+		l912:
+			position, tokenIndex = position912, tokenIndex912
 			return false
 		},
-		/* 71 AuthorSep <- <(AuthorSep1 / AuthorSep2)> */
+		/* 121 AuthorSep <- <(AuthorSep1 / AuthorSep2)> */
 		func() bool {
-			position537, tokenIndex537 := position, tokenIndex
+			position924, tokenIndex924 := position, tokenIndex
 			{
-				position538 := position
+				position925 := position
 				{
-					position539, tokenIndex539 := position, tokenIndex
+					position926, tokenIndex926 := position, tokenIndex
 					if !_rules[ruleAuthorSep1]() {
-						goto l540
+						goto l927
 					}
-					goto l539
-				l540:
-					position, tokenIndex = position539, tokenIndex539
+					goto l926
+				l927:
+					position, tokenIndex = position926, tokenIndex926
 					if !_rules[ruleAuthorSep2]() {
-						goto l537
+						goto l924
 					}
 				}
-			l539:
-				add(ruleAuthorSep, position538)
+			l926:
+				add(ruleAuthorSep, position925)
 			}
 			return true
-		l537:
-			position, tokenIndex = position537, tokenIndex537
+		l924:
+			position, tokenIndex = position924, tokenIndex924
 			return false
 		},
-		/* 72 AuthorSep1 <- <(_? (',' _)? ('&' / AuthorSepSpanish / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd')) _?)> */
+		/* 122 AuthorSep1 <- <(_? (',' _)? ('&' / AuthorSepSpanish / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd')) _?)> */
 		func() bool {
-			position541, tokenIndex541 := position, tokenIndex
+			position928, tokenIndex928 := position, tokenIndex
 			{
-				position542 := position
+				position929 := position
 				{
-					position543, tokenIndex543 := position, tokenIndex
+					position930, tokenIndex930 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l543
+						goto l930
 					}
-					goto l544
-				l543:
-					position, tokenIndex = position543, tokenIndex543
+					goto l931
+				l930:
+					position, tokenIndex = position930, tokenIndex930
 				}
-			l544:
+			l931:
 				{
-					position545, tokenIndex545 := position, tokenIndex
+					position932, tokenIndex932 := position, tokenIndex
 					if buffer[position] != rune(',') {
-						goto l545
+						goto l932
 					}
 					position++
 					if !_rules[rule_]() {
-						goto l545
+						goto l932
 					}
-					goto l546
-				l545:
-					position, tokenIndex = position545, tokenIndex545
+					goto l933
+				l932:
+					position, tokenIndex = position932, tokenIndex932
 				}
-			l546:
+			l933:
 				{
-					position547, tokenIndex547 := position, tokenIndex
+					position934, tokenIndex934 := position, tokenIndex
 					if buffer[position] != rune('&') {
-						goto l548
+						goto l935
 					}
 					position++
-					goto l547
-				l548:
-					position, tokenIndex = position547, tokenIndex547
+					goto l934
+				l935:
+					position, tokenIndex = position934, tokenIndex934
 					if !_rules[ruleAuthorSepSpanish]() {
-						goto l549
+						goto l936
 					}
-					goto l547
-				l549:
-					position, tokenIndex = position547, tokenIndex547
+					goto l934
+				l936:
+					position, tokenIndex = position934, tokenIndex934
 					if buffer[position] != rune('e') {
-						goto l550
+						goto l937
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l550
+						goto l937
 					}
 					position++
-					goto l547
-				l550:
-					position, tokenIndex = position547, tokenIndex547
+					goto l934
+				l937:
+					position, tokenIndex = position934, tokenIndex934
 					if buffer[position] != rune('a') {
-						goto l551
+						goto l938
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l551
+						goto l938
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l551
+						goto l938
 					}
 					position++
-					goto l547
-				l551:
-					position, tokenIndex = position547, tokenIndex547
+					goto l934
+				l938:
+					position, tokenIndex = position934, tokenIndex934
 					if buffer[position] != rune('a') {
-						goto l541
+						goto l928
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l541
+						goto l928
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l541
+						goto l928
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l541
+						goto l928
 					}
 					position++
 				}
-			l547:
+			l934:
 				{
-					position552, tokenIndex552 := position, tokenIndex
+					position939, tokenIndex939 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l552
+						goto l939
 					}
-					goto l553
-				l552:
-					position, tokenIndex = position552, tokenIndex552
+					goto l940
+				l939:
+					position, tokenIndex = position939, tokenIndex939
 				}
-			l553:
-				add(ruleAuthorSep1, position542)
+			l940:
+				add(ruleAuthorSep1, position929)
 			}
 			return true
-		l541:
-			position, tokenIndex = position541, tokenIndex541
+		l928:
+			position, tokenIndex = position928, tokenIndex928
 			return false
 		},
-		/* 73 AuthorSep2 <- <(_? ',' _?)> */
+		/* 123 AuthorSep2 <- <(_? ',' _?)> */
 		func() bool {
-			position554, tokenIndex554 := position, tokenIndex
+			position941, tokenIndex941 := position, tokenIndex
 			{
-				position555 := position
+				position942 := position
 				{
-					position556, tokenIndex556 := position, tokenIndex
+					position943, tokenIndex943 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l556
+						goto l943
 					}
-					goto l557
-				l556:
-					position, tokenIndex = position556, tokenIndex556
+					goto l944
+				l943:
+					position, tokenIndex = position943, tokenIndex943
 				}
-			l557:
+			l944:
 				if buffer[position] != rune(',') {
-					goto l554
+					goto l941
 				}
 				position++
 				{
-					position558, tokenIndex558 := position, tokenIndex
+					position945, tokenIndex945 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l558
+						goto l945
 					}
-					goto l559
-				l558:
-					position, tokenIndex = position558, tokenIndex558
+					goto l946
+				l945:
+					position, tokenIndex = position945, tokenIndex945
 				}
-			l559:
-				add(ruleAuthorSep2, position555)
+			l946:
+				add(ruleAuthorSep2, position942)
 			}
 			return true
-		l554:
-			position, tokenIndex = position554, tokenIndex554
+		l941:
+			position, tokenIndex = position941, tokenIndex941
 			return false
 		},
-		/* 74 AuthorSepSpanish <- <(_? 'y' _?)> */
+		/* 124 AuthorSepSpanish <- <(_? 'y' _?)> */
 		func() bool {
-			position560, tokenIndex560 := position, tokenIndex
+			position947, tokenIndex947 := position, tokenIndex
 			{
-				position561 := position
+				position948 := position
 				{
-					position562, tokenIndex562 := position, tokenIndex
+					position949, tokenIndex949 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l562
+						goto l949
 					}
-					goto l563
-				l562:
-					position, tokenIndex = position562, tokenIndex562
+					goto l950
+				l949:
+					position, tokenIndex = position949, tokenIndex949
 				}
-			l563:
+			l950:
 				if buffer[position] != rune('y') {
-					goto l560
+					goto l947
 				}
 				position++
 				{
-					position564, tokenIndex564 := position, tokenIndex
+					position951, tokenIndex951 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l564
+						goto l951
 					}
-					goto l565
-				l564:
-					position, tokenIndex = position564, tokenIndex564
+					goto l952
+				l951:
+					position, tokenIndex = position951, tokenIndex951
 				}
-			l565:
-				add(ruleAuthorSepSpanish, position561)
+			l952:
+				add(ruleAuthorSepSpanish, position948)
 			}
 			return true
-		l560:
-			position, tokenIndex = position560, tokenIndex560
+		l947:
+			position, tokenIndex = position947, tokenIndex947
 			return false
 		},
-		/* 75 AuthorEx <- <((('e' 'x' '.'?) / ('i' 'n')) _)> */
+		/* 125 AuthorEx <- <('e' 'x' '.'? _)> */
 		func() bool {
-			position566, tokenIndex566 := position, tokenIndex
+			position953, tokenIndex953 := position, tokenIndex
 			{
-				position567 := position
+				position954 := position
+				if buffer[position] != rune('e') {
+					goto l953
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l953
+				}
+				position++
 				{
-					position568, tokenIndex568 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l569
-					}
-					position++
-					if buffer[position] != rune('x') {
-						goto l569
-					}
-					position++
-					{
-						position570, tokenIndex570 := position, tokenIndex
-						if buffer[position] != rune('.') {
-							goto l570
-						}
-						position++
-						goto l571
-					l570:
-						position, tokenIndex = position570, tokenIndex570
-					}
-				l571:
-					goto l568
-				l569:
-					position, tokenIndex = position568, tokenIndex568
-					if buffer[position] != rune('i') {
-						goto l566
-					}
-					position++
-					if buffer[position] != rune('n') {
-						goto l566
+					position955, tokenIndex955 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l955
 					}
 					position++
+					goto l956
+				l955:
+					position, tokenIndex = position955, tokenIndex955
 				}
-			l568:
+			l956:
 				if !_rules[rule_]() {
-					goto l566
+					goto l953
 				}
-				add(ruleAuthorEx, position567)
+				add(ruleAuthorEx, position954)
 			}
 			return true
-		l566:
-			position, tokenIndex = position566, tokenIndex566
+		l953:
+			position, tokenIndex = position953, tokenIndex953
 			return false
 		},
-		/* 76 AuthorEmend <- <('e' 'm' 'e' 'n' 'd' '.'? _)> */
+		/* 126 AuthorIn <- <('i' 'n')> */
 		func() bool {
-			position572, tokenIndex572 := position, tokenIndex
+			position957, tokenIndex957 := position, tokenIndex
 			{
-				position573 := position
+				position958 := position
+				if buffer[position] != rune('i') {
+					goto l957
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l957
+				}
+				position++
+				add(ruleAuthorIn, position958)
+			}
+			return true
+		l957:
+			position, tokenIndex = position957, tokenIndex957
+			return false
+		},
+		/* 127 AuthorEmend <- <('e' 'm' 'e' 'n' 'd' '.'? _)> */
+		func() bool {
+			position959, tokenIndex959 := position, tokenIndex
+			{
+				position960 := position
 				if buffer[position] != rune('e') {
-					goto l572
+					goto l959
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l572
+					goto l959
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l572
+					goto l959
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l572
+					goto l959
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l572
+					goto l959
 				}
 				position++
 				{
-					position574, tokenIndex574 := position, tokenIndex
+					position961, tokenIndex961 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l574
+						goto l961
 					}
 					position++
-					goto l575
-				l574:
-					position, tokenIndex = position574, tokenIndex574
+					goto l962
+				l961:
+					position, tokenIndex = position961, tokenIndex961
 				}
-			l575:
+			l962:
 				if !_rules[rule_]() {
-					goto l572
+					goto l959
+				}
+				add(ruleAuthorEmend, position960)
+			}
+			return true
+		l959:
+			position, tokenIndex = position959, tokenIndex959
+			return false
+		},
+		/* 128 AuthorCorrig <- <('c' 'o' 'r' 'r' 'i' 'g' '.'?)> */
+		func() bool {
+			position963, tokenIndex963 := position, tokenIndex
+			{
+				position964 := position
+				if buffer[position] != rune('c') {
+					goto l963
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l963
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l963
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l963
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l963
+				}
+				position++
+				if buffer[position] != rune('g') {
+					goto l963
+				}
+				position++
+				{
+					position965, tokenIndex965 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l965
+					}
+					position++
+					goto l966
+				l965:
+					position, tokenIndex = position965, tokenIndex965
 				}
-				add(ruleAuthorEmend, position573)
+			l966:
+				add(ruleAuthorCorrig, position964)
 			}
 			return true
-		l572:
-			position, tokenIndex = position572, tokenIndex572
+		l963:
+			position, tokenIndex = position963, tokenIndex963
 			return false
 		},
-		/* 77 Author <- <((Author0 / Author1 / Author2 / UnknownAuthor) (_ AuthorEtAl)?)> */
+		/* 129 Author <- <((Author0 / Author1 / Author2 / UnknownAuthor) (_ AuthorEtAl)?)> */
 		func() bool {
-			position576, tokenIndex576 := position, tokenIndex
+			position967, tokenIndex967 := position, tokenIndex
 			{
-				position577 := position
+				position968 := position
 				{
-					position578, tokenIndex578 := position, tokenIndex
+					position969, tokenIndex969 := position, tokenIndex
 					if !_rules[ruleAuthor0]() {
-						goto l579
+						goto l970
 					}
-					goto l578
-				l579:
-					position, tokenIndex = position578, tokenIndex578
+					goto l969
+				l970:
+					position, tokenIndex = position969, tokenIndex969
 					if !_rules[ruleAuthor1]() {
-						goto l580
+						goto l971
 					}
-					goto l578
-				l580:
-					position, tokenIndex = position578, tokenIndex578
+					goto l969
+				l971:
+					position, tokenIndex = position969, tokenIndex969
 					if !_rules[ruleAuthor2]() {
-						goto l581
+						goto l972
 					}
-					goto l578
-				l581:
-					position, tokenIndex = position578, tokenIndex578
+					goto l969
+				l972:
+					position, tokenIndex = position969, tokenIndex969
 					if !_rules[ruleUnknownAuthor]() {
-						goto l576
+						goto l967
 					}
 				}
-			l578:
+			l969:
 				{
-					position582, tokenIndex582 := position, tokenIndex
+					position973, tokenIndex973 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l582
+						goto l973
 					}
 					if !_rules[ruleAuthorEtAl]() {
-						goto l582
+						goto l973
 					}
-					goto l583
-				l582:
-					position, tokenIndex = position582, tokenIndex582
+					goto l974
+				l973:
+					position, tokenIndex = position973, tokenIndex973
 				}
-			l583:
-				add(ruleAuthor, position577)
+			l974:
+				add(ruleAuthor, position968)
 			}
 			return true
-		l576:
-			position, tokenIndex = position576, tokenIndex576
+		l967:
+			position, tokenIndex = position967, tokenIndex967
 			return false
 		},
-		/* 78 Author0 <- <(Author2 FiliusFNoSpace)> */
+		/* 130 Author0 <- <(Author2 FiliusFNoSpace)> */
 		func() bool {
-			position584, tokenIndex584 := position, tokenIndex
+			position975, tokenIndex975 := position, tokenIndex
 			{
-				position585 := position
+				position976 := position
 				if !_rules[ruleAuthor2]() {
-					goto l584
+					goto l975
 				}
 				if !_rules[ruleFiliusFNoSpace]() {
-					goto l584
+					goto l975
 				}
-				add(ruleAuthor0, position585)
+				add(ruleAuthor0, position976)
 			}
 			return true
-		l584:
-			position, tokenIndex = position584, tokenIndex584
+		l975:
+			position, tokenIndex = position975, tokenIndex975
 			return false
 		},
-		/* 79 Author1 <- <(Author2 _? (Filius / AuthorSuffix))> */
+		/* 131 Author1 <- <(Author2 _? (Filius / AuthorSuffix))> */
 		func() bool {
-			position586, tokenIndex586 := position, tokenIndex
+			position977, tokenIndex977 := position, tokenIndex
 			{
-				position587 := position
+				position978 := position
 				if !_rules[ruleAuthor2]() {
-					goto l586
+					goto l977
 				}
 				{
-					position588, tokenIndex588 := position, tokenIndex
+					position979, tokenIndex979 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l588
+						goto l979
 					}
-					goto l589
-				l588:
-					position, tokenIndex = position588, tokenIndex588
+					goto l980
+				l979:
+					position, tokenIndex = position979, tokenIndex979
 				}
-			l589:
+			l980:
 				{
-					position590, tokenIndex590 := position, tokenIndex
+					position981, tokenIndex981 := position, tokenIndex
 					if !_rules[ruleFilius]() {
-						goto l591
+						goto l982
 					}
-					goto l590
-				l591:
-					position, tokenIndex = position590, tokenIndex590
+					goto l981
+				l982:
+					position, tokenIndex = position981, tokenIndex981
 					if !_rules[ruleAuthorSuffix]() {
-						goto l586
+						goto l977
 					}
 				}
-			l590:
-				add(ruleAuthor1, position587)
+			l981:
+				add(ruleAuthor1, position978)
 			}
 			return true
-		l586:
-			position, tokenIndex = position586, tokenIndex586
+		l977:
+			position, tokenIndex = position977, tokenIndex977
 			return false
 		},
-		/* 80 Author2 <- <(AuthorWord (_? AuthorWord)*)> */
+		/* 132 Author2 <- <(AuthorWord (_? AuthorWord)*)> */
 		func() bool {
-			position592, tokenIndex592 := position, tokenIndex
+			position983, tokenIndex983 := position, tokenIndex
 			{
-				position593 := position
+				position984 := position
 				if !_rules[ruleAuthorWord]() {
-					goto l592
+					goto l983
 				}
-			l594:
+			l985:
 				{
-					position595, tokenIndex595 := position, tokenIndex
+					position986, tokenIndex986 := position, tokenIndex
 					{
-						position596, tokenIndex596 := position, tokenIndex
+						position987, tokenIndex987 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l596
+							goto l987
 						}
-						goto l597
-					l596:
-						position, tokenIndex = position596, tokenIndex596
+						goto l988
+					l987:
+						position, tokenIndex = position987, tokenIndex987
 					}
-				l597:
+				l988:
 					if !_rules[ruleAuthorWord]() {
-						goto l595
+						goto l986
 					}
-					goto l594
-				l595:
-					position, tokenIndex = position595, tokenIndex595
+					goto l985
+				l986:
+					position, tokenIndex = position986, tokenIndex986
 				}
-				add(ruleAuthor2, position593)
+				add(ruleAuthor2, position984)
 			}
 			return true
-		l592:
-			position, tokenIndex = position592, tokenIndex592
+		l983:
+			position, tokenIndex = position983, tokenIndex983
 			return false
 		},
-		/* 81 UnknownAuthor <- <('?' / ((('a' 'u' 'c' 't') / ('a' 'n' 'o' 'n')) (&SpaceCharEOI / '.')))> */
+		/* 133 UnknownAuthor <- <('?' / ((('a' 'u' 'c' 't') / ('a' 'n' 'o' 'n')) (&SpaceCharEOI / '.')))> */
 		func() bool {
-			position598, tokenIndex598 := position, tokenIndex
+			position989, tokenIndex989 := position, tokenIndex
 			{
-				position599 := position
+				position990 := position
 				{
-					position600, tokenIndex600 := position, tokenIndex
+					position991, tokenIndex991 := position, tokenIndex
 					if buffer[position] != rune('?') {
-						goto l601
+						goto l992
 					}
 					position++
-					goto l600
-				l601:
-					position, tokenIndex = position600, tokenIndex600
+					goto l991
+				l992:
+					position, tokenIndex = position991, tokenIndex991
 					{
-						position602, tokenIndex602 := position, tokenIndex
+						position993, tokenIndex993 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l603
+							goto l994
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l603
+							goto l994
 						}
 						position++
 						if buffer[position] != rune('c') {
-							goto l603
+							goto l994
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l603
+							goto l994
 						}
 						position++
-						goto l602
-					l603:
-						position, tokenIndex = position602, tokenIndex602
+						goto l993
+					l994:
+						position, tokenIndex = position993, tokenIndex993
 						if buffer[position] != rune('a') {
-							goto l598
+							goto l989
 						}
 						position++
 						if buffer[position] != rune('n') {
-							goto l598
+							goto l989
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l598
+							goto l989
 						}
 						position++
 						if buffer[position] != rune('n') {
-							goto l598
+							goto l989
 						}
 						position++
 					}
-				l602:
+				l993:
 					{
-						position604, tokenIndex604 := position, tokenIndex
+						position995, tokenIndex995 := position, tokenIndex
 						{
-							position606, tokenIndex606 := position, tokenIndex
+							position997, tokenIndex997 := position, tokenIndex
 							if !_rules[ruleSpaceCharEOI]() {
-								goto l605
+								goto l996
 							}
-							position, tokenIndex = position606, tokenIndex606
+							position, tokenIndex = position997, tokenIndex997
 						}
-						goto l604
-					l605:
-						position, tokenIndex = position604, tokenIndex604
+						goto l995
+					l996:
+						position, tokenIndex = position995, tokenIndex995
 						if buffer[position] != rune('.') {
-							goto l598
+							goto l989
 						}
 						position++
 					}
-				l604:
+				l995:
 				}
-			l600:
-				add(ruleUnknownAuthor, position599)
+			l991:
+				add(ruleUnknownAuthor, position990)
 			}
 			return true
-		l598:
-			position, tokenIndex = position598, tokenIndex598
+		l989:
+			position, tokenIndex = position989, tokenIndex989
 			return false
 		},
-		/* 82 AuthorWord <- <(!(('b' / 'B') ('o' / 'O') ('l' / 'L') ('d' / 'D') ':') (AuthorWord2 / AuthorWord3 / AuthorPrefix))> */
+		/* 134 AuthorWord <- <(!((('b' / 'B') ('o' / 'O') ('l' / 'L') ('d' / 'D') ':') / StrainCollected) (AuthorWord2 / AuthorWord3 / AuthorPrefix))> */
 		func() bool {
-			position607, tokenIndex607 := position, tokenIndex
+			position998, tokenIndex998 := position, tokenIndex
 			{
-				position608 := position
+				position999 := position
 				{
-					position609, tokenIndex609 := position, tokenIndex
-					{
-						position610, tokenIndex610 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l611
-						}
-						position++
-						goto l610
-					l611:
-						position, tokenIndex = position610, tokenIndex610
-						if buffer[position] != rune('B') {
-							goto l609
-						}
-						position++
-					}
-				l610:
+					position1000, tokenIndex1000 := position, tokenIndex
 					{
-						position612, tokenIndex612 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l613
+						position1001, tokenIndex1001 := position, tokenIndex
+						{
+							position1003, tokenIndex1003 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1004
+							}
+							position++
+							goto l1003
+						l1004:
+							position, tokenIndex = position1003, tokenIndex1003
+							if buffer[position] != rune('B') {
+								goto l1002
+							}
+							position++
 						}
-						position++
-						goto l612
-					l613:
-						position, tokenIndex = position612, tokenIndex612
-						if buffer[position] != rune('O') {
-							goto l609
+					l1003:
+						{
+							position1005, tokenIndex1005 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1006
+							}
+							position++
+							goto l1005
+						l1006:
+							position, tokenIndex = position1005, tokenIndex1005
+							if buffer[position] != rune('O') {
+								goto l1002
+							}
+							position++
 						}
-						position++
-					}
-				l612:
-					{
-						position614, tokenIndex614 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l615
+					l1005:
+						{
+							position1007, tokenIndex1007 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1008
+							}
+							position++
+							goto l1007
+						l1008:
+							position, tokenIndex = position1007, tokenIndex1007
+							if buffer[position] != rune('L') {
+								goto l1002
+							}
+							position++
 						}
-						position++
-						goto l614
-					l615:
-						position, tokenIndex = position614, tokenIndex614
-						if buffer[position] != rune('L') {
-							goto l609
+					l1007:
+						{
+							position1009, tokenIndex1009 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1010
+							}
+							position++
+							goto l1009
+						l1010:
+							position, tokenIndex = position1009, tokenIndex1009
+							if buffer[position] != rune('D') {
+								goto l1002
+							}
+							position++
 						}
-						position++
-					}
-				l614:
-					{
-						position616, tokenIndex616 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l617
+					l1009:
+						if buffer[position] != rune(':') {
+							goto l1002
 						}
 						position++
-						goto l616
-					l617:
-						position, tokenIndex = position616, tokenIndex616
-						if buffer[position] != rune('D') {
-							goto l609
+						goto l1001
+					l1002:
+						position, tokenIndex = position1001, tokenIndex1001
+						if !_rules[ruleStrainCollected]() {
+							goto l1000
 						}
-						position++
-					}
-				l616:
-					if buffer[position] != rune(':') {
-						goto l609
 					}
-					position++
-					goto l607
-				l609:
-					position, tokenIndex = position609, tokenIndex609
+				l1001:
+					goto l998
+				l1000:
+					position, tokenIndex = position1000, tokenIndex1000
 				}
 				{
-					position618, tokenIndex618 := position, tokenIndex
+					position1011, tokenIndex1011 := position, tokenIndex
 					if !_rules[ruleAuthorWord2]() {
-						goto l619
+						goto l1012
 					}
-					goto l618
-				l619:
-					position, tokenIndex = position618, tokenIndex618
+					goto l1011
+				l1012:
+					position, tokenIndex = position1011, tokenIndex1011
 					if !_rules[ruleAuthorWord3]() {
-						goto l620
+						goto l1013
 					}
-					goto l618
-				l620:
-					position, tokenIndex = position618, tokenIndex618
+					goto l1011
+				l1013:
+					position, tokenIndex = position1011, tokenIndex1011
 					if !_rules[ruleAuthorPrefix]() {
-						goto l607
+						goto l998
 					}
 				}
-			l618:
-				add(ruleAuthorWord, position608)
+			l1011:
+				add(ruleAuthorWord, position999)
 			}
 			return true
-		l607:
-			position, tokenIndex = position607, tokenIndex607
+		l998:
+			position, tokenIndex = position998, tokenIndex998
 			return false
 		},
-		/* 83 AuthorEtAl <- <(('a' 'r' 'g' '.') / ('e' 't' ' ' 'a' 'l' '.' '{' '?' '}') / ((('e' 't') / '&') (' ' 'a' 'l') '.'?))> */
+		/* 135 AuthorEtAl <- <(('a' 'r' 'g' '.') / ('e' 't' ' ' 'a' 'l' '.' '{' '?' '}') / ((('e' 't') / '&') (' ' 'a' 'l') '.'?))> */
 		func() bool {
-			position621, tokenIndex621 := position, tokenIndex
+			position1014, tokenIndex1014 := position, tokenIndex
 			{
-				position622 := position
+				position1015 := position
 				{
-					position623, tokenIndex623 := position, tokenIndex
+					position1016, tokenIndex1016 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l624
+						goto l1017
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l624
+						goto l1017
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l624
+						goto l1017
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l624
+						goto l1017
 					}
 					position++
-					goto l623
-				l624:
-					position, tokenIndex = position623, tokenIndex623
+					goto l1016
+				l1017:
+					position, tokenIndex = position1016, tokenIndex1016
 					if buffer[position] != rune('e') {
-						goto l625
+						goto l1018
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l625
+						goto l1018
 					}
 					position++
 					if buffer[position] != rune(' ') {
-						goto l625
+						goto l1018
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l625
+						goto l1018
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l625
+						goto l1018
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l625
+						goto l1018
 					}
 					position++
 					if buffer[position] != rune('{') {
-						goto l625
+						goto l1018
 					}
 					position++
 					if buffer[position] != rune('?') {
-						goto l625
+						goto l1018
 					}
 					position++
 					if buffer[position] != rune('}') {
-						goto l625
+						goto l1018
 					}
 					position++
-					goto l623
-				l625:
-					position, tokenIndex = position623, tokenIndex623
+					goto l1016
+				l1018:
+					position, tokenIndex = position1016, tokenIndex1016
 					{
-						position626, tokenIndex626 := position, tokenIndex
+						position1019, tokenIndex1019 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l627
+							goto l1020
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l627
+							goto l1020
 						}
 						position++
-						goto l626
-					l627:
-						position, tokenIndex = position626, tokenIndex626
+						goto l1019
+					l1020:
+						position, tokenIndex = position1019, tokenIndex1019
 						if buffer[position] != rune('&') {
-							goto l621
+							goto l1014
 						}
 						position++
 					}
-				l626:
+				l1019:
 					if buffer[position] != rune(' ') {
-						goto l621
+						goto l1014
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l621
+						goto l1014
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l621
+						goto l1014
 					}
 					position++
 					{
-						position628, tokenIndex628 := position, tokenIndex
+						position1021, tokenIndex1021 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l628
+							goto l1021
 						}
 						position++
-						goto l629
-					l628:
-						position, tokenIndex = position628, tokenIndex628
+						goto l1022
+					l1021:
+						position, tokenIndex = position1021, tokenIndex1021
 					}
-				l629:
+				l1022:
 				}
-			l623:
-				add(ruleAuthorEtAl, position622)
+			l1016:
+				add(ruleAuthorEtAl, position1015)
 			}
 			return true
-		l621:
-			position, tokenIndex = position621, tokenIndex621
+		l1014:
+			position, tokenIndex = position1014, tokenIndex1014
 			return false
 		},
-		/* 84 AuthorWord2 <- <(AuthorWord3 Dash AuthorWordSoft)> */
+		/* 136 AuthorWord2 <- <(AuthorWord3 Dash AuthorWordSoft)> */
 		func() bool {
-			position630, tokenIndex630 := position, tokenIndex
+			position1023, tokenIndex1023 := position, tokenIndex
 			{
-				position631 := position
+				position1024 := position
 				if !_rules[ruleAuthorWord3]() {
-					goto l630
+					goto l1023
 				}
 				if !_rules[ruleDash]() {
-					goto l630
+					goto l1023
 				}
 				if !_rules[ruleAuthorWordSoft]() {
-					goto l630
+					goto l1023
 				}
-				add(ruleAuthorWord2, position631)
+				add(ruleAuthorWord2, position1024)
 			}
 			return true
-		l630:
-			position, tokenIndex = position630, tokenIndex630
+		l1023:
+			position, tokenIndex = position1023, tokenIndex1023
 			return false
 		},
-		/* 85 AuthorWord3 <- <(AuthorPrefixGlued? (AllCapsAuthorWord / CapAuthorWord) '.'?)> */
+		/* 137 AuthorWord3 <- <(AuthorPrefixGlued? (AllCapsAuthorWord / CapAuthorWord) '.'?)> */
 		func() bool {
-			position632, tokenIndex632 := position, tokenIndex
+			position1025, tokenIndex1025 := position, tokenIndex
 			{
-				position633 := position
+				position1026 := position
 				{
-					position634, tokenIndex634 := position, tokenIndex
+					position1027, tokenIndex1027 := position, tokenIndex
 					if !_rules[ruleAuthorPrefixGlued]() {
-						goto l634
+						goto l1027
 					}
-					goto l635
-				l634:
-					position, tokenIndex = position634, tokenIndex634
+					goto l1028
+				l1027:
+					position, tokenIndex = position1027, tokenIndex1027
 				}
-			l635:
+			l1028:
 				{
-					position636, tokenIndex636 := position, tokenIndex
+					position1029, tokenIndex1029 := position, tokenIndex
 					if !_rules[ruleAllCapsAuthorWord]() {
-						goto l637
+						goto l1030
 					}
-					goto l636
-				l637:
-					position, tokenIndex = position636, tokenIndex636
+					goto l1029
+				l1030:
+					position, tokenIndex = position1029, tokenIndex1029
 					if !_rules[ruleCapAuthorWord]() {
-						goto l632
+						goto l1025
 					}
 				}
-			l636:
+			l1029:
 				{
-					position638, tokenIndex638 := position, tokenIndex
+					position1031, tokenIndex1031 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l638
+						goto l1031
 					}
 					position++
-					goto l639
-				l638:
-					position, tokenIndex = position638, tokenIndex638
+					goto l1032
+				l1031:
+					position, tokenIndex = position1031, tokenIndex1031
 				}
-			l639:
-				add(ruleAuthorWord3, position633)
+			l1032:
+				add(ruleAuthorWord3, position1026)
 			}
 			return true
-		l632:
-			position, tokenIndex = position632, tokenIndex632
+		l1025:
+			position, tokenIndex = position1025, tokenIndex1025
 			return false
 		},
-		/* 86 AuthorWordSoft <- <(((AuthorUpperChar (AuthorUpperChar+ / AuthorLowerChar+)) / AuthorLowerChar+) '.'?)> */
+		/* 138 AuthorWordSoft <- <(((AuthorUpperChar (AuthorUpperChar+ / AuthorLowerChar+)) / AuthorLowerChar+) '.'?)> */
 		func() bool {
-			position640, tokenIndex640 := position, tokenIndex
+			position1033, tokenIndex1033 := position, tokenIndex
 			{
-				position641 := position
+				position1034 := position
 				{
-					position642, tokenIndex642 := position, tokenIndex
+					position1035, tokenIndex1035 := position, tokenIndex
 					if !_rules[ruleAuthorUpperChar]() {
-						goto l643
+						goto l1036
 					}
 					{
-						position644, tokenIndex644 := position, tokenIndex
+						position1037, tokenIndex1037 := position, tokenIndex
 						if !_rules[ruleAuthorUpperChar]() {
-							goto l645
+							goto l1038
 						}
-					l646:
+					l1039:
 						{
-							position647, tokenIndex647 := position, tokenIndex
+							position1040, tokenIndex1040 := position, tokenIndex
 							if !_rules[ruleAuthorUpperChar]() {
-								goto l647
+								goto l1040
 							}
-							goto l646
-						l647:
-							position, tokenIndex = position647, tokenIndex647
+							goto l1039
+						l1040:
+							position, tokenIndex = position1040, tokenIndex1040
 						}
-						goto l644
-					l645:
-						position, tokenIndex = position644, tokenIndex644
+						goto l1037
+					l1038:
+						position, tokenIndex = position1037, tokenIndex1037
 						if !_rules[ruleAuthorLowerChar]() {
-							goto l643
+							goto l1036
 						}
-					l648:
+					l1041:
 						{
-							position649, tokenIndex649 := position, tokenIndex
+							position1042, tokenIndex1042 := position, tokenIndex
 							if !_rules[ruleAuthorLowerChar]() {
-								goto l649
+								goto l1042
 							}
-							goto l648
-						l649:
-							position, tokenIndex = position649, tokenIndex649
+							goto l1041
+						l1042:
+							position, tokenIndex = position1042, tokenIndex1042
 						}
 					}
-				l644:
-					goto l642
-				l643:
-					position, tokenIndex = position642, tokenIndex642
+				l1037:
+					goto l1035
+				l1036:
+					position, tokenIndex = position1035, tokenIndex1035
 					if !_rules[ruleAuthorLowerChar]() {
-						goto l640
+						goto l1033
 					}
-				l650:
+				l1043:
 					{
-						position651, tokenIndex651 := position, tokenIndex
+						position1044, tokenIndex1044 := position, tokenIndex
 						if !_rules[ruleAuthorLowerChar]() {
-							goto l651
+							goto l1044
 						}
-						goto l650
-					l651:
-						position, tokenIndex = position651, tokenIndex651
+						goto l1043
+					l1044:
+						position, tokenIndex = position1044, tokenIndex1044
 					}
 				}
-			l642:
+			l1035:
 				{
-					position652, tokenIndex652 := position, tokenIndex
+					position1045, tokenIndex1045 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l652
+						goto l1045
 					}
 					position++
-					goto l653
-				l652:
-					position, tokenIndex = position652, tokenIndex652
+					goto l1046
+				l1045:
+					position, tokenIndex = position1045, tokenIndex1045
 				}
-			l653:
-				add(ruleAuthorWordSoft, position641)
+			l1046:
+				add(ruleAuthorWordSoft, position1034)
 			}
 			return true
-		l640:
-			position, tokenIndex = position640, tokenIndex640
+		l1033:
+			position, tokenIndex = position1033, tokenIndex1033
 			return false
 		},
-		/* 87 CapAuthorWord <- <(AuthorUpperChar AuthorLowerChar*)> */
+		/* 139 CapAuthorWord <- <(AuthorUpperChar AuthorLowerChar*)> */
 		func() bool {
-			position654, tokenIndex654 := position, tokenIndex
+			position1047, tokenIndex1047 := position, tokenIndex
 			{
-				position655 := position
+				position1048 := position
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l654
+					goto l1047
 				}
-			l656:
+			l1049:
 				{
-					position657, tokenIndex657 := position, tokenIndex
+					position1050, tokenIndex1050 := position, tokenIndex
 					if !_rules[ruleAuthorLowerChar]() {
-						goto l657
+						goto l1050
 					}
-					goto l656
-				l657:
-					position, tokenIndex = position657, tokenIndex657
+					goto l1049
+				l1050:
+					position, tokenIndex = position1050, tokenIndex1050
 				}
-				add(ruleCapAuthorWord, position655)
+				add(ruleCapAuthorWord, position1048)
 			}
 			return true
-		l654:
-			position, tokenIndex = position654, tokenIndex654
+		l1047:
+			position, tokenIndex = position1047, tokenIndex1047
 			return false
 		},
-		/* 88 AllCapsAuthorWord <- <(AuthorUpperChar AuthorUpperChar+)> */
+		/* 140 AllCapsAuthorWord <- <(AuthorUpperChar AuthorUpperChar+)> */
 		func() bool {
-			position658, tokenIndex658 := position, tokenIndex
+			position1051, tokenIndex1051 := position, tokenIndex
 			{
-				position659 := position
+				position1052 := position
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l658
+					goto l1051
 				}
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l658
+					goto l1051
 				}
-			l660:
+			l1053:
 				{
-					position661, tokenIndex661 := position, tokenIndex
+					position1054, tokenIndex1054 := position, tokenIndex
 					if !_rules[ruleAuthorUpperChar]() {
-						goto l661
+						goto l1054
 					}
-					goto l660
-				l661:
-					position, tokenIndex = position661, tokenIndex661
+					goto l1053
+				l1054:
+					position, tokenIndex = position1054, tokenIndex1054
 				}
-				add(ruleAllCapsAuthorWord, position659)
+				add(ruleAllCapsAuthorWord, position1052)
 			}
 			return true
-		l658:
-			position, tokenIndex = position658, tokenIndex658
+		l1051:
+			position, tokenIndex = position1051, tokenIndex1051
 			return false
 		},
-		/* 89 Filius <- <(FiliusF / ('f' 'i' 'l' '.') / ('f' 'i' 'l' 'i' 'u' 's'))> */
+		/* 141 Filius <- <(FiliusF / ('f' 'i' 'l' '.') / ('f' 'i' 'l' 'i' 'u' 's'))> */
 		func() bool {
-			position662, tokenIndex662 := position, tokenIndex
+			position1055, tokenIndex1055 := position, tokenIndex
 			{
-				position663 := position
+				position1056 := position
 				{
-					position664, tokenIndex664 := position, tokenIndex
+					position1057, tokenIndex1057 := position, tokenIndex
 					if !_rules[ruleFiliusF]() {
-						goto l665
+						goto l1058
 					}
-					goto l664
-				l665:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1057
+				l1058:
+					position, tokenIndex = position1057, tokenIndex1057
 					if buffer[position] != rune('f') {
-						goto l666
+						goto l1059
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l666
+						goto l1059
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l666
+						goto l1059
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l666
+						goto l1059
 					}
 					position++
-					goto l664
-				l666:
-					position, tokenIndex = position664, tokenIndex664
+					goto l1057
+				l1059:
+					position, tokenIndex = position1057, tokenIndex1057
 					if buffer[position] != rune('f') {
-						goto l662
+						goto l1055
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l662
+						goto l1055
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l662
+						goto l1055
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l662
+						goto l1055
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l662
+						goto l1055
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l662
+						goto l1055
 					}
 					position++
 				}
-			l664:
-				add(ruleFilius, position663)
+			l1057:
+				add(ruleFilius, position1056)
 			}
 			return true
-		l662:
-			position, tokenIndex = position662, tokenIndex662
+		l1055:
+			position, tokenIndex = position1055, tokenIndex1055
 			return false
 		},
-		/* 90 FiliusF <- <('f' '.' !(_ Word))> */
+		/* 142 FiliusF <- <('f' '.' !(_ Word))> */
 		func() bool {
-			position667, tokenIndex667 := position, tokenIndex
+			position1060, tokenIndex1060 := position, tokenIndex
 			{
-				position668 := position
+				position1061 := position
 				if buffer[position] != rune('f') {
-					goto l667
+					goto l1060
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l667
+					goto l1060
 				}
 				position++
 				{
-					position669, tokenIndex669 := position, tokenIndex
+					position1062, tokenIndex1062 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l669
+						goto l1062
 					}
 					if !_rules[ruleWord]() {
-						goto l669
+						goto l1062
 					}
-					goto l667
-				l669:
-					position, tokenIndex = position669, tokenIndex669
+					goto l1060
+				l1062:
+					position, tokenIndex = position1062, tokenIndex1062
 				}
-				add(ruleFiliusF, position668)
+				add(ruleFiliusF, position1061)
 			}
 			return true
-		l667:
-			position, tokenIndex = position667, tokenIndex667
+		l1060:
+			position, tokenIndex = position1060, tokenIndex1060
 			return false
 		},
-		/* 91 FiliusFNoSpace <- <('f' '.')> */
+		/* 143 FiliusFNoSpace <- <('f' '.')> */
 		func() bool {
-			position670, tokenIndex670 := position, tokenIndex
+			position1063, tokenIndex1063 := position, tokenIndex
 			{
-				position671 := position
+				position1064 := position
 				if buffer[position] != rune('f') {
-					goto l670
+					goto l1063
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l670
+					goto l1063
 				}
 				position++
-				add(ruleFiliusFNoSpace, position671)
+				add(ruleFiliusFNoSpace, position1064)
 			}
 			return true
-		l670:
-			position, tokenIndex = position670, tokenIndex670
+		l1063:
+			position, tokenIndex = position1063, tokenIndex1063
 			return false
 		},
-		/* 92 AuthorSuffix <- <('b' 'i' 's')> */
+		/* 144 AuthorSuffix <- <('b' 'i' 's')> */
 		func() bool {
-			position672, tokenIndex672 := position, tokenIndex
+			position1065, tokenIndex1065 := position, tokenIndex
 			{
-				position673 := position
+				position1066 := position
 				if buffer[position] != rune('b') {
-					goto l672
+					goto l1065
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l672
+					goto l1065
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l672
+					goto l1065
 				}
 				position++
-				add(ruleAuthorSuffix, position673)
+				add(ruleAuthorSuffix, position1066)
 			}
 			return true
-		l672:
-			position, tokenIndex = position672, tokenIndex672
+		l1065:
+			position, tokenIndex = position1065, tokenIndex1065
 			return false
 		},
-		/* 93 AuthorPrefixGlued <- <(('d' / 'O' / 'L' / ('M' 'c') / 'M') Apostrophe)> */
+		/* 145 AuthorPrefixGlued <- <(('d' / 'O' / 'L' / ('M' 'c') / 'M') Apostrophe)> */
 		func() bool {
-			position674, tokenIndex674 := position, tokenIndex
+			position1067, tokenIndex1067 := position, tokenIndex
 			{
-				position675 := position
+				position1068 := position
 				{
-					position676, tokenIndex676 := position, tokenIndex
+					position1069, tokenIndex1069 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l677
+						goto l1070
 					}
 					position++
-					goto l676
-				l677:
-					position, tokenIndex = position676, tokenIndex676
+					goto l1069
+				l1070:
+					position, tokenIndex = position1069, tokenIndex1069
 					if buffer[position] != rune('O') {
-						goto l678
+						goto l1071
 					}
 					position++
-					goto l676
-				l678:
-					position, tokenIndex = position676, tokenIndex676
+					goto l1069
+				l1071:
+					position, tokenIndex = position1069, tokenIndex1069
 					if buffer[position] != rune('L') {
-						goto l679
+						goto l1072
 					}
 					position++
-					goto l676
-				l679:
-					position, tokenIndex = position676, tokenIndex676
+					goto l1069
+				l1072:
+					position, tokenIndex = position1069, tokenIndex1069
 					if buffer[position] != rune('M') {
-						goto l680
+						goto l1073
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l680
+						goto l1073
 					}
 					position++
-					goto l676
-				l680:
-					position, tokenIndex = position676, tokenIndex676
+					goto l1069
+				l1073:
+					position, tokenIndex = position1069, tokenIndex1069
 					if buffer[position] != rune('M') {
-						goto l674
+						goto l1067
 					}
 					position++
 				}
-			l676:
+			l1069:
 				if !_rules[ruleApostrophe]() {
-					goto l674
+					goto l1067
 				}
-				add(ruleAuthorPrefixGlued, position675)
+				add(ruleAuthorPrefixGlued, position1068)
 			}
 			return true
-		l674:
-			position, tokenIndex = position674, tokenIndex674
+		l1067:
+			position, tokenIndex = position1067, tokenIndex1067
 			return false
 		},
-		/* 94 AuthorPrefix <- <(AuthorPrefix1 / AuthorPrefix2)> */
+		/* 146 AuthorPrefix <- <(AuthorPrefix1 / AuthorPrefix2)> */
 		func() bool {
-			position681, tokenIndex681 := position, tokenIndex
+			position1074, tokenIndex1074 := position, tokenIndex
 			{
-				position682 := position
+				position1075 := position
 				{
-					position683, tokenIndex683 := position, tokenIndex
+					position1076, tokenIndex1076 := position, tokenIndex
 					if !_rules[ruleAuthorPrefix1]() {
-						goto l684
+						goto l1077
 					}
-					goto l683
-				l684:
-					position, tokenIndex = position683, tokenIndex683
+					goto l1076
+				l1077:
+					position, tokenIndex = position1076, tokenIndex1076
 					if !_rules[ruleAuthorPrefix2]() {
-						goto l681
+						goto l1074
 					}
 				}
-			l683:
-				add(ruleAuthorPrefix, position682)
+			l1076:
+				add(ruleAuthorPrefix, position1075)
 			}
 			return true
-		l681:
-			position, tokenIndex = position681, tokenIndex681
+		l1074:
+			position, tokenIndex = position1074, tokenIndex1074
 			return false
 		},
-		/* 95 AuthorPrefix2 <- <(('v' '.' (_? ('d' '.'))?) / (Apostrophe 't'))> */
+		/* 147 AuthorPrefix2 <- <(('v' '.' (_? ('d' '.'))?) / (Apostrophe 't'))> */
 		func() bool {
-			position685, tokenIndex685 := position, tokenIndex
+			position1078, tokenIndex1078 := position, tokenIndex
 			{
-				position686 := position
+				position1079 := position
 				{
-					position687, tokenIndex687 := position, tokenIndex
+					position1080, tokenIndex1080 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l688
+						goto l1081
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l688
+						goto l1081
 					}
 					position++
 					{
-						position689, tokenIndex689 := position, tokenIndex
+						position1082, tokenIndex1082 := position, tokenIndex
 						{
-							position691, tokenIndex691 := position, tokenIndex
+							position1084, tokenIndex1084 := position, tokenIndex
 							if !_rules[rule_]() {
-								goto l691
+								goto l1084
 							}
-							goto l692
-						l691:
-							position, tokenIndex = position691, tokenIndex691
+							goto l1085
+						l1084:
+							position, tokenIndex = position1084, tokenIndex1084
 						}
-					l692:
+					l1085:
 						if buffer[position] != rune('d') {
-							goto l689
+							goto l1082
 						}
 						position++
 						if buffer[position] != rune('.') {
-							goto l689
+							goto l1082
 						}
 						position++
-						goto l690
-					l689:
-						position, tokenIndex = position689, tokenIndex689
+						goto l1083
+					l1082:
+						position, tokenIndex = position1082, tokenIndex1082
 					}
-				l690:
-					goto l687
-				l688:
-					position, tokenIndex = position687, tokenIndex687
+				l1083:
+					goto l1080
+				l1081:
+					position, tokenIndex = position1080, tokenIndex1080
 					if !_rules[ruleApostrophe]() {
-						goto l685
+						goto l1078
 					}
 					if buffer[position] != rune('t') {
-						goto l685
+						goto l1078
 					}
 					position++
 				}
-			l687:
-				add(ruleAuthorPrefix2, position686)
+			l1080:
+				add(ruleAuthorPrefix2, position1079)
 			}
 			return true
-		l685:
-			position, tokenIndex = position685, tokenIndex685
+		l1078:
+			position, tokenIndex = position1078, tokenIndex1078
 			return false
 		},
-		/* 96 AuthorPrefix1 <- <((('a' 'b') / ('a' 'f') / ('b' 'i' 's') / ('d' 'a') / ('d' 'e' 'r') / ('d' 'e' 's') / ('d' 'e' 'n') / ('d' 'e' 'l') / ('d' 'e' 'l' 'l' 'a') / ('d' 'e' 'l' 'a') / ('d' 'e') / ('d' 'i') / ('d' 'u') / ('e' 'l') / ('l' 'a') / ('l' 'e') / ('t' 'e' 'r') / ('v' 'a' 'n') / ('d' Apostrophe) / ('i' 'n' Apostrophe 't') / ('z' 'u' 'r') / ('z' 'u') / ('v' 'o' 'n' (_ (('d' '.') / ('d' 'e' 'm')))?) / ('v' (_ 'd')?)) &_)> */
+		/* 148 AuthorPrefix1 <- <((('a' 'b') / ('a' 'f') / ('b' 'i' 's') / ('d' 'a') / ('d' 'e' 'r') / ('d' 'e' 's') / ('d' 'e' 'n') / ('d' 'e' 'l') / ('d' 'e' 'l' 'l' 'a') / ('d' 'e' 'l' 'a') / ('d' 'e') / ('d' 'i') / ('d' 'u') / ('e' 'l') / ('l' 'a') / ('l' 'e') / ('t' 'e' 'r') / ('v' 'a' 'n') / ('d' Apostrophe) / ('i' 'n' Apostrophe 't') / ('i' 'n' &(_ Apostrophe 't')) / ('z' 'u' 'r') / ('z' 'u') / ('v' 'o' 'n' (_ (('d' '.') / ('d' 'e' 'm')))?) / ('v' (_ 'd')?)) &_)> */
 		func() bool {
-			position693, tokenIndex693 := position, tokenIndex
+			position1086, tokenIndex1086 := position, tokenIndex
 			{
-				position694 := position
+				position1087 := position
 				{
-					position695, tokenIndex695 := position, tokenIndex
+					position1088, tokenIndex1088 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l696
+						goto l1089
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l696
+						goto l1089
 					}
 					position++
-					goto l695
-				l696:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1089:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('a') {
-						goto l697
+						goto l1090
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l697
+						goto l1090
 					}
 					position++
-					goto l695
-				l697:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1090:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('b') {
-						goto l698
+						goto l1091
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l698
+						goto l1091
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l698
+						goto l1091
 					}
 					position++
-					goto l695
-				l698:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1091:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l699
+						goto l1092
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l699
+						goto l1092
 					}
 					position++
-					goto l695
-				l699:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1092:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l700
+						goto l1093
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l700
+						goto l1093
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l700
+						goto l1093
 					}
 					position++
-					goto l695
-				l700:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1093:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l701
+						goto l1094
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l701
+						goto l1094
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l701
+						goto l1094
 					}
 					position++
-					goto l695
-				l701:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1094:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l702
+						goto l1095
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l702
+						goto l1095
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l702
+						goto l1095
 					}
 					position++
-					goto l695
-				l702:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1095:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l703
+						goto l1096
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l703
+						goto l1096
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l703
+						goto l1096
 					}
 					position++
-					goto l695
-				l703:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1096:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l704
+						goto l1097
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l704
+						goto l1097
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l704
+						goto l1097
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l704
+						goto l1097
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l704
+						goto l1097
 					}
 					position++
-					goto l695
-				l704:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1097:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l705
+						goto l1098
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l705
+						goto l1098
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l705
+						goto l1098
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l705
+						goto l1098
 					}
 					position++
-					goto l695
-				l705:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1098:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l706
+						goto l1099
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l706
+						goto l1099
 					}
 					position++
-					goto l695
-				l706:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1099:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l707
+						goto l1100
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l707
+						goto l1100
 					}
 					position++
-					goto l695
-				l707:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1100:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l708
+						goto l1101
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l708
+						goto l1101
 					}
 					position++
-					goto l695
-				l708:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1101:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('e') {
-						goto l709
+						goto l1102
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l709
+						goto l1102
 					}
 					position++
-					goto l695
-				l709:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1102:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('l') {
-						goto l710
+						goto l1103
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l710
+						goto l1103
 					}
 					position++
-					goto l695
-				l710:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1103:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('l') {
-						goto l711
+						goto l1104
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l711
+						goto l1104
 					}
 					position++
-					goto l695
-				l711:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1104:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('t') {
-						goto l712
+						goto l1105
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l712
+						goto l1105
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l712
+						goto l1105
 					}
 					position++
-					goto l695
-				l712:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1105:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('v') {
-						goto l713
+						goto l1106
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l713
+						goto l1106
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l713
+						goto l1106
 					}
 					position++
-					goto l695
-				l713:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1106:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('d') {
-						goto l714
+						goto l1107
 					}
 					position++
 					if !_rules[ruleApostrophe]() {
-						goto l714
+						goto l1107
 					}
-					goto l695
-				l714:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1107:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('i') {
-						goto l715
+						goto l1108
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l715
+						goto l1108
 					}
 					position++
 					if !_rules[ruleApostrophe]() {
-						goto l715
+						goto l1108
 					}
 					if buffer[position] != rune('t') {
-						goto l715
+						goto l1108
 					}
 					position++
-					goto l695
-				l715:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1108:
+					position, tokenIndex = position1088, tokenIndex1088
+					if buffer[position] != rune('i') {
+						goto l1109
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1109
+					}
+					position++
+					{
+						position1110, tokenIndex1110 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l1109
+						}
+						if !_rules[ruleApostrophe]() {
+							goto l1109
+						}
+						if buffer[position] != rune('t') {
+							goto l1109
+						}
+						position++
+						position, tokenIndex = position1110, tokenIndex1110
+					}
+					goto l1088
+				l1109:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('z') {
-						goto l716
+						goto l1111
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l716
+						goto l1111
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l716
+						goto l1111
 					}
 					position++
-					goto l695
-				l716:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1111:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('z') {
-						goto l717
+						goto l1112
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l717
+						goto l1112
 					}
 					position++
-					goto l695
-				l717:
-					position, tokenIndex = position695, tokenIndex695
+					goto l1088
+				l1112:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('v') {
-						goto l718
+						goto l1113
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l718
+						goto l1113
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l718
+						goto l1113
 					}
 					position++
 					{
-						position719, tokenIndex719 := position, tokenIndex
+						position1114, tokenIndex1114 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l719
+							goto l1114
 						}
 						{
-							position721, tokenIndex721 := position, tokenIndex
+							position1116, tokenIndex1116 := position, tokenIndex
 							if buffer[position] != rune('d') {
-								goto l722
+								goto l1117
 							}
 							position++
 							if buffer[position] != rune('.') {
-								goto l722
+								goto l1117
 							}
 							position++
-							goto l721
-						l722:
-							position, tokenIndex = position721, tokenIndex721
+							goto l1116
+						l1117:
+							position, tokenIndex = position1116, tokenIndex1116
 							if buffer[position] != rune('d') {
-								goto l719
+								goto l1114
 							}
 							position++
 							if buffer[position] != rune('e') {
-								goto l719
+								goto l1114
 							}
 							position++
 							if buffer[position] != rune('m') {
-								goto l719
+								goto l1114
 							}
 							position++
 						}
-					l721:
-						goto l720
-					l719:
-						position, tokenIndex = position719, tokenIndex719
+					l1116:
+						goto l1115
+					l1114:
+						position, tokenIndex = position1114, tokenIndex1114
 					}
-				l720:
-					goto l695
-				l718:
-					position, tokenIndex = position695, tokenIndex695
+				l1115:
+					goto l1088
+				l1113:
+					position, tokenIndex = position1088, tokenIndex1088
 					if buffer[position] != rune('v') {
-						goto l693
+						goto l1086
 					}
 					position++
 					{
-						position723, tokenIndex723 := position, tokenIndex
+						position1118, tokenIndex1118 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l723
+							goto l1118
 						}
 						if buffer[position] != rune('d') {
-							goto l723
+							goto l1118
 						}
 						position++
-						goto l724
-					l723:
-						position, tokenIndex = position723, tokenIndex723
+						goto l1119
+					l1118:
+						position, tokenIndex = position1118, tokenIndex1118
 					}
-				l724:
+				l1119:
 				}
-			l695:
+			l1088:
 				{
-					position725, tokenIndex725 := position, tokenIndex
+					position1120, tokenIndex1120 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l693
+						goto l1086
 					}
-					position, tokenIndex = position725, tokenIndex725
+					position, tokenIndex = position1120, tokenIndex1120
 				}
-				add(ruleAuthorPrefix1, position694)
+				add(ruleAuthorPrefix1, position1087)
 			}
 			return true
-		l693:
-			position, tokenIndex = position693, tokenIndex693
+		l1086:
+			position, tokenIndex = position1086, tokenIndex1086
 			return false
 		},
-		/* 97 AuthorUpperChar <- <(UpperASCII / MiscodedChar / ('À' / 'Á' / 'Â' / 'Ã' / 'Ä' / 'Å' / 'Æ' / 'Ç' / 'È' / 'É' / 'Ê' / 'Ë' / 'Ì' / 'Í' / 'Î' / 'Ï' / 'Ð' / 'Ñ' / 'Ò' / 'Ó' / 'Ô' / 'Õ' / 'Ö' / 'Ø' / 'Ù' / 'Ú' / 'Û' / 'Ü' / 'Ý' / 'Ć' / 'Č' / 'Ď' / 'İ' / 'Ķ' / 'Ĺ' / 'ĺ' / 'Ľ' / 'ľ' / 'Ł' / 'ł' / 'Ņ' / 'Ō' / 'Ő' / 'Œ' / 'Ř' / 'Ś' / 'Ŝ' / 'Ş' / 'Š' / 'Ÿ' / 'Ź' / 'Ż' / 'Ž' / 'ƒ' / 'Ǿ' / 'Ș' / 'Ț'))> */
+		/* 149 AuthorUpperChar <- <(UpperASCII / MiscodedChar / ('À' / 'Á' / 'Â' / 'Ã' / 'Ä' / 'Å' / 'Æ' / 'Ç' / 'È' / 'É' / 'Ê' / 'Ë' / 'Ì' / 'Í' / 'Î' / 'Ï' / 'Ð' / 'Ñ' / 'Ò' / 'Ó' / 'Ô' / 'Õ' / 'Ö' / 'Ø' / 'Ù' / 'Ú' / 'Û' / 'Ü' / 'Ý' / 'Ć' / 'Č' / 'Ď' / 'İ' / 'Ķ' / 'Ĺ' / 'ĺ' / 'Ľ' / 'ľ' / 'Ł' / 'ł' / 'Ņ' / 'Ō' / 'Ő' / 'Œ' / 'Ř' / 'Ś' / 'Ŝ' / 'Ş' / 'Š' / 'Ÿ' / 'Ź' / 'Ż' / 'Ž' / 'ƒ' / 'Ǿ' / 'Ș' / 'Ț'))> */
 		func() bool {
-			position726, tokenIndex726 := position, tokenIndex
+			position1121, tokenIndex1121 := position, tokenIndex
 			{
-				position727 := position
+				position1122 := position
 				{
-					position728, tokenIndex728 := position, tokenIndex
+					position1123, tokenIndex1123 := position, tokenIndex
 					if !_rules[ruleUpperASCII]() {
-						goto l729
+						goto l1124
 					}
-					goto l728
-				l729:
-					position, tokenIndex = position728, tokenIndex728
+					goto l1123
+				l1124:
+					position, tokenIndex = position1123, tokenIndex1123
 					if !_rules[ruleMiscodedChar]() {
-						goto l730
+						goto l1125
 					}
-					goto l728
-				l730:
-					position, tokenIndex = position728, tokenIndex728
+					goto l1123
+				l1125:
+					position, tokenIndex = position1123, tokenIndex1123
 					{
-						position731, tokenIndex731 := position, tokenIndex
+						position1126, tokenIndex1126 := position, tokenIndex
 						if buffer[position] != rune('À') {
-							goto l732
+							goto l1127
 						}
 						position++
-						goto l731
-					l732:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1127:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Á') {
-							goto l733
+							goto l1128
 						}
 						position++
-						goto l731
-					l733:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1128:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Â') {
-							goto l734
+							goto l1129
 						}
 						position++
-						goto l731
-					l734:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1129:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ã') {
-							goto l735
+							goto l1130
 						}
 						position++
-						goto l731
-					l735:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1130:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ä') {
-							goto l736
+							goto l1131
 						}
 						position++
-						goto l731
-					l736:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1131:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Å') {
-							goto l737
+							goto l1132
 						}
 						position++
-						goto l731
-					l737:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1132:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Æ') {
-							goto l738
+							goto l1133
 						}
 						position++
-						goto l731
-					l738:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1133:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ç') {
-							goto l739
+							goto l1134
 						}
 						position++
-						goto l731
-					l739:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1134:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('È') {
-							goto l740
+							goto l1135
 						}
 						position++
-						goto l731
-					l740:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1135:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('É') {
-							goto l741
+							goto l1136
 						}
 						position++
-						goto l731
-					l741:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1136:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ê') {
-							goto l742
+							goto l1137
 						}
 						position++
-						goto l731
-					l742:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1137:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ë') {
-							goto l743
+							goto l1138
 						}
 						position++
-						goto l731
-					l743:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1138:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ì') {
-							goto l744
+							goto l1139
 						}
 						position++
-						goto l731
-					l744:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1139:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Í') {
-							goto l745
+							goto l1140
 						}
 						position++
-						goto l731
-					l745:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1140:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Î') {
-							goto l746
+							goto l1141
 						}
 						position++
-						goto l731
-					l746:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1141:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ï') {
-							goto l747
+							goto l1142
 						}
 						position++
-						goto l731
-					l747:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1142:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ð') {
-							goto l748
+							goto l1143
 						}
 						position++
-						goto l731
-					l748:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1143:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ñ') {
-							goto l749
+							goto l1144
 						}
 						position++
-						goto l731
-					l749:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1144:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ò') {
-							goto l750
+							goto l1145
 						}
 						position++
-						goto l731
-					l750:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1145:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ó') {
-							goto l751
+							goto l1146
 						}
 						position++
-						goto l731
-					l751:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1146:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ô') {
-							goto l752
+							goto l1147
 						}
 						position++
-						goto l731
-					l752:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1147:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Õ') {
-							goto l753
+							goto l1148
 						}
 						position++
-						goto l731
-					l753:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1148:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ö') {
-							goto l754
+							goto l1149
 						}
 						position++
-						goto l731
-					l754:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1149:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ø') {
-							goto l755
+							goto l1150
 						}
 						position++
-						goto l731
-					l755:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1150:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ù') {
-							goto l756
+							goto l1151
 						}
 						position++
-						goto l731
-					l756:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1151:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ú') {
-							goto l757
+							goto l1152
 						}
 						position++
-						goto l731
-					l757:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1152:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Û') {
-							goto l758
+							goto l1153
 						}
 						position++
-						goto l731
-					l758:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1153:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ü') {
-							goto l759
+							goto l1154
 						}
 						position++
-						goto l731
-					l759:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1154:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ý') {
-							goto l760
+							goto l1155
 						}
 						position++
-						goto l731
-					l760:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1155:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ć') {
-							goto l761
+							goto l1156
 						}
 						position++
-						goto l731
-					l761:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1156:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Č') {
-							goto l762
+							goto l1157
 						}
 						position++
-						goto l731
-					l762:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1157:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ď') {
-							goto l763
+							goto l1158
 						}
 						position++
-						goto l731
-					l763:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1158:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('İ') {
-							goto l764
+							goto l1159
 						}
 						position++
-						goto l731
-					l764:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1159:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ķ') {
-							goto l765
+							goto l1160
 						}
 						position++
-						goto l731
-					l765:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1160:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ĺ') {
-							goto l766
+							goto l1161
 						}
 						position++
-						goto l731
-					l766:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1161:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('ĺ') {
-							goto l767
+							goto l1162
 						}
 						position++
-						goto l731
-					l767:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1162:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ľ') {
-							goto l768
+							goto l1163
 						}
 						position++
-						goto l731
-					l768:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1163:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('ľ') {
-							goto l769
+							goto l1164
 						}
 						position++
-						goto l731
-					l769:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1164:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ł') {
-							goto l770
+							goto l1165
 						}
 						position++
-						goto l731
-					l770:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1165:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('ł') {
-							goto l771
+							goto l1166
 						}
 						position++
-						goto l731
-					l771:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1166:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ņ') {
-							goto l772
+							goto l1167
 						}
 						position++
-						goto l731
-					l772:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1167:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ō') {
-							goto l773
+							goto l1168
 						}
 						position++
-						goto l731
-					l773:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1168:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ő') {
-							goto l774
+							goto l1169
 						}
 						position++
-						goto l731
-					l774:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1169:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Œ') {
-							goto l775
+							goto l1170
 						}
 						position++
-						goto l731
-					l775:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1170:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ř') {
-							goto l776
+							goto l1171
 						}
 						position++
-						goto l731
-					l776:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1171:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ś') {
-							goto l777
+							goto l1172
 						}
 						position++
-						goto l731
-					l777:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1172:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ŝ') {
-							goto l778
+							goto l1173
 						}
 						position++
-						goto l731
-					l778:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1173:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ş') {
-							goto l779
+							goto l1174
 						}
 						position++
-						goto l731
-					l779:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1174:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Š') {
-							goto l780
+							goto l1175
 						}
 						position++
-						goto l731
-					l780:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1175:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ÿ') {
-							goto l781
+							goto l1176
 						}
 						position++
-						goto l731
-					l781:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1176:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ź') {
-							goto l782
+							goto l1177
 						}
 						position++
-						goto l731
-					l782:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1177:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ż') {
-							goto l783
+							goto l1178
 						}
 						position++
-						goto l731
-					l783:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1178:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ž') {
-							goto l784
+							goto l1179
 						}
 						position++
-						goto l731
-					l784:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1179:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('ƒ') {
-							goto l785
+							goto l1180
 						}
 						position++
-						goto l731
-					l785:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1180:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ǿ') {
-							goto l786
+							goto l1181
 						}
 						position++
-						goto l731
-					l786:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1181:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ș') {
-							goto l787
+							goto l1182
 						}
 						position++
-						goto l731
-					l787:
-						position, tokenIndex = position731, tokenIndex731
+						goto l1126
+					l1182:
+						position, tokenIndex = position1126, tokenIndex1126
 						if buffer[position] != rune('Ț') {
-							goto l726
+							goto l1121
 						}
 						position++
 					}
-				l731:
+				l1126:
 				}
-			l728:
-				add(ruleAuthorUpperChar, position727)
+			l1123:
+				add(ruleAuthorUpperChar, position1122)
 			}
 			return true
-		l726:
-			position, tokenIndex = position726, tokenIndex726
+		l1121:
+			position, tokenIndex = position1121, tokenIndex1121
 			return false
 		},
-		/* 98 AuthorLowerChar <- <(LowerASCII / MiscodedChar / ('à' / 'á' / 'â' / 'ã' / 'ä' / 'å' / 'æ' / 'ç' / 'è' / 'é' / 'ê' / 'ë' / 'ì' / 'í' / 'î' / 'ï' / 'ð' / 'ñ' / 'ò' / 'ó' / 'ó' / 'ô' / 'õ' / 'ö' / 'ø' / 'ù' / 'ú' / 'û' / 'ü' / 'ý' / 'ÿ' / 'ā' / 'ă' / 'ą' / 'ć' / 'ĉ' / 'č' / 'ď' / 'đ' / '\'' / 'ē' / 'ĕ' / 'ė' / 'ę' / 'ě' / 'ğ' / 'ī' / 'ĭ' / 'İ' / 'ı' / 'ĺ' / 'ľ' / 'ł' / 'ń' / 'ņ' / 'ň' / 'ŏ' / 'ő' / 'œ' / 'ŕ' / 'ř' / 'ś' / 'ş' / 'š' / 'ţ' / 'ť' / 'ũ' / 'ū' / 'ŭ' / 'ů' / 'ű' / 'ź' / 'ż' / 'ž' / 'ſ' / 'ǎ' / 'ǔ' / 'ǧ' / 'ș' / 'ț' / 'ȳ' / 'ß'))> */
+		/* 150 AuthorLowerChar <- <(LowerASCII / MiscodedChar / ('à' / 'á' / 'â' / 'ã' / 'ä' / 'å' / 'æ' / 'ç' / 'è' / 'é' / 'ê' / 'ë' / 'ì' / 'í' / 'î' / 'ï' / 'ð' / 'ñ' / 'ò' / 'ó' / 'ó' / 'ô' / 'õ' / 'ö' / 'ø' / 'ù' / 'ú' / 'û' / 'ü' / 'ý' / 'ÿ' / 'ā' / 'ă' / 'ą' / 'ć' / 'ĉ' / 'č' / 'ď' / 'đ' / '\'' / 'ē' / 'ĕ' / 'ė' / 'ę' / 'ě' / 'ğ' / 'ī' / 'ĭ' / 'İ' / 'ı' / 'ĺ' / 'ľ' / 'ł' / 'ń' / 'ņ' / 'ň' / 'ŏ' / 'ő' / 'œ' / 'ŕ' / 'ř' / 'ś' / 'ş' / 'š' / 'ţ' / 'ť' / 'ũ' / 'ū' / 'ŭ' / 'ů' / 'ű' / 'ź' / 'ż' / 'ž' / 'ſ' / 'ǎ' / 'ǔ' / 'ǧ' / 'ș' / 'ț' / 'ȳ' / 'ß'))> */
 		func() bool {
-			position788, tokenIndex788 := position, tokenIndex
+			position1183, tokenIndex1183 := position, tokenIndex
 			{
-				position789 := position
+				position1184 := position
 				{
-					position790, tokenIndex790 := position, tokenIndex
+					position1185, tokenIndex1185 := position, tokenIndex
 					if !_rules[ruleLowerASCII]() {
-						goto l791
+						goto l1186
 					}
-					goto l790
-				l791:
-					position, tokenIndex = position790, tokenIndex790
+					goto l1185
+				l1186:
+					position, tokenIndex = position1185, tokenIndex1185
 					if !_rules[ruleMiscodedChar]() {
-						goto l792
+						goto l1187
 					}
-					goto l790
-				l792:
-					position, tokenIndex = position790, tokenIndex790
+					goto l1185
+				l1187:
+					position, tokenIndex = position1185, tokenIndex1185
 					{
-						position793, tokenIndex793 := position, tokenIndex
+						position1188, tokenIndex1188 := position, tokenIndex
 						if buffer[position] != rune('à') {
-							goto l794
+							goto l1189
 						}
 						position++
-						goto l793
-					l794:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1189:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('á') {
-							goto l795
+							goto l1190
 						}
 						position++
-						goto l793
-					l795:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1190:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('â') {
-							goto l796
+							goto l1191
 						}
 						position++
-						goto l793
-					l796:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1191:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ã') {
-							goto l797
+							goto l1192
 						}
 						position++
-						goto l793
-					l797:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1192:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ä') {
-							goto l798
+							goto l1193
 						}
 						position++
-						goto l793
-					l798:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1193:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('å') {
-							goto l799
+							goto l1194
 						}
 						position++
-						goto l793
-					l799:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1194:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('æ') {
-							goto l800
+							goto l1195
 						}
 						position++
-						goto l793
-					l800:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1195:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ç') {
-							goto l801
+							goto l1196
 						}
 						position++
-						goto l793
-					l801:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1196:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('è') {
-							goto l802
+							goto l1197
 						}
 						position++
-						goto l793
-					l802:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1197:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('é') {
-							goto l803
+							goto l1198
 						}
 						position++
-						goto l793
-					l803:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1198:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ê') {
-							goto l804
+							goto l1199
 						}
 						position++
-						goto l793
-					l804:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1199:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ë') {
-							goto l805
+							goto l1200
 						}
 						position++
-						goto l793
-					l805:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1200:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ì') {
-							goto l806
+							goto l1201
 						}
 						position++
-						goto l793
-					l806:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1201:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('í') {
-							goto l807
+							goto l1202
 						}
 						position++
-						goto l793
-					l807:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1202:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('î') {
-							goto l808
+							goto l1203
 						}
 						position++
-						goto l793
-					l808:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1203:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ï') {
-							goto l809
+							goto l1204
 						}
 						position++
-						goto l793
-					l809:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1204:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ð') {
-							goto l810
+							goto l1205
 						}
 						position++
-						goto l793
-					l810:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1205:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ñ') {
-							goto l811
+							goto l1206
 						}
 						position++
-						goto l793
-					l811:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1206:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ò') {
-							goto l812
+							goto l1207
 						}
 						position++
-						goto l793
-					l812:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1207:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ó') {
-							goto l813
+							goto l1208
 						}
 						position++
-						goto l793
-					l813:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1208:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ó') {
-							goto l814
+							goto l1209
 						}
 						position++
-						goto l793
-					l814:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1209:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ô') {
-							goto l815
+							goto l1210
 						}
 						position++
-						goto l793
-					l815:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1210:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('õ') {
-							goto l816
+							goto l1211
 						}
 						position++
-						goto l793
-					l816:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1211:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ö') {
-							goto l817
+							goto l1212
 						}
 						position++
-						goto l793
-					l817:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1212:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ø') {
-							goto l818
+							goto l1213
 						}
 						position++
-						goto l793
-					l818:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1213:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ù') {
-							goto l819
+							goto l1214
 						}
 						position++
-						goto l793
-					l819:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1214:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ú') {
-							goto l820
+							goto l1215
 						}
 						position++
-						goto l793
-					l820:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1215:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('û') {
-							goto l821
+							goto l1216
 						}
 						position++
-						goto l793
-					l821:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1216:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ü') {
-							goto l822
+							goto l1217
 						}
 						position++
-						goto l793
-					l822:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1217:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ý') {
-							goto l823
+							goto l1218
 						}
 						position++
-						goto l793
-					l823:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1218:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ÿ') {
-							goto l824
+							goto l1219
 						}
 						position++
-						goto l793
-					l824:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1219:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ā') {
-							goto l825
+							goto l1220
 						}
 						position++
-						goto l793
-					l825:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1220:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ă') {
-							goto l826
+							goto l1221
 						}
 						position++
-						goto l793
-					l826:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1221:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ą') {
-							goto l827
+							goto l1222
 						}
 						position++
-						goto l793
-					l827:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1222:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ć') {
-							goto l828
+							goto l1223
 						}
 						position++
-						goto l793
-					l828:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1223:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ĉ') {
-							goto l829
+							goto l1224
 						}
 						position++
-						goto l793
-					l829:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1224:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('č') {
-							goto l830
+							goto l1225
 						}
 						position++
-						goto l793
-					l830:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1225:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ď') {
-							goto l831
+							goto l1226
 						}
 						position++
-						goto l793
-					l831:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1226:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('đ') {
-							goto l832
+							goto l1227
 						}
 						position++
-						goto l793
-					l832:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1227:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('\'') {
-							goto l833
+							goto l1228
 						}
 						position++
-						goto l793
-					l833:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1228:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ē') {
-							goto l834
+							goto l1229
 						}
 						position++
-						goto l793
-					l834:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1229:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ĕ') {
-							goto l835
+							goto l1230
 						}
 						position++
-						goto l793
-					l835:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1230:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ė') {
-							goto l836
+							goto l1231
 						}
 						position++
-						goto l793
-					l836:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1231:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ę') {
-							goto l837
+							goto l1232
 						}
 						position++
-						goto l793
-					l837:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1232:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ě') {
-							goto l838
+							goto l1233
 						}
 						position++
-						goto l793
-					l838:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1233:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ğ') {
-							goto l839
+							goto l1234
 						}
 						position++
-						goto l793
-					l839:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1234:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ī') {
-							goto l840
+							goto l1235
 						}
 						position++
-						goto l793
-					l840:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1235:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ĭ') {
-							goto l841
+							goto l1236
 						}
 						position++
-						goto l793
-					l841:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1236:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('İ') {
-							goto l842
+							goto l1237
 						}
 						position++
-						goto l793
-					l842:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1237:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ı') {
-							goto l843
+							goto l1238
 						}
 						position++
-						goto l793
-					l843:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1238:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ĺ') {
-							goto l844
+							goto l1239
 						}
 						position++
-						goto l793
-					l844:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1239:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ľ') {
-							goto l845
+							goto l1240
 						}
 						position++
-						goto l793
-					l845:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1240:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ł') {
-							goto l846
+							goto l1241
 						}
 						position++
-						goto l793
-					l846:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1241:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ń') {
-							goto l847
+							goto l1242
 						}
 						position++
-						goto l793
-					l847:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1242:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ņ') {
-							goto l848
+							goto l1243
 						}
 						position++
-						goto l793
-					l848:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1243:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ň') {
-							goto l849
+							goto l1244
 						}
 						position++
-						goto l793
-					l849:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1244:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ŏ') {
-							goto l850
+							goto l1245
 						}
 						position++
-						goto l793
-					l850:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1245:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ő') {
-							goto l851
+							goto l1246
 						}
 						position++
-						goto l793
-					l851:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1246:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('œ') {
-							goto l852
+							goto l1247
 						}
 						position++
-						goto l793
-					l852:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1247:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ŕ') {
-							goto l853
+							goto l1248
 						}
 						position++
-						goto l793
-					l853:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1248:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ř') {
-							goto l854
+							goto l1249
 						}
 						position++
-						goto l793
-					l854:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1249:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ś') {
-							goto l855
+							goto l1250
 						}
 						position++
-						goto l793
-					l855:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1250:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ş') {
-							goto l856
+							goto l1251
 						}
 						position++
-						goto l793
-					l856:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1251:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('š') {
-							goto l857
+							goto l1252
 						}
 						position++
-						goto l793
-					l857:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1252:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ţ') {
-							goto l858
+							goto l1253
 						}
 						position++
-						goto l793
-					l858:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1253:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ť') {
-							goto l859
+							goto l1254
 						}
 						position++
-						goto l793
-					l859:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1254:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ũ') {
-							goto l860
+							goto l1255
 						}
 						position++
-						goto l793
-					l860:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1255:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ū') {
-							goto l861
+							goto l1256
 						}
 						position++
-						goto l793
-					l861:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1256:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ŭ') {
-							goto l862
+							goto l1257
 						}
 						position++
-						goto l793
-					l862:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1257:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ů') {
-							goto l863
+							goto l1258
 						}
 						position++
-						goto l793
-					l863:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1258:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ű') {
-							goto l864
+							goto l1259
 						}
 						position++
-						goto l793
-					l864:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1259:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ź') {
-							goto l865
+							goto l1260
 						}
 						position++
-						goto l793
-					l865:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1260:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ż') {
-							goto l866
+							goto l1261
 						}
 						position++
-						goto l793
-					l866:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1261:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ž') {
-							goto l867
+							goto l1262
 						}
 						position++
-						goto l793
-					l867:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1262:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ſ') {
-							goto l868
+							goto l1263
 						}
 						position++
-						goto l793
-					l868:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1263:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ǎ') {
-							goto l869
+							goto l1264
 						}
 						position++
-						goto l793
-					l869:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1264:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ǔ') {
-							goto l870
+							goto l1265
 						}
 						position++
-						goto l793
-					l870:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1265:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ǧ') {
-							goto l871
+							goto l1266
 						}
 						position++
-						goto l793
-					l871:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1266:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ș') {
-							goto l872
+							goto l1267
 						}
 						position++
-						goto l793
-					l872:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1267:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ț') {
-							goto l873
+							goto l1268
 						}
 						position++
-						goto l793
-					l873:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1268:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ȳ') {
-							goto l874
+							goto l1269
 						}
 						position++
-						goto l793
-					l874:
-						position, tokenIndex = position793, tokenIndex793
+						goto l1188
+					l1269:
+						position, tokenIndex = position1188, tokenIndex1188
 						if buffer[position] != rune('ß') {
-							goto l788
+							goto l1183
 						}
 						position++
 					}
-				l793:
+				l1188:
 				}
-			l790:
-				add(ruleAuthorLowerChar, position789)
+			l1185:
+				add(ruleAuthorLowerChar, position1184)
 			}
 			return true
-		l788:
-			position, tokenIndex = position788, tokenIndex788
+		l1183:
+			position, tokenIndex = position1183, tokenIndex1183
 			return false
 		},
-		/* 99 Year <- <(YearRange / YearApprox / YearWithParens / YearWithPage / YearWithDot / YearWithChar / YearNum)> */
+		/* 151 Year <- <(YearRange / YearApprox / YearWithParens / YearWithPage / YearWithDot / YearWithChar / YearNum)> */
 		func() bool {
-			position875, tokenIndex875 := position, tokenIndex
+			position1270, tokenIndex1270 := position, tokenIndex
 			{
-				position876 := position
+				position1271 := position
 				{
-					position877, tokenIndex877 := position, tokenIndex
+					position1272, tokenIndex1272 := position, tokenIndex
 					if !_rules[ruleYearRange]() {
-						goto l878
+						goto l1273
 					}
-					goto l877
-				l878:
-					position, tokenIndex = position877, tokenIndex877
+					goto l1272
+				l1273:
+					position, tokenIndex = position1272, tokenIndex1272
 					if !_rules[ruleYearApprox]() {
-						goto l879
+						goto l1274
 					}
-					goto l877
-				l879:
-					position, tokenIndex = position877, tokenIndex877
+					goto l1272
+				l1274:
+					position, tokenIndex = position1272, tokenIndex1272
 					if !_rules[ruleYearWithParens]() {
-						goto l880
+						goto l1275
 					}
-					goto l877
-				l880:
-					position, tokenIndex = position877, tokenIndex877
+					goto l1272
+				l1275:
+					position, tokenIndex = position1272, tokenIndex1272
 					if !_rules[ruleYearWithPage]() {
-						goto l881
+						goto l1276
 					}
-					goto l877
-				l881:
-					position, tokenIndex = position877, tokenIndex877
+					goto l1272
+				l1276:
+					position, tokenIndex = position1272, tokenIndex1272
 					if !_rules[ruleYearWithDot]() {
-						goto l882
+						goto l1277
 					}
-					goto l877
-				l882:
-					position, tokenIndex = position877, tokenIndex877
+					goto l1272
+				l1277:
+					position, tokenIndex = position1272, tokenIndex1272
 					if !_rules[ruleYearWithChar]() {
-						goto l883
+						goto l1278
 					}
-					goto l877
-				l883:
-					position, tokenIndex = position877, tokenIndex877
+					goto l1272
+				l1278:
+					position, tokenIndex = position1272, tokenIndex1272
 					if !_rules[ruleYearNum]() {
-						goto l875
+						goto l1270
 					}
 				}
-			l877:
-				add(ruleYear, position876)
+			l1272:
+				add(ruleYear, position1271)
 			}
 			return true
-		l875:
-			position, tokenIndex = position875, tokenIndex875
+		l1270:
+			position, tokenIndex = position1270, tokenIndex1270
 			return false
 		},
-		/* 100 YearRange <- <(YearNum (Dash / Slash) (Nums+ ('a' / 'b' / 'c' / 'd' / 'e' / 'f' / 'g' / 'h' / 'i' / 'j' / 'k' / 'l' / 'm' / 'n' / 'o' / 'p' / 'q' / 'r' / 's' / 't' / 'u' / 'v' / 'w' / 'x' / 'y' / 'z' / '?')*))> */
+		/* 152 YearRange <- <(YearNum (Dash / Slash) (Nums+ ('a' / 'b' / 'c' / 'd' / 'e' / 'f' / 'g' / 'h' / 'i' / 'j' / 'k' / 'l' / 'm' / 'n' / 'o' / 'p' / 'q' / 'r' / 's' / 't' / 'u' / 'v' / 'w' / 'x' / 'y' / 'z' / '?')*))> */
 		func() bool {
-			position884, tokenIndex884 := position, tokenIndex
+			position1279, tokenIndex1279 := position, tokenIndex
 			{
-				position885 := position
+				position1280 := position
 				if !_rules[ruleYearNum]() {
-					goto l884
+					goto l1279
 				}
 				{
-					position886, tokenIndex886 := position, tokenIndex
+					position1281, tokenIndex1281 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l887
+						goto l1282
 					}
-					goto l886
-				l887:
-					position, tokenIndex = position886, tokenIndex886
+					goto l1281
+				l1282:
+					position, tokenIndex = position1281, tokenIndex1281
 					if !_rules[ruleSlash]() {
-						goto l884
+						goto l1279
 					}
 				}
-			l886:
+			l1281:
 				if !_rules[ruleNums]() {
-					goto l884
+					goto l1279
 				}
-			l888:
+			l1283:
 				{
-					position889, tokenIndex889 := position, tokenIndex
+					position1284, tokenIndex1284 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l889
+						goto l1284
 					}
-					goto l888
-				l889:
-					position, tokenIndex = position889, tokenIndex889
+					goto l1283
+				l1284:
+					position, tokenIndex = position1284, tokenIndex1284
 				}
-			l890:
+			l1285:
 				{
-					position891, tokenIndex891 := position, tokenIndex
+					position1286, tokenIndex1286 := position, tokenIndex
 					{
-						position892, tokenIndex892 := position, tokenIndex
+						position1287, tokenIndex1287 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l893
+							goto l1288
 						}
 						position++
-						goto l892
-					l893:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1288:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('b') {
-							goto l894
+							goto l1289
 						}
 						position++
-						goto l892
-					l894:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1289:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('c') {
-							goto l895
+							goto l1290
 						}
 						position++
-						goto l892
-					l895:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1290:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('d') {
-							goto l896
+							goto l1291
 						}
 						position++
-						goto l892
-					l896:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1291:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('e') {
-							goto l897
+							goto l1292
 						}
 						position++
-						goto l892
-					l897:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1292:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('f') {
-							goto l898
+							goto l1293
 						}
 						position++
-						goto l892
-					l898:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1293:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('g') {
-							goto l899
+							goto l1294
 						}
 						position++
-						goto l892
-					l899:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1294:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('h') {
-							goto l900
+							goto l1295
 						}
 						position++
-						goto l892
-					l900:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1295:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('i') {
-							goto l901
+							goto l1296
 						}
 						position++
-						goto l892
-					l901:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1296:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('j') {
-							goto l902
+							goto l1297
 						}
 						position++
-						goto l892
-					l902:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1297:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('k') {
-							goto l903
+							goto l1298
 						}
 						position++
-						goto l892
-					l903:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1298:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('l') {
-							goto l904
+							goto l1299
 						}
 						position++
-						goto l892
-					l904:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1299:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('m') {
-							goto l905
+							goto l1300
 						}
 						position++
-						goto l892
-					l905:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1300:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('n') {
-							goto l906
+							goto l1301
 						}
 						position++
-						goto l892
-					l906:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1301:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('o') {
-							goto l907
+							goto l1302
 						}
 						position++
-						goto l892
-					l907:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1302:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('p') {
-							goto l908
+							goto l1303
 						}
 						position++
-						goto l892
-					l908:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1303:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('q') {
-							goto l909
+							goto l1304
 						}
 						position++
-						goto l892
-					l909:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1304:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('r') {
-							goto l910
+							goto l1305
 						}
 						position++
-						goto l892
-					l910:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1305:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('s') {
-							goto l911
+							goto l1306
 						}
 						position++
-						goto l892
-					l911:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1306:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('t') {
-							goto l912
+							goto l1307
 						}
 						position++
-						goto l892
-					l912:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1307:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('u') {
-							goto l913
+							goto l1308
 						}
 						position++
-						goto l892
-					l913:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1308:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('v') {
-							goto l914
+							goto l1309
 						}
 						position++
-						goto l892
-					l914:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1309:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('w') {
-							goto l915
+							goto l1310
 						}
 						position++
-						goto l892
-					l915:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1310:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('x') {
-							goto l916
+							goto l1311
 						}
 						position++
-						goto l892
-					l916:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1311:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('y') {
-							goto l917
+							goto l1312
 						}
 						position++
-						goto l892
-					l917:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1312:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('z') {
-							goto l918
+							goto l1313
 						}
 						position++
-						goto l892
-					l918:
-						position, tokenIndex = position892, tokenIndex892
+						goto l1287
+					l1313:
+						position, tokenIndex = position1287, tokenIndex1287
 						if buffer[position] != rune('?') {
-							goto l891
+							goto l1286
 						}
 						position++
 					}
-				l892:
-					goto l890
-				l891:
-					position, tokenIndex = position891, tokenIndex891
+				l1287:
+					goto l1285
+				l1286:
+					position, tokenIndex = position1286, tokenIndex1286
 				}
-				add(ruleYearRange, position885)
+				add(ruleYearRange, position1280)
 			}
 			return true
-		l884:
-			position, tokenIndex = position884, tokenIndex884
+		l1279:
+			position, tokenIndex = position1279, tokenIndex1279
 			return false
 		},
-		/* 101 YearWithDot <- <(YearNum '.')> */
+		/* 153 YearWithDot <- <(YearNum '.')> */
 		func() bool {
-			position919, tokenIndex919 := position, tokenIndex
+			position1314, tokenIndex1314 := position, tokenIndex
 			{
-				position920 := position
+				position1315 := position
 				if !_rules[ruleYearNum]() {
-					goto l919
+					goto l1314
 				}
 				if buffer[position] != rune('.') {
-					goto l919
+					goto l1314
 				}
 				position++
-				add(ruleYearWithDot, position920)
+				add(ruleYearWithDot, position1315)
 			}
 			return true
-		l919:
-			position, tokenIndex = position919, tokenIndex919
+		l1314:
+			position, tokenIndex = position1314, tokenIndex1314
 			return false
 		},
-		/* 102 YearApprox <- <('[' _? YearNum _? ']')> */
+		/* 154 YearApprox <- <('[' _? YearNum _? ']')> */
 		func() bool {
-			position921, tokenIndex921 := position, tokenIndex
+			position1316, tokenIndex1316 := position, tokenIndex
 			{
-				position922 := position
+				position1317 := position
 				if buffer[position] != rune('[') {
-					goto l921
+					goto l1316
 				}
 				position++
 				{
-					position923, tokenIndex923 := position, tokenIndex
+					position1318, tokenIndex1318 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l923
+						goto l1318
 					}
-					goto l924
-				l923:
-					position, tokenIndex = position923, tokenIndex923
+					goto l1319
+				l1318:
+					position, tokenIndex = position1318, tokenIndex1318
 				}
-			l924:
+			l1319:
 				if !_rules[ruleYearNum]() {
-					goto l921
+					goto l1316
 				}
 				{
-					position925, tokenIndex925 := position, tokenIndex
+					position1320, tokenIndex1320 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l925
+						goto l1320
 					}
-					goto l926
-				l925:
-					position, tokenIndex = position925, tokenIndex925
+					goto l1321
+				l1320:
+					position, tokenIndex = position1320, tokenIndex1320
 				}
-			l926:
+			l1321:
 				if buffer[position] != rune(']') {
-					goto l921
+					goto l1316
 				}
 				position++
-				add(ruleYearApprox, position922)
+				add(ruleYearApprox, position1317)
 			}
 			return true
-		l921:
-			position, tokenIndex = position921, tokenIndex921
+		l1316:
+			position, tokenIndex = position1316, tokenIndex1316
 			return false
 		},
-		/* 103 YearWithPage <- <((YearWithChar / YearNum) _? ':' _? Nums+)> */
+		/* 155 YearWithPage <- <((YearWithChar / YearNum) _? ':' _? Nums+)> */
 		func() bool {
-			position927, tokenIndex927 := position, tokenIndex
+			position1322, tokenIndex1322 := position, tokenIndex
 			{
-				position928 := position
+				position1323 := position
 				{
-					position929, tokenIndex929 := position, tokenIndex
+					position1324, tokenIndex1324 := position, tokenIndex
 					if !_rules[ruleYearWithChar]() {
-						goto l930
+						goto l1325
 					}
-					goto l929
-				l930:
-					position, tokenIndex = position929, tokenIndex929
+					goto l1324
+				l1325:
+					position, tokenIndex = position1324, tokenIndex1324
 					if !_rules[ruleYearNum]() {
-						goto l927
+						goto l1322
 					}
 				}
-			l929:
+			l1324:
 				{
-					position931, tokenIndex931 := position, tokenIndex
+					position1326, tokenIndex1326 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l931
+						goto l1326
 					}
-					goto l932
-				l931:
-					position, tokenIndex = position931, tokenIndex931
+					goto l1327
+				l1326:
+					position, tokenIndex = position1326, tokenIndex1326
 				}
-			l932:
+			l1327:
 				if buffer[position] != rune(':') {
-					goto l927
+					goto l1322
 				}
 				position++
 				{
-					position933, tokenIndex933 := position, tokenIndex
+					position1328, tokenIndex1328 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l933
+						goto l1328
 					}
-					goto l934
-				l933:
-					position, tokenIndex = position933, tokenIndex933
+					goto l1329
+				l1328:
+					position, tokenIndex = position1328, tokenIndex1328
 				}
-			l934:
+			l1329:
 				if !_rules[ruleNums]() {
-					goto l927
+					goto l1322
 				}
-			l935:
+			l1330:
 				{
-					position936, tokenIndex936 := position, tokenIndex
+					position1331, tokenIndex1331 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l936
+						goto l1331
 					}
-					goto l935
-				l936:
-					position, tokenIndex = position936, tokenIndex936
+					goto l1330
+				l1331:
+					position, tokenIndex = position1331, tokenIndex1331
 				}
-				add(ruleYearWithPage, position928)
+				add(ruleYearWithPage, position1323)
 			}
 			return true
-		l927:
-			position, tokenIndex = position927, tokenIndex927
+		l1322:
+			position, tokenIndex = position1322, tokenIndex1322
 			return false
 		},
-		/* 104 YearWithParens <- <('(' (YearWithChar / YearNum) ')')> */
+		/* 156 YearWithParens <- <('(' (YearWithChar / YearNum) ')')> */
 		func() bool {
-			position937, tokenIndex937 := position, tokenIndex
+			position1332, tokenIndex1332 := position, tokenIndex
 			{
-				position938 := position
+				position1333 := position
 				if buffer[position] != rune('(') {
-					goto l937
+					goto l1332
 				}
 				position++
 				{
-					position939, tokenIndex939 := position, tokenIndex
+					position1334, tokenIndex1334 := position, tokenIndex
 					if !_rules[ruleYearWithChar]() {
-						goto l940
+						goto l1335
 					}
-					goto l939
-				l940:
-					position, tokenIndex = position939, tokenIndex939
+					goto l1334
+				l1335:
+					position, tokenIndex = position1334, tokenIndex1334
 					if !_rules[ruleYearNum]() {
-						goto l937
+						goto l1332
 					}
 				}
-			l939:
+			l1334:
 				if buffer[position] != rune(')') {
-					goto l937
+					goto l1332
 				}
 				position++
-				add(ruleYearWithParens, position938)
+				add(ruleYearWithParens, position1333)
 			}
 			return true
-		l937:
-			position, tokenIndex = position937, tokenIndex937
+		l1332:
+			position, tokenIndex = position1332, tokenIndex1332
 			return false
 		},
-		/* 105 YearWithChar <- <(YearNum LowerASCII)> */
+		/* 157 YearWithChar <- <(YearNum LowerASCII)> */
 		func() bool {
-			position941, tokenIndex941 := position, tokenIndex
+			position1336, tokenIndex1336 := position, tokenIndex
 			{
-				position942 := position
+				position1337 := position
 				if !_rules[ruleYearNum]() {
-					goto l941
+					goto l1336
 				}
 				if !_rules[ruleLowerASCII]() {
-					goto l941
+					goto l1336
 				}
-				add(ruleYearWithChar, position942)
+				add(ruleYearWithChar, position1337)
 			}
 			return true
-		l941:
-			position, tokenIndex = position941, tokenIndex941
+		l1336:
+			position, tokenIndex = position1336, tokenIndex1336
 			return false
 		},
-		/* 106 YearNum <- <(('1' / '2') ('0' / '7' / '8' / '9') Nums (Nums / '?') '?'*)> */
+		/* 158 YearNum <- <(('1' / '2') ('0' / '7' / '8' / '9') Nums (Nums / '?') '?'*)> */
 		func() bool {
-			position943, tokenIndex943 := position, tokenIndex
+			position1338, tokenIndex1338 := position, tokenIndex
 			{
-				position944 := position
+				position1339 := position
 				{
-					position945, tokenIndex945 := position, tokenIndex
+					position1340, tokenIndex1340 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l946
+						goto l1341
 					}
 					position++
-					goto l945
-				l946:
-					position, tokenIndex = position945, tokenIndex945
+					goto l1340
+				l1341:
+					position, tokenIndex = position1340, tokenIndex1340
 					if buffer[position] != rune('2') {
-						goto l943
+						goto l1338
 					}
 					position++
 				}
-			l945:
+			l1340:
 				{
-					position947, tokenIndex947 := position, tokenIndex
+					position1342, tokenIndex1342 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l948
+						goto l1343
 					}
 					position++
-					goto l947
-				l948:
-					position, tokenIndex = position947, tokenIndex947
+					goto l1342
+				l1343:
+					position, tokenIndex = position1342, tokenIndex1342
 					if buffer[position] != rune('7') {
-						goto l949
+						goto l1344
 					}
 					position++
-					goto l947
-				l949:
-					position, tokenIndex = position947, tokenIndex947
+					goto l1342
+				l1344:
+					position, tokenIndex = position1342, tokenIndex1342
 					if buffer[position] != rune('8') {
-						goto l950
+						goto l1345
 					}
 					position++
-					goto l947
-				l950:
-					position, tokenIndex = position947, tokenIndex947
+					goto l1342
+				l1345:
+					position, tokenIndex = position1342, tokenIndex1342
 					if buffer[position] != rune('9') {
-						goto l943
+						goto l1338
 					}
 					position++
 				}
-			l947:
+			l1342:
 				if !_rules[ruleNums]() {
-					goto l943
+					goto l1338
 				}
 				{
-					position951, tokenIndex951 := position, tokenIndex
+					position1346, tokenIndex1346 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l952
+						goto l1347
 					}
-					goto l951
-				l952:
-					position, tokenIndex = position951, tokenIndex951
+					goto l1346
+				l1347:
+					position, tokenIndex = position1346, tokenIndex1346
 					if buffer[position] != rune('?') {
-						goto l943
+						goto l1338
 					}
 					position++
 				}
-			l951:
-			l953:
+			l1346:
+			l1348:
 				{
-					position954, tokenIndex954 := position, tokenIndex
+					position1349, tokenIndex1349 := position, tokenIndex
 					if buffer[position] != rune('?') {
-						goto l954
+						goto l1349
 					}
 					position++
-					goto l953
-				l954:
-					position, tokenIndex = position954, tokenIndex954
+					goto l1348
+				l1349:
+					position, tokenIndex = position1349, tokenIndex1349
 				}
-				add(ruleYearNum, position944)
+				add(ruleYearNum, position1339)
 			}
 			return true
-		l943:
-			position, tokenIndex = position943, tokenIndex943
+		l1338:
+			position, tokenIndex = position1338, tokenIndex1338
 			return false
 		},
-		/* 107 NameUpperChar <- <(UpperChar / UpperCharExtended)> */
+		/* 159 NameUpperChar <- <(UpperChar / UpperCharExtended)> */
 		func() bool {
-			position955, tokenIndex955 := position, tokenIndex
+			position1350, tokenIndex1350 := position, tokenIndex
 			{
-				position956 := position
+				position1351 := position
 				{
-					position957, tokenIndex957 := position, tokenIndex
+					position1352, tokenIndex1352 := position, tokenIndex
 					if !_rules[ruleUpperChar]() {
-						goto l958
+						goto l1353
 					}
-					goto l957
-				l958:
-					position, tokenIndex = position957, tokenIndex957
+					goto l1352
+				l1353:
+					position, tokenIndex = position1352, tokenIndex1352
 					if !_rules[ruleUpperCharExtended]() {
-						goto l955
+						goto l1350
 					}
 				}
-			l957:
-				add(ruleNameUpperChar, position956)
+			l1352:
+				add(ruleNameUpperChar, position1351)
 			}
 			return true
-		l955:
-			position, tokenIndex = position955, tokenIndex955
+		l1350:
+			position, tokenIndex = position1350, tokenIndex1350
 			return false
 		},
-		/* 108 UpperCharExtended <- <('Æ' / 'Œ' / 'Ö')> */
+		/* 160 UpperCharExtended <- <('Æ' / 'Œ' / 'Ö')> */
 		func() bool {
-			position959, tokenIndex959 := position, tokenIndex
+			position1354, tokenIndex1354 := position, tokenIndex
 			{
-				position960 := position
+				position1355 := position
 				{
-					position961, tokenIndex961 := position, tokenIndex
+					position1356, tokenIndex1356 := position, tokenIndex
 					if buffer[position] != rune('Æ') {
-						goto l962
+						goto l1357
 					}
 					position++
-					goto l961
-				l962:
-					position, tokenIndex = position961, tokenIndex961
+					goto l1356
+				l1357:
+					position, tokenIndex = position1356, tokenIndex1356
 					if buffer[position] != rune('Œ') {
-						goto l963
+						goto l1358
 					}
 					position++
-					goto l961
-				l963:
-					position, tokenIndex = position961, tokenIndex961
+					goto l1356
+				l1358:
+					position, tokenIndex = position1356, tokenIndex1356
 					if buffer[position] != rune('Ö') {
-						goto l959
+						goto l1354
 					}
 					position++
 				}
-			l961:
-				add(ruleUpperCharExtended, position960)
+			l1356:
+				add(ruleUpperCharExtended, position1355)
 			}
 			return true
-		l959:
-			position, tokenIndex = position959, tokenIndex959
+		l1354:
+			position, tokenIndex = position1354, tokenIndex1354
 			return false
 		},
-		/* 109 UpperChar <- <UpperASCII> */
+		/* 161 UpperChar <- <UpperASCII> */
 		func() bool {
-			position964, tokenIndex964 := position, tokenIndex
+			position1359, tokenIndex1359 := position, tokenIndex
 			{
-				position965 := position
+				position1360 := position
 				if !_rules[ruleUpperASCII]() {
-					goto l964
+					goto l1359
 				}
-				add(ruleUpperChar, position965)
+				add(ruleUpperChar, position1360)
 			}
 			return true
-		l964:
-			position, tokenIndex = position964, tokenIndex964
+		l1359:
+			position, tokenIndex = position1359, tokenIndex1359
 			return false
 		},
-		/* 110 NameLowerChar <- <(LowerChar / LowerCharExtended / MiscodedChar)> */
+		/* 162 NameLowerChar <- <(LowerChar / LowerCharExtended / MiscodedChar)> */
 		func() bool {
-			position966, tokenIndex966 := position, tokenIndex
+			position1361, tokenIndex1361 := position, tokenIndex
 			{
-				position967 := position
+				position1362 := position
 				{
-					position968, tokenIndex968 := position, tokenIndex
+					position1363, tokenIndex1363 := position, tokenIndex
 					if !_rules[ruleLowerChar]() {
-						goto l969
+						goto l1364
 					}
-					goto l968
-				l969:
-					position, tokenIndex = position968, tokenIndex968
+					goto l1363
+				l1364:
+					position, tokenIndex = position1363, tokenIndex1363
 					if !_rules[ruleLowerCharExtended]() {
-						goto l970
+						goto l1365
 					}
-					goto l968
-				l970:
-					position, tokenIndex = position968, tokenIndex968
+					goto l1363
+				l1365:
+					position, tokenIndex = position1363, tokenIndex1363
 					if !_rules[ruleMiscodedChar]() {
-						goto l966
+						goto l1361
 					}
 				}
-			l968:
-				add(ruleNameLowerChar, position967)
+			l1363:
+				add(ruleNameLowerChar, position1362)
 			}
 			return true
-		l966:
-			position, tokenIndex = position966, tokenIndex966
+		l1361:
+			position, tokenIndex = position1361, tokenIndex1361
 			return false
 		},
-		/* 111 MiscodedChar <- <'�'> */
+		/* 163 MiscodedChar <- <'�'> */
 		func() bool {
-			position971, tokenIndex971 := position, tokenIndex
+			position1366, tokenIndex1366 := position, tokenIndex
 			{
-				position972 := position
+				position1367 := position
 				if buffer[position] != rune('�') {
-					goto l971
+					goto l1366
 				}
 				position++
-				add(ruleMiscodedChar, position972)
+				add(ruleMiscodedChar, position1367)
 			}
 			return true
-		l971:
-			position, tokenIndex = position971, tokenIndex971
+		l1366:
+			position, tokenIndex = position1366, tokenIndex1366
 			return false
 		},
-		/* 112 LowerCharExtended <- <('æ' / 'œ' / 'à' / 'â' / 'å' / 'ã' / 'ä' / 'á' / 'ç' / 'č' / 'é' / 'è' / 'ë' / 'í' / 'ì' / 'ï' / 'ň' / 'ñ' / 'ñ' / 'ó' / 'ò' / 'ô' / 'ø' / 'õ' / 'ö' / 'ú' / 'ù' / 'ü' / 'ŕ' / 'ř' / 'ŗ' / 'ſ' / 'š' / 'š' / 'ş' / 'ß' / 'ž')> */
+		/* 164 LowerCharExtended <- <('æ' / 'œ' / 'à' / 'â' / 'å' / 'ã' / 'ä' / 'á' / 'ç' / 'č' / 'é' / 'è' / 'ë' / 'í' / 'ì' / 'ï' / 'ň' / 'ñ' / 'ñ' / 'ó' / 'ò' / 'ô' / 'ø' / 'õ' / 'ö' / 'ú' / 'ù' / 'ü' / 'ŕ' / 'ř' / 'ŗ' / 'ſ' / 'š' / 'š' / 'ş' / 'ß' / 'ž')> */
 		func() bool {
-			position973, tokenIndex973 := position, tokenIndex
+			position1368, tokenIndex1368 := position, tokenIndex
 			{
-				position974 := position
+				position1369 := position
 				{
-					position975, tokenIndex975 := position, tokenIndex
+					position1370, tokenIndex1370 := position, tokenIndex
 					if buffer[position] != rune('æ') {
-						goto l976
+						goto l1371
 					}
 					position++
-					goto l975
-				l976:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1371:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('œ') {
-						goto l977
+						goto l1372
 					}
 					position++
-					goto l975
-				l977:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1372:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('à') {
-						goto l978
+						goto l1373
 					}
 					position++
-					goto l975
-				l978:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1373:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('â') {
-						goto l979
+						goto l1374
 					}
 					position++
-					goto l975
-				l979:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1374:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('å') {
-						goto l980
+						goto l1375
 					}
 					position++
-					goto l975
-				l980:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1375:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ã') {
-						goto l981
+						goto l1376
 					}
 					position++
-					goto l975
-				l981:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1376:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ä') {
-						goto l982
+						goto l1377
 					}
 					position++
-					goto l975
-				l982:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1377:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('á') {
-						goto l983
+						goto l1378
 					}
 					position++
-					goto l975
-				l983:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1378:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ç') {
-						goto l984
+						goto l1379
 					}
 					position++
-					goto l975
-				l984:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1379:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('č') {
-						goto l985
+						goto l1380
 					}
 					position++
-					goto l975
-				l985:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1380:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('é') {
-						goto l986
+						goto l1381
 					}
 					position++
-					goto l975
-				l986:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1381:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('è') {
-						goto l987
+						goto l1382
 					}
 					position++
-					goto l975
-				l987:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1382:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ë') {
-						goto l988
+						goto l1383
 					}
 					position++
-					goto l975
-				l988:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1383:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('í') {
-						goto l989
+						goto l1384
 					}
 					position++
-					goto l975
-				l989:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1384:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ì') {
-						goto l990
+						goto l1385
 					}
 					position++
-					goto l975
-				l990:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1385:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ï') {
-						goto l991
+						goto l1386
 					}
 					position++
-					goto l975
-				l991:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1386:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ň') {
-						goto l992
+						goto l1387
 					}
 					position++
-					goto l975
-				l992:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1387:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ñ') {
-						goto l993
+						goto l1388
 					}
 					position++
-					goto l975
-				l993:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1388:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ñ') {
-						goto l994
+						goto l1389
 					}
 					position++
-					goto l975
-				l994:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1389:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ó') {
-						goto l995
+						goto l1390
 					}
 					position++
-					goto l975
-				l995:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1390:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ò') {
-						goto l996
+						goto l1391
 					}
 					position++
-					goto l975
-				l996:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1391:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ô') {
-						goto l997
+						goto l1392
 					}
 					position++
-					goto l975
-				l997:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1392:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ø') {
-						goto l998
+						goto l1393
 					}
 					position++
-					goto l975
-				l998:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1393:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('õ') {
-						goto l999
+						goto l1394
 					}
 					position++
-					goto l975
-				l999:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1394:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ö') {
-						goto l1000
+						goto l1395
 					}
 					position++
-					goto l975
-				l1000:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1395:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ú') {
-						goto l1001
+						goto l1396
 					}
 					position++
-					goto l975
-				l1001:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1396:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ù') {
-						goto l1002
+						goto l1397
 					}
 					position++
-					goto l975
-				l1002:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1397:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ü') {
-						goto l1003
+						goto l1398
 					}
 					position++
-					goto l975
-				l1003:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1398:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ŕ') {
-						goto l1004
+						goto l1399
 					}
 					position++
-					goto l975
-				l1004:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1399:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ř') {
-						goto l1005
+						goto l1400
 					}
 					position++
-					goto l975
-				l1005:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1400:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ŗ') {
-						goto l1006
+						goto l1401
 					}
 					position++
-					goto l975
-				l1006:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1401:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ſ') {
-						goto l1007
+						goto l1402
 					}
 					position++
-					goto l975
-				l1007:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1402:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('š') {
-						goto l1008
+						goto l1403
 					}
 					position++
-					goto l975
-				l1008:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1403:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('š') {
-						goto l1009
+						goto l1404
 					}
 					position++
-					goto l975
-				l1009:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1404:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ş') {
-						goto l1010
+						goto l1405
 					}
 					position++
-					goto l975
-				l1010:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1405:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ß') {
-						goto l1011
+						goto l1406
 					}
 					position++
-					goto l975
-				l1011:
-					position, tokenIndex = position975, tokenIndex975
+					goto l1370
+				l1406:
+					position, tokenIndex = position1370, tokenIndex1370
 					if buffer[position] != rune('ž') {
-						goto l973
+						goto l1368
 					}
 					position++
 				}
-			l975:
-				add(ruleLowerCharExtended, position974)
+			l1370:
+				add(ruleLowerCharExtended, position1369)
 			}
 			return true
-		l973:
-			position, tokenIndex = position973, tokenIndex973
+		l1368:
+			position, tokenIndex = position1368, tokenIndex1368
 			return false
 		},
-		/* 113 LowerChar <- <LowerASCII> */
+		/* 165 LowerChar <- <LowerASCII> */
 		func() bool {
-			position1012, tokenIndex1012 := position, tokenIndex
+			position1407, tokenIndex1407 := position, tokenIndex
 			{
-				position1013 := position
+				position1408 := position
 				if !_rules[ruleLowerASCII]() {
-					goto l1012
+					goto l1407
 				}
-				add(ruleLowerChar, position1013)
+				add(ruleLowerChar, position1408)
 			}
 			return true
-		l1012:
-			position, tokenIndex = position1012, tokenIndex1012
+		l1407:
+			position, tokenIndex = position1407, tokenIndex1407
 			return false
 		},
-		/* 114 SpaceCharEOI <- <(_ / !.)> */
+		/* 166 SpaceCharEOI <- <(_ / !.)> */
 		func() bool {
-			position1014, tokenIndex1014 := position, tokenIndex
+			position1409, tokenIndex1409 := position, tokenIndex
 			{
-				position1015 := position
+				position1410 := position
 				{
-					position1016, tokenIndex1016 := position, tokenIndex
+					position1411, tokenIndex1411 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l1017
+						goto l1412
 					}
-					goto l1016
-				l1017:
-					position, tokenIndex = position1016, tokenIndex1016
+					goto l1411
+				l1412:
+					position, tokenIndex = position1411, tokenIndex1411
 					{
-						position1018, tokenIndex1018 := position, tokenIndex
+						position1413, tokenIndex1413 := position, tokenIndex
 						if !matchDot() {
-							goto l1018
+							goto l1413
 						}
-						goto l1014
-					l1018:
-						position, tokenIndex = position1018, tokenIndex1018
+						goto l1409
+					l1413:
+						position, tokenIndex = position1413, tokenIndex1413
 					}
 				}
-			l1016:
-				add(ruleSpaceCharEOI, position1015)
+			l1411:
+				add(ruleSpaceCharEOI, position1410)
 			}
 			return true
-		l1014:
-			position, tokenIndex = position1014, tokenIndex1014
+		l1409:
+			position, tokenIndex = position1409, tokenIndex1409
 			return false
 		},
-		/* 115 Nums <- <[0-9]> */
+		/* 167 Nums <- <[0-9]> */
 		func() bool {
-			position1019, tokenIndex1019 := position, tokenIndex
+			position1414, tokenIndex1414 := position, tokenIndex
 			{
-				position1020 := position
+				position1415 := position
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l1019
+					goto l1414
 				}
 				position++
-				add(ruleNums, position1020)
+				add(ruleNums, position1415)
 			}
 			return true
-		l1019:
-			position, tokenIndex = position1019, tokenIndex1019
+		l1414:
+			position, tokenIndex = position1414, tokenIndex1414
 			return false
 		},
-		/* 116 LowerGreek <- <[α-ω]> */
+		/* 168 LowerGreek <- <[α-ω]> */
 		func() bool {
-			position1021, tokenIndex1021 := position, tokenIndex
+			position1416, tokenIndex1416 := position, tokenIndex
 			{
-				position1022 := position
+				position1417 := position
 				if c := buffer[position]; c < rune('α') || c > rune('ω') {
-					goto l1021
+					goto l1416
 				}
 				position++
-				add(ruleLowerGreek, position1022)
+				add(ruleLowerGreek, position1417)
 			}
 			return true
-		l1021:
-			position, tokenIndex = position1021, tokenIndex1021
+		l1416:
+			position, tokenIndex = position1416, tokenIndex1416
 			return false
 		},
-		/* 117 LowerASCII <- <[a-z]> */
+		/* 169 LowerASCII <- <[a-z]> */
 		func() bool {
-			position1023, tokenIndex1023 := position, tokenIndex
+			position1418, tokenIndex1418 := position, tokenIndex
 			{
-				position1024 := position
+				position1419 := position
 				if c := buffer[position]; c < rune('a') || c > rune('z') {
-					goto l1023
+					goto l1418
 				}
 				position++
-				add(ruleLowerASCII, position1024)
+				add(ruleLowerASCII, position1419)
 			}
 			return true
-		l1023:
-			position, tokenIndex = position1023, tokenIndex1023
+		l1418:
+			position, tokenIndex = position1418, tokenIndex1418
 			return false
 		},
-		/* 118 UpperASCII <- <[A-Z]> */
+		/* 170 UpperASCII <- <[A-Z]> */
 		func() bool {
-			position1025, tokenIndex1025 := position, tokenIndex
+			position1420, tokenIndex1420 := position, tokenIndex
 			{
-				position1026 := position
+				position1421 := position
 				if c := buffer[position]; c < rune('A') || c > rune('Z') {
-					goto l1025
+					goto l1420
 				}
 				position++
-				add(ruleUpperASCII, position1026)
+				add(ruleUpperASCII, position1421)
 			}
 			return true
-		l1025:
-			position, tokenIndex = position1025, tokenIndex1025
+		l1420:
+			position, tokenIndex = position1420, tokenIndex1420
 			return false
 		},
-		/* 119 Apostrophe <- <(ApostrOther / ApostrASCII)> */
+		/* 171 Apostrophe <- <(ApostrOther / ApostrASCII)> */
 		func() bool {
-			position1027, tokenIndex1027 := position, tokenIndex
+			position1422, tokenIndex1422 := position, tokenIndex
 			{
-				position1028 := position
+				position1423 := position
 				{
-					position1029, tokenIndex1029 := position, tokenIndex
+					position1424, tokenIndex1424 := position, tokenIndex
 					if !_rules[ruleApostrOther]() {
-						goto l1030
+						goto l1425
 					}
-					goto l1029
-				l1030:
-					position, tokenIndex = position1029, tokenIndex1029
+					goto l1424
+				l1425:
+					position, tokenIndex = position1424, tokenIndex1424
 					if !_rules[ruleApostrASCII]() {
-						goto l1027
+						goto l1422
 					}
 				}
-			l1029:
-				add(ruleApostrophe, position1028)
+			l1424:
+				add(ruleApostrophe, position1423)
 			}
 			return true
-		l1027:
-			position, tokenIndex = position1027, tokenIndex1027
+		l1422:
+			position, tokenIndex = position1422, tokenIndex1422
 			return false
 		},
-		/* 120 ApostrASCII <- <'\''> */
+		/* 172 ApostrASCII <- <'\''> */
 		func() bool {
-			position1031, tokenIndex1031 := position, tokenIndex
+			position1426, tokenIndex1426 := position, tokenIndex
 			{
-				position1032 := position
+				position1427 := position
 				if buffer[position] != rune('\'') {
-					goto l1031
+					goto l1426
 				}
 				position++
-				add(ruleApostrASCII, position1032)
+				add(ruleApostrASCII, position1427)
 			}
 			return true
-		l1031:
-			position, tokenIndex = position1031, tokenIndex1031
+		l1426:
+			position, tokenIndex = position1426, tokenIndex1426
 			return false
 		},
-		/* 121 ApostrOther <- <('‘' / '’')> */
+		/* 173 ApostrOther <- <('‘' / '’')> */
 		func() bool {
-			position1033, tokenIndex1033 := position, tokenIndex
+			position1428, tokenIndex1428 := position, tokenIndex
 			{
-				position1034 := position
+				position1429 := position
 				{
-					position1035, tokenIndex1035 := position, tokenIndex
+					position1430, tokenIndex1430 := position, tokenIndex
 					if buffer[position] != rune('‘') {
-						goto l1036
+						goto l1431
 					}
 					position++
-					goto l1035
-				l1036:
-					position, tokenIndex = position1035, tokenIndex1035
+					goto l1430
+				l1431:
+					position, tokenIndex = position1430, tokenIndex1430
 					if buffer[position] != rune('’') {
-						goto l1033
+						goto l1428
 					}
 					position++
 				}
-			l1035:
-				add(ruleApostrOther, position1034)
+			l1430:
+				add(ruleApostrOther, position1429)
 			}
 			return true
-		l1033:
-			position, tokenIndex = position1033, tokenIndex1033
+		l1428:
+			position, tokenIndex = position1428, tokenIndex1428
 			return false
 		},
-		/* 122 Dash <- <'-'> */
+		/* 174 Dash <- <'-'> */
 		func() bool {
-			position1037, tokenIndex1037 := position, tokenIndex
+			position1432, tokenIndex1432 := position, tokenIndex
 			{
-				position1038 := position
+				position1433 := position
 				if buffer[position] != rune('-') {
-					goto l1037
+					goto l1432
 				}
 				position++
-				add(ruleDash, position1038)
+				add(ruleDash, position1433)
 			}
 			return true
-		l1037:
-			position, tokenIndex = position1037, tokenIndex1037
+		l1432:
+			position, tokenIndex = position1432, tokenIndex1432
 			return false
 		},
-		/* 123 Slash <- <'/'> */
+		/* 175 Slash <- <'/'> */
 		func() bool {
-			position1039, tokenIndex1039 := position, tokenIndex
+			position1434, tokenIndex1434 := position, tokenIndex
 			{
-				position1040 := position
+				position1435 := position
 				if buffer[position] != rune('/') {
-					goto l1039
+					goto l1434
 				}
 				position++
-				add(ruleSlash, position1040)
+				add(ruleSlash, position1435)
 			}
 			return true
-		l1039:
-			position, tokenIndex = position1039, tokenIndex1039
+		l1434:
+			position, tokenIndex = position1434, tokenIndex1434
 			return false
 		},
-		/* 124 _ <- <(MultipleSpace / SingleSpace)> */
+		/* 176 _ <- <(MultipleSpace / SingleSpace)> */
 		func() bool {
-			position1041, tokenIndex1041 := position, tokenIndex
+			position1436, tokenIndex1436 := position, tokenIndex
 			{
-				position1042 := position
+				position1437 := position
 				{
-					position1043, tokenIndex1043 := position, tokenIndex
+					position1438, tokenIndex1438 := position, tokenIndex
 					if !_rules[ruleMultipleSpace]() {
-						goto l1044
+						goto l1439
 					}
-					goto l1043
-				l1044:
-					position, tokenIndex = position1043, tokenIndex1043
+					goto l1438
+				l1439:
+					position, tokenIndex = position1438, tokenIndex1438
 					if !_rules[ruleSingleSpace]() {
-						goto l1041
+						goto l1436
 					}
 				}
-			l1043:
-				add(rule_, position1042)
+			l1438:
+				add(rule_, position1437)
 			}
 			return true
-		l1041:
-			position, tokenIndex = position1041, tokenIndex1041
+		l1436:
+			position, tokenIndex = position1436, tokenIndex1436
 			return false
 		},
-		/* 125 MultipleSpace <- <(SingleSpace SingleSpace+)> */
+		/* 177 MultipleSpace <- <(SingleSpace SingleSpace+)> */
 		func() bool {
-			position1045, tokenIndex1045 := position, tokenIndex
+			position1440, tokenIndex1440 := position, tokenIndex
 			{
-				position1046 := position
+				position1441 := position
 				if !_rules[ruleSingleSpace]() {
-					goto l1045
+					goto l1440
 				}
 				if !_rules[ruleSingleSpace]() {
-					goto l1045
+					goto l1440
 				}
-			l1047:
+			l1442:
 				{
-					position1048, tokenIndex1048 := position, tokenIndex
+					position1443, tokenIndex1443 := position, tokenIndex
 					if !_rules[ruleSingleSpace]() {
-						goto l1048
+						goto l1443
 					}
-					goto l1047
-				l1048:
-					position, tokenIndex = position1048, tokenIndex1048
+					goto l1442
+				l1443:
+					position, tokenIndex = position1443, tokenIndex1443
 				}
-				add(ruleMultipleSpace, position1046)
+				add(ruleMultipleSpace, position1441)
 			}
 			return true
-		l1045:
-			position, tokenIndex = position1045, tokenIndex1045
+		l1440:
+			position, tokenIndex = position1440, tokenIndex1440
 			return false
 		},
-		/* 126 SingleSpace <- <(' ' / OtherSpace)> */
+		/* 178 SingleSpace <- <(' ' / OtherSpace)> */
 		func() bool {
-			position1049, tokenIndex1049 := position, tokenIndex
+			position1444, tokenIndex1444 := position, tokenIndex
 			{
-				position1050 := position
+				position1445 := position
 				{
-					position1051, tokenIndex1051 := position, tokenIndex
+					position1446, tokenIndex1446 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l1052
+						goto l1447
 					}
 					position++
-					goto l1051
-				l1052:
-					position, tokenIndex = position1051, tokenIndex1051
+					goto l1446
+				l1447:
+					position, tokenIndex = position1446, tokenIndex1446
 					if !_rules[ruleOtherSpace]() {
-						goto l1049
+						goto l1444
 					}
 				}
-			l1051:
-				add(ruleSingleSpace, position1050)
+			l1446:
+				add(ruleSingleSpace, position1445)
 			}
 			return true
-		l1049:
-			position, tokenIndex = position1049, tokenIndex1049
+		l1444:
+			position, tokenIndex = position1444, tokenIndex1444
 			return false
 		},
-		/* 127 OtherSpace <- <('\u3000' / '\u00a0' / '\t' / '\r' / '\n' / '\f' / '\v')> */
+		/* 179 OtherSpace <- <('\u3000' / '\u00a0' / '\t' / '\r' / '\n' / '\f' / '\v')> */
 		func() bool {
-			position1053, tokenIndex1053 := position, tokenIndex
+			position1448, tokenIndex1448 := position, tokenIndex
 			{
-				position1054 := position
+				position1449 := position
 				{
-					position1055, tokenIndex1055 := position, tokenIndex
+					position1450, tokenIndex1450 := position, tokenIndex
 					if buffer[position] != rune('\u3000') {
-						goto l1056
+						goto l1451
 					}
 					position++
-					goto l1055
-				l1056:
-					position, tokenIndex = position1055, tokenIndex1055
+					goto l1450
+				l1451:
+					position, tokenIndex = position1450, tokenIndex1450
 					if buffer[position] != rune('\u00a0') {
-						goto l1057
+						goto l1452
 					}
 					position++
-					goto l1055
-				l1057:
-					position, tokenIndex = position1055, tokenIndex1055
+					goto l1450
+				l1452:
+					position, tokenIndex = position1450, tokenIndex1450
 					if buffer[position] != rune('\t') {
-						goto l1058
+						goto l1453
 					}
 					position++
-					goto l1055
-				l1058:
-					position, tokenIndex = position1055, tokenIndex1055
+					goto l1450
+				l1453:
+					position, tokenIndex = position1450, tokenIndex1450
 					if buffer[position] != rune('\r') {
-						goto l1059
+						goto l1454
 					}
 					position++
-					goto l1055
-				l1059:
-					position, tokenIndex = position1055, tokenIndex1055
+					goto l1450
+				l1454:
+					position, tokenIndex = position1450, tokenIndex1450
 					if buffer[position] != rune('\n') {
-						goto l1060
+						goto l1455
 					}
 					position++
-					goto l1055
-				l1060:
-					position, tokenIndex = position1055, tokenIndex1055
+					goto l1450
+				l1455:
+					position, tokenIndex = position1450, tokenIndex1450
 					if buffer[position] != rune('\f') {
-						goto l1061
+						goto l1456
 					}
 					position++
-					goto l1055
-				l1061:
-					position, tokenIndex = position1055, tokenIndex1055
+					goto l1450
+				l1456:
+					position, tokenIndex = position1450, tokenIndex1450
 					if buffer[position] != rune('\v') {
-						goto l1053
+						goto l1448
 					}
 					position++
 				}
-			l1055:
-				add(ruleOtherSpace, position1054)
+			l1450:
+				add(ruleOtherSpace, position1449)
 			}
 			return true
-		l1053:
-			position, tokenIndex = position1053, tokenIndex1053
+		l1448:
+			position, tokenIndex = position1448, tokenIndex1448
 			return false
 		},
-		/* 128 END <- <!.> */
+		/* 180 END <- <!.> */
 		func() bool {
-			position1062, tokenIndex1062 := position, tokenIndex
+			position1457, tokenIndex1457 := position, tokenIndex
 			{
-				position1063 := position
+				position1458 := position
 				{
-					position1064, tokenIndex1064 := position, tokenIndex
+					position1459, tokenIndex1459 := position, tokenIndex
 					if !matchDot() {
-						goto l1064
+						goto l1459
 					}
-					goto l1062
-				l1064:
-					position, tokenIndex = position1064, tokenIndex1064
+					goto l1457
+				l1459:
+					position, tokenIndex = position1459, tokenIndex1459
 				}
-				add(ruleEND, position1063)
+				add(ruleEND, position1458)
 			}
 			return true
-		l1062:
-			position, tokenIndex = position1062, tokenIndex1062
+		l1457:
+			position, tokenIndex = position1457, tokenIndex1457
 			return false
 		},
 	}
@@ -10,6 +10,10 @@ type Parser interface {
 	// PreprocessAndParse takes a scientific name and returns back Abstract
 	// Syntax Tree of the name-string.
 	PreprocessAndParse(name, version string, keepHTML bool) ScientificNameNode
+	// Explain parses a name-string and returns a description of the
+	// preprocessing, PEG rules tree and sources of warnings together with
+	// the parsing result.
+	Explain(name, version string, keepHTML bool) Explanation
 }
 
 // ScientificNameNode is the Abstract Syntax Tree of a name-string.
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/gnames/gnparser/ent/internal/str"
 	"github.com/gnames/gnparser/ent/parsed"
@@ -11,6 +12,8 @@ import (
 type canonical struct {
 	Value       string
 	ValueRanked string
+	// Stemmed is set only if the stemmed form cannot be made from Value.
+	Stemmed string
 }
 
 func appendCanonical(c1 *canonical, c2 *canonical, sep string) *canonical {
@@ -24,7 +27,28 @@ func appendCanonical(c1 *canonical, c2 *canonical, sep string) *canonical {
 // contains the value of the word, its semantic meaning and its
 // position in the string.
 func (sn *scientificNameNode) Words() []parsed.Word {
-	return sn.nameData.words()
+	var words []parsed.Word
+	if sn.candidatus != nil {
+		wrd := sn.candidatus.Pos
+		wrd.Verbatim = sn.candidatus.Value
+		wrd.Normalized = sn.candidatus.NormValue
+		words = append(words, wrd)
+	}
+	words = append(words, sn.nameData.words()...)
+	words = append(words, sn.bacterialInfo.words()...)
+	if len(sn.extinct) == 0 {
+		return words
+	}
+	for _, v := range sn.extinct {
+		wrd := v.Pos
+		wrd.Verbatim = v.Value
+		wrd.Normalized = v.NormValue
+		words = append(words, wrd)
+	}
+	sort.SliceStable(words, func(i, j int) bool {
+		return words[i].Start < words[j].Start
+	})
+	return words
 }
 
 // Normalized returns a normalized version of a scientific name.
@@ -32,7 +56,12 @@ func (sn *scientificNameNode) Normalized() string {
 	if sn.nameData == nil {
 		return ""
 	}
-	return sn.nameData.value()
+	var res string
+	if sn.candidatus != nil {
+		res = sn.candidatus.NormValue
+	}
+	res = str.JoinStrings(res, sn.nameData.value(), " ")
+	return str.JoinStrings(res, sn.bacterialInfo.value(), " ")
 }
 
 // Canonical returns canonical forms of scientific name. There are
@@ -44,10 +73,18 @@ func (sn *scientificNameNode) Canonical() *parsed.Canonical {
 		return res
 	}
 	c := sn.nameData.canonical()
+	stemmed := c.Stemmed
+	if stemmed == "" {
+		stemmed = stemmer.StemCanonical(c.Value)
+	}
+	full := c.ValueRanked
+	if sn.candidatus != nil {
+		full = sn.candidatus.NormValue + " " + full
+	}
 	return &parsed.Canonical{
-		Stemmed: stemmer.StemCanonical(c.Value),
+		Stemmed: stemmed,
 		Simple:  c.Value,
-		Full:    c.ValueRanked,
+		Full:    full,
 	}
 }
 
@@ -60,6 +97,36 @@ func (sn *scientificNameNode) Details() parsed.Details {
 	return sn.nameData.details()
 }
 
+// BacterialDetails returns Candidatus status, designations and strains
+// of a bacterial name. It returns nil if a name has none of them.
+func (sn *scientificNameNode) BacterialDetails() *parsed.BacterialDetails {
+	var res *parsed.BacterialDetails
+	if sn.candidatus == nil && sn.bacterialInfo == nil {
+		return res
+	}
+	res = &parsed.BacterialDetails{Candidatus: sn.candidatus != nil}
+	if sn.bacterialInfo == nil {
+		return res
+	}
+	for _, v := range sn.bacterialInfo.Elements {
+		if v.Rank != nil {
+			d := parsed.Designation{
+				Rank:  v.Rank.NormValue,
+				Value: v.Value.NormValue,
+			}
+			res.Designations = append(res.Designations, d)
+			continue
+		}
+		s := parsed.Strain{
+			Value:      v.StrainID,
+			Collection: v.Collection,
+			TypeStrain: v.TypeStrain,
+		}
+		res.Strains = append(res.Strains, s)
+	}
+	return res
+}
+
 // LastAuthorship returns the authorshop of the smallest element of a name.
 // For example for a variation, it returns the authors of the variation, and
 // ignores authors of genus, species etc.
@@ -80,6 +147,41 @@ func (sn *scientificNameNode) LastAuthorship(withDetails bool) *parsed.Authorshi
 	return res
 }
 
+func (bi *bacterialInfoNode) words() []parsed.Word {
+	var words []parsed.Word
+	if bi == nil {
+		return words
+	}
+	for _, v := range bi.Elements {
+		ws := []*wordNode{v.Value}
+		if v.Rank != nil {
+			ws = []*wordNode{v.Rank, v.Value}
+		}
+		for _, w := range ws {
+			wrd := w.Pos
+			wrd.Verbatim = w.Value
+			wrd.Normalized = w.NormValue
+			words = append(words, wrd)
+		}
+	}
+	return words
+}
+
+func (bi *bacterialInfoNode) value() string {
+	if bi == nil {
+		return ""
+	}
+	var res string
+	for _, v := range bi.Elements {
+		res += v.Sep
+		if v.Rank != nil {
+			res += v.Rank.NormValue + " "
+		}
+		res += v.Value.NormValue
+	}
+	return res
+}
+
 func (nf *hybridFormulaNode) words() []parsed.Word {
 	words := nf.FirstSpecies.words()
 	for _, v := range nf.HybridElements {
@@ -99,6 +201,9 @@ func (nf *hybridFormulaNode) value() string {
 			val = str.JoinStrings(val, v.Species.value(), " ")
 		}
 	}
+	if nf.Parens {
+		val = "(" + val + ")"
+	}
 	return val
 }
 
@@ -115,6 +220,10 @@ func (nf *hybridFormulaNode) canonical() *canonical {
 			c = appendCanonical(c, sc, " ")
 		}
 	}
+	if nf.Parens {
+		c.Value = "(" + c.Value + ")"
+		c.ValueRanked = "(" + c.ValueRanked + ")"
+	}
 	return c
 }
 
@@ -142,14 +251,14 @@ func (nh *namedGenusHybridNode) words() []parsed.Word {
 
 func (nh *namedGenusHybridNode) value() string {
 	v := nh.nameData.value()
-	v = "× " + v
+	v = nh.Hybrid.NormValue + " " + v
 	return v
 }
 
 func (nh *namedGenusHybridNode) canonical() *canonical {
 	c := &canonical{
 		Value:       "",
-		ValueRanked: "×",
+		ValueRanked: nh.Hybrid.NormValue,
 	}
 
 	c1 := nh.nameData.canonical()
@@ -396,6 +505,196 @@ func (comp *comparisonNode) details() parsed.Details {
 	return parsed.DetailsComparison{Comparison: co}
 }
 
+func (on *openNomenNode) words() []parsed.Word {
+	var words []parsed.Word
+	var wrd parsed.Word
+	if on == nil {
+		return nil
+	}
+	wrd = on.Genus.Pos
+	wrd.Verbatim = on.Genus.Value
+	wrd.Normalized = on.Genus.NormValue
+	words = []parsed.Word{wrd}
+	qual := on.Qualifier.Pos
+	qual.Verbatim = on.Qualifier.Value
+	qual.Normalized = on.Qualifier.NormValue
+	if on.qualifiesSpecies() {
+		words = append(words, qual)
+		return append(words, on.SpEpithet.words()...)
+	}
+	if on.SpEpithet != nil {
+		words = append(words, on.SpEpithet.words()...)
+	}
+	inf := on.Infraspecies
+	if inf != nil && inf.Rank != nil {
+		wrd = inf.Rank.Word.Pos
+		wrd.Verbatim = inf.Rank.Word.Value
+		wrd.Normalized = inf.Rank.Word.NormValue
+		words = append(words, wrd)
+	}
+	words = append(words, qual)
+	if inf != nil {
+		wrd = inf.Word.Pos
+		wrd.Verbatim = inf.Word.Value
+		wrd.Normalized = inf.Word.NormValue
+		words = append(words, wrd)
+		words = append(words, inf.Authorship.words()...)
+	}
+	return words
+}
+
+func (on *openNomenNode) value() string {
+	if on == nil {
+		return ""
+	}
+	val := on.Genus.NormValue
+	if on.qualifiesSpecies() {
+		val = str.JoinStrings(val, on.Qualifier.NormValue, " ")
+		return str.JoinStrings(val, on.SpEpithet.value(), " ")
+	}
+	if on.SpEpithet != nil {
+		val = str.JoinStrings(val, on.SpEpithet.value(), " ")
+	}
+	inf := on.Infraspecies
+	if inf == nil {
+		return str.JoinStrings(val, on.Qualifier.NormValue, " ")
+	}
+	if inf.Rank != nil {
+		val = str.JoinStrings(val, inf.Rank.Word.NormValue, " ")
+	}
+	val = str.JoinStrings(val, on.Qualifier.NormValue, " ")
+	val = str.JoinStrings(val, inf.Word.NormValue, " ")
+	return str.JoinStrings(val, inf.Authorship.value(), " ")
+}
+
+// qualifiesSpecies is true if the qualifier precedes a specific epithet.
+func (on *openNomenNode) qualifiesSpecies() bool {
+	return on.SpEpithet != nil && on.Infraspecies == nil &&
+		on.Qualifier.Pos.Start < on.SpEpithet.Word.Pos.Start
+}
+
+// canonical of an open nomenclature name contains only its determined
+// elements. Qualified epithets are determined, and are kept with any
+// qualifier.
+func (on *openNomenNode) canonical() *canonical {
+	if on == nil {
+		return &canonical{}
+	}
+	gen := on.Genus.NormValue
+	c := &canonical{Value: gen, ValueRanked: gen}
+	if on.SpEpithet != nil {
+		c = appendCanonical(c, on.SpEpithet.canonical(), " ")
+	}
+	if on.Infraspecies != nil {
+		c = appendCanonical(c, on.Infraspecies.canonical(), " ")
+	}
+	return c
+}
+
+func (on *openNomenNode) lastAuthorship() *authorshipNode {
+	var au *authorshipNode
+	if on == nil {
+		return au
+	}
+	if on.Infraspecies != nil && on.Infraspecies.Authorship != nil {
+		return on.Infraspecies.Authorship
+	}
+	if on.SpEpithet == nil {
+		return au
+	}
+	return on.SpEpithet.Authorship
+}
+
+func (on *openNomenNode) details() parsed.Details {
+	if on == nil {
+		return nil
+	}
+	ono := parsed.OpenNomenclature{
+		Genus:         on.Genus.NormValue,
+		Qualifier:     on.Qualifier.NormValue,
+		QualifierType: on.QualifierType,
+		Position:      on.Position,
+	}
+	if on.SpEpithet != nil {
+		ono.Species = on.SpEpithet.Word.NormValue
+		if on.SpEpithet.Authorship != nil {
+			ono.SpeciesAuthorship = on.SpEpithet.Authorship.details()
+		}
+	}
+	if on.Infraspecies != nil {
+		inf := on.Infraspecies.details()
+		ono.Infraspecies = &inf
+	}
+	return parsed.DetailsOpenNomenclature{OpenNomenclature: ono}
+}
+
+func (pn *phraseNode) words() []parsed.Word {
+	if pn == nil {
+		return nil
+	}
+	ws := []*wordNode{pn.Genus, pn.Rank, pn.Phrase, pn.Collector, pn.Voucher}
+	if pn.Herbarium != nil {
+		ws = append(ws, pn.Herbarium)
+	}
+	words := make([]parsed.Word, len(ws))
+	for i, v := range ws {
+		words[i] = v.Pos
+		words[i].Verbatim = v.Value
+		words[i].Normalized = v.NormValue
+	}
+	return words
+}
+
+func (pn *phraseNode) value() string {
+	if pn == nil {
+		return ""
+	}
+	val := pn.canonical().ValueRanked
+	if pn.Herbarium != nil {
+		val = str.JoinStrings(val, pn.Herbarium.NormValue, " ")
+	}
+	return val
+}
+
+// canonical of a phrase name keeps the phrase, so different phrase names of
+// the same genus are not merged. The full form contains the voucher as well.
+// The phrase is not latin, so it is not stemmed.
+func (pn *phraseNode) canonical() *canonical {
+	if pn == nil {
+		return &canonical{}
+	}
+	val := str.JoinStrings(pn.Genus.NormValue, pn.Rank.NormValue, " ")
+	val = str.JoinStrings(val, pn.Phrase.NormValue, " ")
+	voucher := "(" + pn.Collector.NormValue + " " + pn.Voucher.NormValue + ")"
+	return &canonical{
+		Value:       val,
+		ValueRanked: str.JoinStrings(val, voucher, " "),
+		Stemmed:     val,
+	}
+}
+
+func (pn *phraseNode) lastAuthorship() *authorshipNode {
+	var au *authorshipNode
+	return au
+}
+
+func (pn *phraseNode) details() parsed.Details {
+	if pn == nil {
+		return nil
+	}
+	po := parsed.PhraseName{
+		Genus:     pn.Genus.NormValue,
+		Rank:      pn.Rank.NormValue,
+		Phrase:    pn.Phrase.NormValue,
+		Collector: pn.Collector.NormValue,
+		Voucher:   pn.Voucher.NormValue,
+	}
+	if pn.Herbarium != nil {
+		po.Herbarium = pn.Herbarium.NormValue
+	}
+	return parsed.DetailsPhraseName{PhraseName: po}
+}
+
 func (sp *speciesNode) words() []parsed.Word {
 	var words []parsed.Word
 	var wrd parsed.Word
@@ -451,8 +750,10 @@ func (sp *speciesNode) lastAuthorship() *authorshipNode {
 
 func (sp *speciesNode) details() parsed.Details {
 	so := parsed.Species{
-		Genus:   sp.Genus.NormValue,
-		Species: sp.SpEpithet.Word.NormValue,
+		Genus:            sp.Genus.NormValue,
+		Species:          sp.SpEpithet.Word.NormValue,
+		GenusUncertain:   sp.Genus.Pos.Uncertain,
+		SpeciesUncertain: sp.SpEpithet.Word.Pos.Uncertain,
 	}
 	if sp.SpEpithet.Authorship != nil {
 		so.Authorship = sp.SpEpithet.Authorship.details()
@@ -553,6 +854,7 @@ func (inf *infraspEpithetNode) details() parsed.InfraspeciesElem {
 		Value:      inf.Word.NormValue,
 		Rank:       rank,
 		Authorship: inf.Authorship.details(),
+		Uncertain:  inf.Word.Pos.Uncertain,
 	}
 	return res
 }
@@ -580,7 +882,10 @@ func (u *uninomialNode) lastAuthorship() *authorshipNode {
 }
 
 func (u *uninomialNode) details() parsed.Details {
-	ud := parsed.Uninomial{Value: u.Word.NormValue}
+	ud := parsed.Uninomial{
+		Value:     u.Word.NormValue,
+		Uncertain: u.Word.Pos.Uncertain,
+	}
 	if u.Authorship != nil {
 		ud.Authorship = u.Authorship.details()
 	}
@@ -656,10 +961,18 @@ func (au *authorshipNode) details() *parsed.Authorship {
 		ao.Combination = authGroupDetail(au.CombinationAuthors)
 	}
 	yr := ""
-	if ao.Original != nil && ao.Original.Year != nil {
-		yr = ao.Original.Year.Value
-		if ao.Original.Year.IsApproximate {
-			yr = fmt.Sprintf("(%s)", yr)
+	if ao.Original != nil {
+		oy := ao.Original.Year
+		// the year after "in" authors is the year of the name, it stays
+		// in InAuthors details.
+		if oy == nil && ao.Original.InAuthors != nil {
+			oy = ao.Original.InAuthors.Year
+		}
+		if oy != nil {
+			yr = oy.Value
+			if oy.IsApproximate {
+				yr = fmt.Sprintf("(%s)", yr)
+			}
 		}
 	}
 	var aus []string
@@ -671,6 +984,7 @@ func (au *authorshipNode) details() *parsed.Authorship {
 	}
 	ao.Authors = aus
 	ao.Year = yr
+	ao.Corrig = au.Corrig
 	return &ao
 }
 
@@ -684,6 +998,13 @@ func authGroupDetail(ag *authorsGroupNode) *parsed.AuthGroup {
 		Authors: aus,
 		Year:    yr,
 	}
+	if ag.InTeam != nil {
+		aus, yr := ag.InTeam.details()
+		ago.InAuthors = &parsed.Authors{
+			Authors: aus,
+			Year:    yr,
+		}
+	}
 	if ag.Team2 == nil {
 		return &ago
 	}
@@ -723,11 +1044,12 @@ func (a *authorshipNode) value() string {
 	if a.OriginalAuthors.Parens {
 		v = fmt.Sprintf("(%s)", v)
 	}
-	if a.CombinationAuthors == nil {
-		return v
+	if a.CombinationAuthors != nil {
+		v = v + " " + a.CombinationAuthors.value()
+	}
+	if a.Corrig {
+		v = v + " corrig."
 	}
-	cav := a.CombinationAuthors.value()
-	v = v + " " + cav
 	return v
 }
 
@@ -736,10 +1058,12 @@ func (ag *authorsGroupNode) value() string {
 		return ""
 	}
 	v := ag.Team1.value()
-	if ag.Team2 == nil {
-		return v
+	if ag.Team2 != nil {
+		v = fmt.Sprintf("%s %s %s", v, ag.Team2Word.NormValue, ag.Team2.value())
+	}
+	if ag.InTeam != nil {
+		v = fmt.Sprintf("%s %s %s", v, ag.InWord.NormValue, ag.InTeam.value())
 	}
-	v = fmt.Sprintf("%s %s %s", v, ag.Team2Word.NormValue, ag.Team2.value())
 	return v
 }
 
@@ -749,7 +1073,14 @@ func (ag *authorsGroupNode) words() []parsed.Word {
 		return p
 	}
 	p := ag.Team1.words()
-	return append(p, ag.Team2.words()...)
+	p = append(p, ag.Team2.words()...)
+	if ag.InWord != nil {
+		wrd := ag.InWord.Pos
+		wrd.Verbatim = ag.InWord.Value
+		wrd.Normalized = ag.InWord.NormValue
+		p = append(p, wrd)
+	}
+	return append(p, ag.InTeam.words()...)
 }
 
 func (aut *authorsTeamNode) value() string {
@@ -30,9 +30,11 @@ func (sn *scientificNameNode) ToOutput(withDetails bool) parsed.Parsed {
 	res.Hybrid = sn.hybrid
 	res.Surrogate = sn.surrogate
 	res.Bacteria = sn.bacteria
+	res.Extinct = len(sn.extinct) > 0
 	res.Tail = sn.tail
 	if withDetails {
 		res.Details = sn.Details()
+		res.BacterialDetails = sn.BacterialDetails()
 		res.Words = sn.Words()
 	}
 	return res
@@ -25,8 +25,13 @@ func (p *Engine) PreprocessAndParse(
 		}
 	}
 	preproc := preprocess.Preprocess([]byte(s))
+	p.explainPreprocess(s, tagsOrEntities, preproc)
 
 	defer func() {
+		if p.explain != nil {
+			p.explainPush(warnSource{name: "tail detection"})
+			defer p.explainPop()
+		}
 		if len(preproc.Tail) > 0 {
 			p.sn.tail += string(preproc.Tail)
 		}
@@ -50,14 +55,19 @@ func (p *Engine) PreprocessAndParse(
 
 	p.Buffer = string(preproc.Body)
 	p.fullReset()
+	if p.explain != nil {
+		p.explainPush(warnSource{name: "preprocessing"})
+	}
 	if tagsOrEntities {
 		p.addWarn(parsed.HTMLTagsEntitiesWarn)
 	}
 	if preproc.Underscore {
 		p.addWarn(parsed.SpaceNonStandardWarn)
 	}
+	p.explainPop()
 	err := p.Parse()
 	if err != nil {
+		p.explainError(err)
 		p.error = err
 		p.newNotParsedScientificNameNode(preproc)
 		return p.sn
@@ -1,6 +1,7 @@
 package parser_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/gnames/gnparser/ent/parser"
@@ -65,3 +66,105 @@ func TestToOutput(t *testing.T) {
 		assert.Equal(t, out.Authorship.Normalized, v.au, msg)
 	}
 }
+
+// TestExplain tests Explain method
+func TestExplain(t *testing.T) {
+	p := &parser.Engine{Buffer: ""}
+	p.Init()
+
+	res := p.Explain("<i>Bubo</i> bubo (L.) sensu Smith", "test_version", false)
+	pp := res.Preprocessing
+	assert.True(t, pp.HTMLStripped)
+	assert.Equal(t, "Bubo bubo (L.) sensu Smith", pp.Cleaned)
+	assert.True(t, pp.Annotation)
+	assert.Equal(t, 14, pp.AnnotationStart)
+	assert.Equal(t, "Bubo bubo (L.)", pp.Body)
+	assert.Equal(t, " sensu Smith", pp.Tail)
+	assert.Equal(t, "SciName", res.Tree.Rule)
+	assert.Equal(t, 0, res.Tree.Start)
+	assert.Equal(t, 14, res.Tree.End)
+	assert.Equal(t, "Bubo bubo", res.Parsed.Canonical.Simple)
+	srcs := make(map[string]string)
+	for _, v := range res.Warnings {
+		srcs[v.Warning] = v.Source
+	}
+	assert.Equal(t, "preprocessing", srcs["HTML tags or entities in the name"])
+	assert.Equal(t, "tail detection", srcs["Unparsed tail"])
+
+	res = p.Explain("Aus bus Smith y Jones", "test_version", false)
+	assert.Equal(t, "rule AuthorSepSpanish", res.Warnings[0].Source)
+
+	// A warning emitted after a nested node is processed is attributed
+	// to the parent.
+	res = p.Explain("Aus bus Smith ex. Jones", "test_version", false)
+	srcs = make(map[string]string)
+	for _, v := range res.Warnings {
+		srcs[v.Warning] = v.Source
+	}
+	assert.Equal(t, "AST newAuthorsGroupNode",
+		srcs["`ex` ends with a period"])
+	assert.Equal(t, "AST newAuthorsGroupNode",
+		srcs["Ex authors are not required"])
+
+	res = p.Explain("Tobacco mosaic virus", "test_version", false)
+	assert.True(t, res.Preprocessing.Virus)
+	assert.True(t, res.Preprocessing.NoParse)
+	assert.Nil(t, res.Tree)
+	assert.Contains(t, res.Text(), "Virus:         true")
+
+	// Explain does not affect following parsing.
+	sn := p.PreprocessAndParse("Aus bus", "test_version", false)
+	assert.Equal(t, "Aus bus", sn.ToOutput(false).Canonical.Simple)
+}
+
+func TestPool(t *testing.T) {
+	pool := parser.NewPool()
+	names := []string{"Pardosa moesta L.", "Bubo bubo", "Aus bus Mill. 1800"}
+	cans := []string{"Pardosa moesta", "Bubo bubo", "Aus bus"}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				k := j % len(names)
+				res := pool.ParseName(names[k], "test_version", false, j%2 == 0)
+				assert.Equal(t, cans[k], res.Canonical.Simple)
+			}
+		}()
+	}
+	wg.Wait()
+	exp := pool.Explain("Bubo bubo", "test_version", false)
+	assert.Equal(t, "Bubo bubo", exp.Parsed.Canonical.Simple)
+}
+
+// TestParseAllocs makes sure that hooks of Explain do not allocate memory
+// during normal parsing. The limit is the number of allocations measured
+// by BenchmarkEngine before the hooks were added.
+func TestParseAllocs(t *testing.T) {
+	name := "Abarema clypearia (Jack) Kosterm., p.p."
+	p := parser.New()
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = p.PreprocessAndParse(name, "test_version", false).ToOutput(false)
+	})
+	assert.LessOrEqual(t, allocs, 230.0)
+}
+
+// BenchmarkEngine compares creation of a new engine for every name with
+// reuse of engines from a pool. Run it with:
+// `go test -bench=Engine -benchmem -run=XXX`
+func BenchmarkEngine(b *testing.B) {
+	name := "Abarema clypearia (Jack) Kosterm., p.p."
+	b.Run("New engine", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			p := parser.New()
+			_ = p.PreprocessAndParse(name, "test_version", false).ToOutput(false)
+		}
+	})
+	b.Run("Pool", func(b *testing.B) {
+		pool := parser.NewPool()
+		for i := 0; i < b.N; i++ {
+			_ = pool.ParseName(name, "test_version", false, false)
+		}
+	})
+}
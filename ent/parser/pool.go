@@ -0,0 +1,45 @@
+package parser
+
+import (
+	"sync"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Pool keeps parsing engines for reuse. An engine keeps its memory for
+// tokens between parsing events, so reuse of engines decreases the number
+// of allocations per name. Pool is safe for concurrent use, every engine
+// is used by one goroutine at a time.
+type Pool struct {
+	engines sync.Pool
+}
+
+// NewPool creates an empty Pool. Engines are created when needed.
+func NewPool() *Pool {
+	return &Pool{
+		engines: sync.Pool{New: func() interface{} { return New() }},
+	}
+}
+
+// ParseName preprocesses and parses a name-string with an engine from
+// the pool, and converts the result to the final output.
+func (p *Pool) ParseName(
+	name, version string,
+	keepHTML, withDetails bool,
+) parsed.Parsed {
+	e := p.engines.Get().(Parser)
+	sn := e.PreprocessAndParse(name, version, keepHTML)
+	res := sn.ToOutput(withDetails)
+	// the engine is not returned to the pool if parsing panicked, because
+	// its state might be broken.
+	p.engines.Put(e)
+	return res
+}
+
+// Explain describes parsing of a name-string using an engine from the pool.
+func (p *Pool) Explain(name, version string, keepHTML bool) Explanation {
+	e := p.engines.Get().(Parser)
+	res := e.Explain(name, version, keepHTML)
+	p.engines.Put(e)
+	return res
+}
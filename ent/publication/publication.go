@@ -0,0 +1,116 @@
+// Package publication cuts off bibliographic references from the end of
+// name-strings. Checklists often give a reference to the original
+// publication right after the authorship, for example
+// "Carex flava L., Sp. Pl. 2: 975. 1753" or "Aus bus Smith, 1900: 23, pl. 4".
+// Such references confuse parsing of the authorship and are better
+// separated from the name-string before parsing.
+package publication
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+const (
+	// year is a year of publication.
+	year = `(?:1[5-9]|20)\d\d`
+
+	// title is an abbreviated title of a book or a journal, like
+	// "Sp. Pl." or "Ann. Mag. nat. Hist.". Short lowercase words are
+	// not allowed, so "p." or "no." of pages are not taken as a title.
+	title = `\p{Lu}\p{L}*\.?(?:\s*(?:\p{Lu}\p{L}*\.?|(?:\p{Ll}{3,}|ed)\.|&))*`
+
+	// pages are a page or a range of pages, like "975", "p. 23" or "23-25".
+	pages = `(?:(?:pp?|no)\.\s*)?(?P<pages>\d+(?:\s*[-–]\s*\d+)?)`
+
+	// plates are a plate or a range of plates, like ", pl. 4" or ", t. 2".
+	plates = `(?:,\s*(?:pls?|tab|t|plates?)\.?\s*` +
+		`(?P<plates>\d+(?:\s*[-–]\s*\d+)?))?`
+
+	// figs are figures, they are recognized, but not kept.
+	figs = `(?:,\s*figs?\.?\s*\d+(?:\s*[-–]\s*\d+)?)?`
+)
+
+// titledRe matches a reference with a title that starts after a comma,
+// for example ", Sp. Pl. 2: 975. 1753".
+var titledRe = regexp.MustCompile(
+	`^,\s*(?P<title>` + title + `)\s*` +
+		`(?:(?P<volume>\d+)(?:\s*\(\d+\))?\s*[:,]\s*)?` +
+		pages + plates + figs +
+		`(?:[.,]?\s*\(?(?P<year>` + year + `)\)?)?\.?\s*$`,
+)
+
+// yearPageRe matches a reference that gives only pages after the year
+// of the authorship, for example "Smith, 1900: 23, pl. 4". The year stays
+// with the authorship.
+var yearPageRe = regexp.MustCompile(
+	`^(?P<name>.*\D(?P<year>` + year + `)\)?)` +
+		`(?P<cite>(?:\s*:|,\s*pp?\.)\s*` + pages + plates + figs +
+		`\.?\s*)$`,
+)
+
+var yearRe = regexp.MustCompile(`^` + year + `$`)
+
+// Split cuts off a publication reference from the end of a name-string.
+// It returns the name-string without the reference and the reference.
+// If no reference is found, it returns the input and nil.
+func Split(s string) (string, *parsed.Publication) {
+	if name, pub := splitTitled(s); pub != nil {
+		return name, pub
+	}
+	return splitYearPage(s)
+}
+
+// splitTitled tries every comma of the name-string, the first comma that
+// starts a valid reference gives the longest possible reference.
+func splitTitled(s string) (string, *parsed.Publication) {
+	for i := 1; i < len(s); i++ {
+		if s[i] != ',' {
+			continue
+		}
+		m := titledRe.FindStringSubmatch(s[i:])
+		if m == nil {
+			continue
+		}
+		pub := &parsed.Publication{
+			Verbatim: strings.TrimSpace(s[i+1:]),
+			Title:    m[titledRe.SubexpIndex("title")],
+			Volume:   m[titledRe.SubexpIndex("volume")],
+			Pages:    m[titledRe.SubexpIndex("pages")],
+			Plates:   m[titledRe.SubexpIndex("plates")],
+			Year:     m[titledRe.SubexpIndex("year")],
+		}
+		// A title without dots is more likely to be an author, and pages
+		// without a volume might be a year of the authorship.
+		if !strings.Contains(pub.Title, ".") ||
+			(pub.Volume == "" && yearRe.MatchString(pub.Pages)) {
+			continue
+		}
+		// A year before the colon is not a volume, like in
+		// "Proc. Zool. Soc. London 1901: 23-25".
+		if pub.Year == "" && yearRe.MatchString(pub.Volume) {
+			pub.Year, pub.Volume = pub.Volume, ""
+		}
+		return strings.TrimSpace(s[:i]), pub
+	}
+	return s, nil
+}
+
+// splitYearPage cuts off pages and plates that follow the year of the
+// authorship.
+func splitYearPage(s string) (string, *parsed.Publication) {
+	m := yearPageRe.FindStringSubmatch(s)
+	if m == nil {
+		return s, nil
+	}
+	cite := strings.TrimLeft(m[yearPageRe.SubexpIndex("cite")], " :,")
+	pub := &parsed.Publication{
+		Verbatim: strings.TrimSpace(cite),
+		Pages:    m[yearPageRe.SubexpIndex("pages")],
+		Plates:   m[yearPageRe.SubexpIndex("plates")],
+		Year:     m[yearPageRe.SubexpIndex("year")],
+	}
+	return m[yearPageRe.SubexpIndex("name")], pub
+}
@@ -0,0 +1,64 @@
+package publication_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/publication"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		msg, s, name string
+		pub          *parsed.Publication
+	}{
+		{"no pub", "Aus bus Smith, 1900", "Aus bus Smith, 1900", nil},
+		{"author", "Aus bus L., Mill. 1768", "Aus bus L., Mill. 1768", nil},
+		{"no dots", "Aus bus L., Jones 2: 23", "Aus bus L., Jones 2: 23", nil},
+		{"title", "Carex flava L., Sp. Pl. 2: 975. 1753", "Carex flava L.",
+			&parsed.Publication{
+				Verbatim: "Sp. Pl. 2: 975. 1753",
+				Title:    "Sp. Pl.",
+				Volume:   "2",
+				Pages:    "975",
+				Year:     "1753",
+			}},
+		{"plates", "Aus bus (Smith, 1900) Jones, Proc. Zool. Soc. London " +
+			"1901: 23-25, pls. 2-3, fig. 4", "Aus bus (Smith, 1900) Jones",
+			&parsed.Publication{
+				Verbatim: "Proc. Zool. Soc. London 1901: 23-25, pls. 2-3, fig. 4",
+				Title:    "Proc. Zool. Soc. London",
+				Pages:    "23-25",
+				Plates:   "2-3",
+				Year:     "1901",
+			}},
+		{"no volume", "Aus bus Smith, Ann. Mag. nat. Hist. p. 45 (1850)",
+			"Aus bus Smith",
+			&parsed.Publication{
+				Verbatim: "Ann. Mag. nat. Hist. p. 45 (1850)",
+				Title:    "Ann. Mag. nat. Hist.",
+				Pages:    "45",
+				Year:     "1850",
+			}},
+		{"year page", "Aus bus Smith, 1900: 23, pl. 4", "Aus bus Smith, 1900",
+			&parsed.Publication{
+				Verbatim: "23, pl. 4",
+				Pages:    "23",
+				Plates:   "4",
+				Year:     "1900",
+			}},
+		{"year pp", "Aus bus (Smith 1900), p. 45", "Aus bus (Smith 1900)",
+			&parsed.Publication{
+				Verbatim: "p. 45",
+				Pages:    "45",
+				Year:     "1900",
+			}},
+	}
+
+	for _, v := range tests {
+		name, pub := publication.Split(v.s)
+		assert.Equal(t, v.name, name, v.msg)
+		assert.Equal(t, v.pub, pub, v.msg)
+	}
+}
@@ -0,0 +1,246 @@
+// Package stats collects summary statistics of parsing results. It allows
+// to get a quick report about the quality of a dataset before using it.
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// maxKeys is the maximum number of distinct genera or tails kept in
+// memory. When the limit is reached, the rarest entries are removed,
+// so counts of frequent entries might be slightly underestimated for
+// very diverse inputs.
+const maxKeys = 100_000
+
+// Count is a value with the number of its occurrences.
+type Count struct {
+	// Value is a counted category, for example a genus, a tail or a warning.
+	Value string `json:"value"`
+
+	// Count is the number of occurrences of the Value.
+	Count int `json:"count"`
+}
+
+// Stats is a summary of parsing results.
+type Stats struct {
+	// Total is the number of processed name-strings.
+	Total int `json:"total"`
+
+	// Parsed is the number of successfully parsed name-strings.
+	Parsed int `json:"parsed"`
+
+	// Duration is the time spent on processing, in seconds.
+	Duration float64 `json:"durationSec"`
+
+	// NamesPerSec is the throughput of processing.
+	NamesPerSec float64 `json:"namesPerSec"`
+
+	// Quality is the number of names for every parsing quality.
+	Quality []Count `json:"quality"`
+
+	// Cardinality is the number of names for every cardinality.
+	Cardinality []Count `json:"cardinality"`
+
+	// Warnings is the number of names with a particular warning.
+	Warnings []Count `json:"warnings"`
+
+	// Hybrids is the number of names for every type of hybrids.
+	Hybrids []Count `json:"hybrids"`
+
+	// Surrogates is the number of names for every type of surrogates.
+	Surrogates []Count `json:"surrogates"`
+
+	// Bacteria is the number of names detected as bacterial ("yes")
+	// or possibly bacterial ("maybe").
+	Bacteria []Count `json:"bacteria"`
+
+	// Viruses is the number of names detected as viruses.
+	Viruses int `json:"viruses"`
+
+	// TopGenera are the most frequent genera.
+	TopGenera []Count `json:"topGenera"`
+
+	// TopTails are the most frequent unparsed tails.
+	TopTails []Count `json:"topTails"`
+
+	top         int
+	start       time.Time
+	quality     map[int]int
+	cardinality map[int]int
+	warnings    counter
+	hybrids     counter
+	surrogates  counter
+	bacteria    counter
+	genera      counter
+	tails       counter
+}
+
+// New creates an empty Stats object that keeps `top` most frequent
+// genera and tails.
+func New(top int) *Stats {
+	return &Stats{
+		top:         top,
+		start:       time.Now(),
+		quality:     make(map[int]int),
+		cardinality: make(map[int]int),
+		warnings:    make(counter),
+		hybrids:     make(counter),
+		surrogates:  make(counter),
+		bacteria:    make(counter),
+		genera:      make(counter),
+		tails:       make(counter),
+	}
+}
+
+// Add updates statistics with a parsing result.
+func (s *Stats) Add(p parsed.Parsed) {
+	s.Total++
+	s.quality[p.ParseQuality]++
+	s.cardinality[p.Cardinality]++
+	if p.Virus {
+		s.Viruses++
+	}
+	if !p.Parsed {
+		return
+	}
+
+	s.Parsed++
+	for _, v := range p.QualityWarnings {
+		s.warnings.add(v.Warning.String())
+	}
+	if p.Hybrid != nil {
+		s.hybrids.add(p.Hybrid.String())
+	}
+	if p.Surrogate != nil {
+		s.surrogates.add(p.Surrogate.String())
+	}
+	if p.Bacteria != nil {
+		s.bacteria.add(p.Bacteria.String())
+	}
+	if p.Cardinality > 1 && p.Canonical != nil {
+		genus := strings.SplitN(p.Canonical.Simple, " ", 2)[0]
+		s.genera.add(genus)
+	}
+	if p.Tail != "" {
+		s.tails.add(strings.TrimSpace(p.Tail))
+	}
+}
+
+// Finish calculates throughput and converts collected counts into sorted
+// lists. It should be called after all results are added.
+func (s *Stats) Finish() {
+	dur := time.Since(s.start).Seconds()
+	s.Duration = dur
+	if dur > 0 {
+		s.NamesPerSec = float64(s.Total) / dur
+	}
+	s.Quality = intKeys(s.quality)
+	s.Cardinality = intKeys(s.cardinality)
+	s.Warnings = s.warnings.topN(len(s.warnings))
+	s.Hybrids = s.hybrids.topN(len(s.hybrids))
+	s.Surrogates = s.surrogates.topN(len(s.surrogates))
+	s.Bacteria = s.bacteria.topN(len(s.bacteria))
+	s.TopGenera = s.genera.topN(s.top)
+	s.TopTails = s.tails.topN(s.top)
+}
+
+// Text returns a human-readable report.
+func (s *Stats) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Names:         %d\n", s.Total)
+	fmt.Fprintf(&b, "Parsed:        %d (%s)\n", s.Parsed, percent(s.Parsed, s.Total))
+	fmt.Fprintf(&b, "Viruses:       %d\n", s.Viruses)
+	fmt.Fprintf(&b, "Duration:      %.2fs\n", s.Duration)
+	fmt.Fprintf(&b, "Names per sec: %.0f\n", s.NamesPerSec)
+
+	section(&b, "Quality", s.Quality, s.Total)
+	section(&b, "Cardinality", s.Cardinality, s.Total)
+	section(&b, "Warnings", s.Warnings, s.Total)
+	section(&b, "Hybrids", s.Hybrids, s.Total)
+	section(&b, "Surrogates", s.Surrogates, s.Total)
+	section(&b, "Bacteria", s.Bacteria, s.Total)
+	section(&b, "Top genera", s.TopGenera, s.Total)
+	section(&b, "Top tails", s.TopTails, s.Total)
+	return b.String()
+}
+
+// valueWidth is the width of the value column in the text report.
+const valueWidth = 50
+
+func section(b *strings.Builder, title string, counts []Count, total int) {
+	if len(counts) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n%s:\n", title)
+	for _, v := range counts {
+		val := []rune(v.Value)
+		if len(val) > valueWidth {
+			val = append(val[:valueWidth-3], []rune("...")...)
+		}
+		fmt.Fprintf(b, "  %-*s %10d %8s\n",
+			valueWidth, string(val), v.Count, percent(v.Count, total))
+	}
+}
+
+func percent(i, total int) string {
+	if total == 0 {
+		return "0.00%"
+	}
+	return fmt.Sprintf("%.2f%%", float64(i)*100/float64(total))
+}
+
+// intKeys converts a map to counts sorted by keys.
+func intKeys(m map[int]int) []Count {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	res := make([]Count, len(keys))
+	for i, k := range keys {
+		res[i] = Count{Value: fmt.Sprintf("%d", k), Count: m[k]}
+	}
+	return res
+}
+
+// counter counts occurrences of strings.
+type counter map[string]int
+
+// add increases the count of a string. If there are too many distinct
+// strings, the rarest ones are removed.
+func (c counter) add(s string) {
+	c[s]++
+	if len(c) <= maxKeys {
+		return
+	}
+	for min := 1; len(c) > maxKeys/2; min++ {
+		for k, v := range c {
+			if v <= min {
+				delete(c, k)
+			}
+		}
+	}
+}
+
+// topN returns up to n most frequent strings.
+func (c counter) topN(n int) []Count {
+	res := make([]Count, 0, len(c))
+	for k, v := range c {
+		res = append(res, Count{Value: k, Count: v})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count == res[j].Count {
+			return res[i].Value < res[j].Value
+		}
+		return res[i].Count > res[j].Count
+	})
+	if len(res) > n {
+		res = res[:n]
+	}
+	return res
+}
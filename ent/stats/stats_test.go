@@ -0,0 +1,75 @@
+package stats_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	names := []string{
+		"Bubo bubo (Linnaeus, 1758)",
+		"Bubo virginianus",
+		"Aus cf. bus",
+		"Salmonella enterica",
+		"Aus bus something strange 1234",
+		"Tobacco mosaic virus",
+	}
+	gnp := gnparser.New(gnparser.NewConfig())
+	s := stats.New(1)
+	for _, v := range gnp.ParseNames(names) {
+		s.Add(v)
+	}
+	s.Finish()
+
+	assert.Equal(t, 6, s.Total)
+	assert.Equal(t, 5, s.Parsed)
+	assert.Equal(t, 1, s.Viruses)
+	assert.Equal(t, []stats.Count{{Value: "Aus", Count: 2}}, s.TopGenera)
+	assert.Equal(t, 1, len(s.TopTails))
+	assert.Contains(t, s.Surrogates, stats.Count{Value: "COMPARISON", Count: 1})
+	assert.Contains(t, s.Bacteria, stats.Count{Value: "yes", Count: 1})
+	assert.Contains(t, s.Cardinality, stats.Count{Value: "0", Count: 1})
+	assert.Contains(t, s.Warnings, stats.Count{Value: "Unparsed tail", Count: 1})
+
+	txt := s.Text()
+	assert.Contains(t, txt, "Names:         6")
+	assert.Contains(t, txt, "Top genera:")
+}
+
+func TestStatsStreamBatch(t *testing.T) {
+	names := strings.Repeat("Bubo bubo (Linnaeus, 1758)\nAus cf. bus\n"+
+		"Salmonella enterica\nAus bus something strange 1234\n"+
+		"Tobacco mosaic virus\nPomatomus saltatrix\n", 50)
+	var res []string
+	for _, stream := range []bool{false, true} {
+		gnp := gnparser.New(gnparser.NewConfig(
+			gnparser.OptWithStream(stream),
+			gnparser.OptWithNoOrder(true),
+			gnparser.OptBatchSize(7),
+		))
+		s := stats.New(3)
+		err := gnp.ParseReader(
+			context.Background(),
+			strings.NewReader(names),
+			func(p parsed.Parsed) error {
+				s.Add(p)
+				return nil
+			},
+		)
+		assert.Nil(t, err)
+		s.Finish()
+		assert.Equal(t, 300, s.Total)
+		s.Duration, s.NamesPerSec = 0, 0
+		out, err := json.Marshal(s)
+		assert.Nil(t, err)
+		res = append(res, string(out))
+	}
+	assert.Equal(t, res[0], res[1])
+}
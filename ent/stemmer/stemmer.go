@@ -133,10 +133,15 @@ func StemCanonical(c string) string {
 	}
 	res := make([]string, len(words))
 	for i, v := range words {
-		if i == 0 || len(v) < 3 {
+		// parents of hybrid formulas can be in parentheses
+		w := strings.TrimLeft(v, "(")
+		pre := v[:len(v)-len(w)]
+		w = strings.TrimRight(w, ")")
+		suf := v[len(pre)+len(w):]
+		if i == 0 || len(w) < 3 {
 			res[i] = v
 		} else {
-			res[i] = Stem(v).Stem
+			res[i] = pre + Stem(w).Stem + suf
 		}
 	}
 	return strings.Join(res, " ")
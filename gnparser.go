@@ -2,18 +2,22 @@
 // scientific names. There are methods to parse one name at a time,
 // a slice of names, or a stream of names. All methods return results in the
 // same order as input. It is achieved by restoring the order after concurrent
-// execution of the parsing process.
+// execution of the parsing process. All methods are safe for concurrent use.
 package gnparser
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnlib/ent/gnvers"
+	"github.com/gnames/gnparser/ent/cache"
 	"github.com/gnames/gnparser/ent/nameidx"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/parser"
+	"github.com/gnames/gnparser/ent/publication"
+	"github.com/gnames/gnuuid"
 )
 
 // gnparser is an implementation of GNparser interface.
@@ -22,8 +26,13 @@ type gnparser struct {
 	// cfg keeps gnparser settings.
 	cfg Config
 
-	// parser keeps parsing engine
-	parser parser.Parser
+	// pool keeps parsing engines for reuse, it is safe for concurrent use
+	// and is shared by all copies of gnparser.
+	pool *parser.Pool
+
+	// cache keeps recent parsing results, it is nil if the cache is
+	// disabled. The cache is shared by all copies of gnparser.
+	cache cache.Cache
 }
 
 // New constructor function takes options organized into a
@@ -31,38 +40,160 @@ type gnparser struct {
 // interface.
 func New(cfg Config) GNparser {
 	gnp := gnparser{cfg: cfg}
-	gnp.parser = parser.New()
+	gnp.pool = parser.NewPool()
+	if cfg.CacheSize > 0 {
+		gnp.cache = cache.New(cfg.CacheSize)
+	}
 	return gnp
 }
 
 // Parse function parses input string according to configurations.
-// It takes a string and returns an parsed.Parsed object.
+// It takes a string and returns an parsed.Parsed object. It is safe for
+// concurrent use.
 func (gnp gnparser) ParseName(s string) parsed.Parsed {
+	if gnp.cache == nil {
+		return gnp.parseName(s)
+	}
+
+	key := cache.Key{
+		Verbatim:        s,
+		WithDetails:     gnp.cfg.WithDetails,
+		IgnoreHTMLTags:  gnp.cfg.IgnoreHTMLTags,
+		WithPublication: gnp.cfg.WithPublication,
+	}
+	if res, ok := gnp.cache.Get(key); ok {
+		return res
+	}
+	res := gnp.parseName(s)
+	gnp.cache.Set(key, res)
+	return res
+}
+
+// parseName parses a name-string without using the cache.
+func (gnp gnparser) parseName(s string) parsed.Parsed {
 	ver := Version
 	if gnp.cfg.IsTest {
 		ver = "test_version"
 	}
-	sciNameNode := gnp.parser.PreprocessAndParse(s, ver, gnp.cfg.IgnoreHTMLTags)
-	res := sciNameNode.ToOutput(gnp.cfg.WithDetails)
+	if !gnp.cfg.WithPublication {
+		return gnp.pool.ParseName(
+			s, ver, gnp.cfg.IgnoreHTMLTags, gnp.cfg.WithDetails,
+		)
+	}
+
+	name, pub := publication.Split(s)
+	res := gnp.pool.ParseName(
+		name, ver, gnp.cfg.IgnoreHTMLTags, gnp.cfg.WithDetails,
+	)
+	if pub != nil {
+		res.Verbatim = s
+		res.VerbatimID = gnuuid.New(s).String()
+		res.Publication = pub
+	}
 	return res
 }
 
+// Explain parses a name-string and describes the parsing process:
+// preprocessing steps, the tree of PEG rules and sources of warnings.
+func (gnp gnparser) Explain(s string) parser.Explanation {
+	ver := Version
+	if gnp.cfg.IsTest {
+		ver = "test_version"
+	}
+	return gnp.pool.Explain(s, ver, gnp.cfg.IgnoreHTMLTags)
+}
+
 // ParseNames function takes input names and returns parsed results.
+// If WithDedupe is set, every unique name-string is parsed only once.
+// Names that failed to parse are returned as not parsed.
 func (gnp gnparser) ParseNames(names []string) []parsed.Parsed {
+	res, _ := gnp.ParseNamesCtx(context.Background(), names)
+	return res
+}
+
+// ParseNamesCtx takes a context and input names and returns parsed
+// results. If the context is cancelled before all names are parsed, it
+// returns the error of the context and no results. If parsing of some names
+// failed, they are returned as not parsed, and the error of the first
+// failure is returned together with all results.
+func (gnp gnparser) ParseNamesCtx(
+	ctx context.Context,
+	names []string,
+) ([]parsed.Parsed, error) {
+	if !gnp.cfg.WithDedupe {
+		return gnp.parseNames(ctx, names)
+	}
+
+	uniq, idx, _ := dedupe(names)
+	gnp.cfg.WithNoOrder = false
+	uniqRes, err := gnp.parseNames(ctx, uniq)
+	if uniqRes == nil {
+		return nil, err
+	}
+	res := make([]parsed.Parsed, len(names))
+	for i := range idx {
+		res[i] = uniqRes[idx[i]]
+	}
+	return res, err
+}
+
+// ParseNamesUnique takes input names and returns parsed results of unique
+// name-strings in the order of their first appearance, together with
+// the number of their occurrences in the input.
+func (gnp gnparser) ParseNamesUnique(names []string) []parsed.Counted {
+	uniq, _, counts := dedupe(names)
+	gnp.cfg.WithNoOrder = false
+	uniqRes, _ := gnp.parseNames(context.Background(), uniq)
+	res := make([]parsed.Counted, len(uniq))
+	for i := range uniqRes {
+		res[i] = parsed.Counted{Parsed: uniqRes[i], Count: counts[i]}
+	}
+	return res
+}
+
+// dedupe collapses identical name-strings. It returns unique names in the
+// order of their first appearance, the index of a unique name for every
+// input name, and the number of occurrences of every unique name.
+func dedupe(names []string) ([]string, []int, []int) {
+	seen := make(map[string]int)
+	var uniq []string
+	var counts []int
+	idx := make([]int, len(names))
+	for i, v := range names {
+		j, ok := seen[v]
+		if !ok {
+			j = len(uniq)
+			seen[v] = j
+			uniq = append(uniq, v)
+			counts = append(counts, 0)
+		}
+		counts[j]++
+		idx[i] = j
+	}
+	return uniq, idx, counts
+}
+
+// parseNames parses names concurrently. It returns no results if
+// the context is cancelled.
+func (gnp gnparser) parseNames(
+	ctx context.Context,
+	names []string,
+) ([]parsed.Parsed, error) {
 	res := make([]parsed.Parsed, len(names))
 	jobsNum := gnp.cfg.JobsNum
 	chOut := make(chan parsed.ParsedWithIdx)
 	var wgIn, wgOut sync.WaitGroup
+	var errs firstError
 	wgIn.Add(jobsNum)
 	wgOut.Add(1)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctxWork, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	chIn := loadNames(ctx, names)
+	chIn := loadNames(ctxWork, names)
 
 	for i := jobsNum; i > 0; i-- {
-		go gnp.parseWorker(ctx, chIn, chOut, &wgIn)
+		go gnp.parseWorker(ctxWork, chIn, chOut, &wgIn)
 	}
 
 	go func() {
@@ -70,12 +201,13 @@ func (gnp gnparser) ParseNames(names []string) []parsed.Parsed {
 		var count int
 		for {
 			select {
-			case <-ctx.Done():
+			case <-ctxWork.Done():
 				return
 			case v, ok := <-chOut:
 				if !ok {
 					return
 				}
+				errs.set(v.Error)
 				if gnp.cfg.WithNoOrder {
 					res[count] = v.Parsed
 					count++
@@ -89,7 +221,10 @@ func (gnp gnparser) ParseNames(names []string) []parsed.Parsed {
 	wgIn.Wait()
 	close(chOut)
 	wgOut.Wait()
-	return res
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return res, errs.get()
 }
 
 // Format returns the configured output format value.
@@ -100,12 +235,28 @@ func (gnp gnparser) Format() gnfmt.Format {
 // ChangeConfig allows change configuration of already created
 // GNparser object.
 func (gnp gnparser) ChangeConfig(opts ...Option) GNparser {
+	size := gnp.cfg.CacheSize
 	for i := range opts {
 		opts[i](&gnp.cfg)
 	}
+	if gnp.cfg.CacheSize != size {
+		gnp.cache = nil
+		if gnp.cfg.CacheSize > 0 {
+			gnp.cache = cache.New(gnp.cfg.CacheSize)
+		}
+	}
 	return gnp
 }
 
+// CacheStats returns hits, misses and size of the parsing cache. If the
+// cache is disabled, it returns empty statistics.
+func (gnp gnparser) CacheStats() cache.Stats {
+	if gnp.cache == nil {
+		return cache.Stats{}
+	}
+	return gnp.cache.Stats()
+}
+
 // Version function returns version number of `gnparser` and the timestamp
 // of its build.
 func (gnp gnparser) GetVersion() gnvers.Version {
@@ -124,18 +275,59 @@ func (gnp gnparser) parseWorker(
 	wgIn *sync.WaitGroup,
 ) {
 	defer wgIn.Done()
-	gnp.parser = parser.New()
-
 	for v := range chIn {
-		parseRes := gnp.ParseName(v.NameString)
+		parseRes, err := gnp.parseNameSafe(v.NameString)
 		select {
 		case <-ctx.Done():
 			return
-		case chOut <- parsed.ParsedWithIdx{Idx: v.Index, Parsed: parseRes}:
+		case chOut <- parsed.ParsedWithIdx{
+			Idx: v.Index, Parsed: parseRes, Error: err,
+		}:
 		}
 	}
 }
 
+// parseNameSafe parses a name-string and converts a panic during parsing
+// into an error. If parsing failed, the name is returned as not parsed.
+func (gnp gnparser) parseNameSafe(s string) (res parsed.Parsed, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = parsed.Parsed{
+				Verbatim:      s,
+				VerbatimID:    gnuuid.New(s).String(),
+				ParserVersion: gnp.GetVersion().Version,
+			}
+			err = fmt.Errorf("cannot parse '%s': %v", s, r)
+		}
+	}()
+	return gnp.ParseName(s), nil
+}
+
+// firstError keeps the first error reported by concurrent workers.
+type firstError struct {
+	mx  sync.Mutex
+	err error
+}
+
+// set saves the error, if it is the first one.
+func (fe *firstError) set(err error) {
+	if err == nil {
+		return
+	}
+	fe.mx.Lock()
+	defer fe.mx.Unlock()
+	if fe.err == nil {
+		fe.err = err
+	}
+}
+
+// get returns the first error.
+func (fe *firstError) get() error {
+	fe.mx.Lock()
+	defer fe.mx.Unlock()
+	return fe.err
+}
+
 func loadNames(ctx context.Context, names []string) <-chan nameidx.NameIdx {
 	chIn := make(chan nameidx.NameIdx)
 	go func() {
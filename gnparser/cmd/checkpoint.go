@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"io"
+	"log"
+	"os"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/checkpoint"
+	"github.com/gnames/gnparser/io/fileio"
+	"github.com/spf13/cobra"
+)
+
+// chk records progress of parsing. It is nil if checkpoints are not used.
+var chk *checkpointer
+
+// checkpointer saves a checkpoint after every `every` lines of input,
+// when results of these lines are written to the output.
+type checkpointer struct {
+	path    string
+	state   checkpoint.Checkpoint
+	pending int64
+	every   int64
+}
+
+// checkpointFlags sets up checkpoints, and loads the last checkpoint if
+// parsing is resumed. Checkpoints need the output to go to a not compressed
+// file, and the order of results to follow the order of the input.
+func checkpointFlags(cmd *cobra.Command, cfg gnparser.Config, unique bool) {
+	flags := cmd.Flags()
+	path, _ := flags.GetString("checkpoint")
+	resume, _ := flags.GetBool("resume")
+	if path == "" {
+		if resume {
+			log.Fatal("Flag --resume requires --checkpoint.")
+		}
+		return
+	}
+
+	output, _ := flags.GetString("output")
+	rejectedPath, _ := flags.GetString("rejected")
+	switch {
+	case output == "":
+		log.Fatal("Flag --checkpoint requires --output.")
+	case fileio.IsCompressed(output) || fileio.IsCompressed(rejectedPath):
+		log.Fatal("Flag --checkpoint cannot be used with compressed output.")
+	case unique:
+		log.Fatal("Flag --checkpoint cannot be used with --unique.")
+	case cfg.WithStream && cfg.WithNoOrder:
+		log.Fatal("Flag --checkpoint cannot be used with unordered stream.")
+	}
+
+	chk = &checkpointer{path: path, every: int64(cfg.BatchSize)}
+	if resume {
+		state, err := checkpoint.Load(path)
+		switch {
+		case os.IsNotExist(err):
+			log.Printf("Checkpoint %s is not found, starting from the beginning.", path)
+		case err != nil:
+			log.Fatal(err)
+		default:
+			chk.state = state
+			log.Printf("Resuming after %d-th line.", state.Lines)
+		}
+	}
+	if err := chk.state.Save(path); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// outputOffset returns the size of the output at the last checkpoint.
+func (c *checkpointer) outputOffset() int64 {
+	if c == nil {
+		return 0
+	}
+	return c.state.OutputOffset
+}
+
+// rejectedOffset returns the size of rejected results at the last
+// checkpoint.
+func (c *checkpointer) rejectedOffset() int64 {
+	if c == nil {
+		return 0
+	}
+	return c.state.RejectedOffset
+}
+
+// skipInput skips input lines processed before the last checkpoint.
+func (c *checkpointer) skipInput(r io.Reader) io.Reader {
+	if c == nil || c.state.Lines == 0 {
+		return r
+	}
+	res, n, err := fileio.SkipLines(r, c.state.Lines)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if n < c.state.Lines {
+		log.Fatalf(
+			"Input has %d lines, but checkpoint needs more than %d.", n, c.state.Lines,
+		)
+	}
+	return res
+}
+
+// add records that results of n more lines are written to the output.
+func (c *checkpointer) add(n int) {
+	if c == nil {
+		return
+	}
+	c.pending += int64(n)
+	if c.pending >= c.every {
+		c.save()
+	}
+}
+
+// save writes pending results to disk and saves a checkpoint.
+func (c *checkpointer) save() {
+	if c == nil {
+		return
+	}
+	var err error
+	if c.state.OutputOffset, err = outputFile.sync(); err != nil {
+		log.Fatal(err)
+	}
+	if rejected != nil {
+		if c.state.RejectedOffset, err = rejected.sync(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	c.state.Lines += c.pending
+	c.pending = 0
+	if err = c.state.Save(c.path); err != nil {
+		log.Fatal(err)
+	}
+}
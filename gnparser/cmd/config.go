@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gnames/gnparser"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// cfgData keeps settings read from the configuration file and from
+// environment variables.
+type cfgData struct {
+	Format          string
+	JobsNum         int
+	BatchSize       int
+	WithStream      bool
+	IgnoreHTMLTags  bool
+	WithDetails     bool
+	WithNoOrder     bool
+	WithDedupe      bool
+	WithPublication bool
+	Port            int
+	CacheSize       int
+}
+
+// cfgEnv maps configuration keys to environment variables.
+var cfgEnv = map[string]string{
+	"Format":          "GNPARSER_FORMAT",
+	"JobsNum":         "GNPARSER_JOBS_NUM",
+	"BatchSize":       "GNPARSER_BATCH_SIZE",
+	"WithStream":      "GNPARSER_WITH_STREAM",
+	"IgnoreHTMLTags":  "GNPARSER_IGNORE_HTML_TAGS",
+	"WithDetails":     "GNPARSER_WITH_DETAILS",
+	"WithNoOrder":     "GNPARSER_WITH_NO_ORDER",
+	"WithDedupe":      "GNPARSER_WITH_DEDUPE",
+	"WithPublication": "GNPARSER_WITH_PUBLICATION",
+	"Port":            "GNPARSER_PORT",
+	"CacheSize":       "GNPARSER_CACHE_SIZE",
+}
+
+// cfgPort is the port of the web-service set by the configuration file or
+// by environment. If it is 0, the web-service is started only by the flag.
+var cfgPort int
+
+// configCmd groups commands that manage the configuration file.
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manages the gnparser configuration file.",
+	Long: `
+Manages the gnparser configuration file.
+
+Settings are taken from command line flags, environment variables
+(GNPARSER_FORMAT, GNPARSER_JOBS_NUM etc.), the configuration file and
+defaults, in this order of precedence.
+
+The configuration file is located at
+~/.config/gnparser/gnparser.yaml
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+// configInitCmd writes a default configuration file.
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Creates a configuration file with default settings.",
+	Run: func(cmd *cobra.Command, args []string) {
+		force, _ := cmd.Flags().GetBool("force")
+		path, err := configPath()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if _, err = os.Stat(path); err == nil && !force {
+			fmt.Printf("Config file %s exists, use --force to overwrite it.\n", path)
+			os.Exit(1)
+		}
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			log.Fatal(err)
+		}
+		if err = os.WriteFile(path, []byte(configText), 0644); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Created config file %s\n", path)
+	},
+}
+
+func init() {
+	configInitCmd.Flags().BoolP("force", "F", false,
+		"overwrite existing configuration file.")
+	configCmd.AddCommand(configInitCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+// configPath returns the location of the configuration file.
+func configPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "gnparser", "gnparser.yaml"), nil
+}
+
+// initConfig reads the configuration file and environment variables and
+// converts found settings into options. Flags are processed later, so they
+// override these options.
+func initConfig() {
+	v := viper.New()
+	if path, err := configPath(); err == nil {
+		v.SetConfigFile(path)
+	}
+	for k, env := range cfgEnv {
+		_ = v.BindEnv(k, env)
+	}
+
+	if err := v.ReadInConfig(); err != nil {
+		if _, ok := err.(*os.PathError); !ok {
+			log.Printf("Cannot read config file: %s.", err)
+		}
+	}
+
+	var cfg cfgData
+	if err := v.Unmarshal(&cfg); err != nil {
+		log.Printf("Cannot parse settings: %s.", err)
+		return
+	}
+	opts = append(opts, cfgOpts(cfg)...)
+	cfgPort = cfg.Port
+}
+
+// cfgOpts converts settings from the configuration file and environment
+// into options.
+func cfgOpts(cfg cfgData) []gnparser.Option {
+	var res []gnparser.Option
+	if cfg.Format != "" {
+		res = append(res, gnparser.OptFormat(strings.ToLower(cfg.Format)))
+	}
+	if cfg.JobsNum > 0 {
+		res = append(res, gnparser.OptJobsNum(cfg.JobsNum))
+	}
+	if cfg.BatchSize > 0 {
+		res = append(res, gnparser.OptBatchSize(cfg.BatchSize))
+	}
+	if cfg.Port > 0 {
+		res = append(res, gnparser.OptPort(cfg.Port))
+	}
+	if cfg.CacheSize > 0 {
+		res = append(res, gnparser.OptCacheSize(cfg.CacheSize))
+	}
+	res = append(res,
+		gnparser.OptWithStream(cfg.WithStream),
+		gnparser.OptIgnoreHTMLTags(cfg.IgnoreHTMLTags),
+		gnparser.OptWithDetails(cfg.WithDetails),
+		gnparser.OptWithNoOrder(cfg.WithNoOrder),
+		gnparser.OptWithDedupe(cfg.WithDedupe),
+		gnparser.OptWithPublication(cfg.WithPublication),
+	)
+	return res
+}
+
+// configText is the content of a default configuration file.
+const configText = `# Configuration file for gnparser.
+#
+# Settings are applied in the following order of precedence:
+# command line flags, environment variables, this file, defaults.
+# Every setting can be given as an environment variable, shown
+# in square brackets.
+
+# Format sets the output format. Can be one of 'csv', 'compact', 'pretty'.
+# [GNPARSER_FORMAT]
+# Format: csv
+
+# JobsNum sets the number of concurrent parsing jobs. By default it is
+# equal to the number of CPU threads.
+# [GNPARSER_JOBS_NUM]
+# JobsNum: 4
+
+# BatchSize sets the maximum number of names in a batch sent for processing.
+# [GNPARSER_BATCH_SIZE]
+# BatchSize: 50000
+
+# WithStream parses one name at a time instead of parsing by batches.
+# [GNPARSER_WITH_STREAM]
+# WithStream: false
+
+# IgnoreHTMLTags leaves HTML tags and entities intact during parsing. It makes
+# parsing faster if names do not contain HTML.
+# [GNPARSER_IGNORE_HTML_TAGS]
+# IgnoreHTMLTags: false
+
+# WithDetails adds more details to JSON output.
+# [GNPARSER_WITH_DETAILS]
+# WithDetails: false
+
+# WithNoOrder does not restore the order of output according to input.
+# [GNPARSER_WITH_NO_ORDER]
+# WithNoOrder: false
+
+# WithDedupe parses every unique name of a batch only once.
+# [GNPARSER_WITH_DEDUPE]
+# WithDedupe: false
+
+# WithPublication cuts off references to publications from the end of names.
+# [GNPARSER_WITH_PUBLICATION]
+# WithPublication: false
+
+# CacheSize sets the maximum number of parsed names kept in a cache.
+# Repeated names are taken from the cache. 0 means no cache.
+# [GNPARSER_CACHE_SIZE]
+# CacheSize: 0
+
+# Port, if set, starts gnparser as a web-service on this port.
+# [GNPARSER_PORT]
+# Port: 8080
+`
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/spf13/cobra"
+)
+
+// explainCmd shows how a name-string is parsed.
+var explainCmd = &cobra.Command{
+	Use:   "explain name",
+	Short: "Shows how a name-string is parsed.",
+	Long: `
+Shows how a name-string is parsed: preprocessing steps (HTML stripping,
+virus and no-parse detection, cut of annotations, underscores replacement),
+the tree of PEG rules with their spans, and which rules or parsing steps
+emitted warnings.
+
+To explain parsing as text:
+gnparser explain "Bubo bubo (L., 1758) sensu Smith"
+
+To get the explanation as JSON:
+gnparser explain "Bubo bubo (L., 1758) sensu Smith" -f pretty
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ignoreHTMLTagsFlag(cmd)
+		cfg := gnparser.NewConfig(opts...)
+		gnp := gnparser.New(cfg)
+		res := gnp.Explain(args[0])
+
+		frmt, _ := cmd.Flags().GetString("format")
+		if frmt == "text" {
+			fmt.Print(res.Text())
+			return
+		}
+		jsonFrmt, err := gnfmt.NewFormat(frmt)
+		if err != nil || jsonFrmt == gnfmt.CSV {
+			log.Fatalf("Unknown format '%s', use 'text', 'compact' or 'pretty'.", frmt)
+		}
+		enc := gnfmt.GNjson{Pretty: jsonFrmt == gnfmt.PrettyJSON}
+		out, err := enc.Encode(res)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(out))
+	},
+}
+
+func init() {
+	explainCmd.Flags().StringP("format", "f", "text",
+		"sets output format. Can be one of:\n  'text', 'compact', 'pretty'")
+	explainCmd.Flags().BoolP("ignore_tags", "i", false,
+		"ignore HTML entities and tags when parsing.")
+	rootCmd.AddCommand(explainCmd)
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/gnames/gnparser/io/fileio"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// out receives parsing results. It is STDOUT, unless an output file
+	// is given.
+	out io.Writer = os.Stdout
+
+	// outputFile is the output file, if results go to a file.
+	outputFile *output
+)
+
+// output is a buffered file for results that keeps track of its size.
+type output struct {
+	*bufio.Writer
+	file io.WriteCloser
+	size *sizeWriter
+}
+
+// newOutput creates a file for results. If the offset is positive, the
+// existing file is truncated at the offset and results are appended to it.
+// Output to files with `.gz` and `.zst` extensions is compressed.
+func newOutput(path string, offset int64) (*output, error) {
+	var f io.WriteCloser
+	var err error
+	if offset > 0 {
+		f, err = fileio.Append(path, offset)
+	} else {
+		f, err = fileio.Create(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sw := &sizeWriter{w: f, size: offset}
+	return &output{Writer: bufio.NewWriter(sw), file: f, size: sw}, nil
+}
+
+// sync saves buffered results to disk and returns the size of the file.
+func (o *output) sync() (int64, error) {
+	if err := o.Flush(); err != nil {
+		return 0, err
+	}
+	if s, ok := o.file.(interface{ Sync() error }); ok {
+		if err := s.Sync(); err != nil {
+			return 0, err
+		}
+	}
+	return o.size.size, nil
+}
+
+// close saves buffered results and closes the file.
+func (o *output) close() error {
+	if err := o.Flush(); err != nil {
+		return err
+	}
+	return o.file.Close()
+}
+
+// sizeWriter counts bytes written to a file.
+type sizeWriter struct {
+	w    io.Writer
+	size int64
+}
+
+func (sw *sizeWriter) Write(p []byte) (int, error) {
+	n, err := sw.w.Write(p)
+	sw.size += int64(n)
+	return n, err
+}
+
+// outputFlag opens the output file, if it is given. Output to files with
+// `.gz` and `.zst` extensions is compressed.
+func outputFlag(cmd *cobra.Command) {
+	path, _ := cmd.Flags().GetString("output")
+	if path == "" {
+		return
+	}
+	var err error
+	if outputFile, err = newOutput(path, chk.outputOffset()); err != nil {
+		log.Fatal(err)
+	}
+	out = outputFile
+}
+
+// closeOutput saves results written to the output file.
+func closeOutput() {
+	if outputFile == nil {
+		return
+	}
+	if err := outputFile.close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// openFiles opens files for reading and joins them into one input.
+// Files with `.gz` and `.zst` extensions are decompressed. The returned
+// function closes all the files.
+func openFiles(paths []string) (io.Reader, func()) {
+	rs := make([]io.Reader, 0, len(paths))
+	cs := make([]io.Closer, 0, len(paths))
+	closeAll := func() {
+		for i := range cs {
+			cs[i].Close()
+		}
+	}
+	for _, v := range paths {
+		f, err := fileio.Open(v, fileio.OptRawReader(prog.Reader))
+		if err != nil {
+			closeAll()
+			log.Fatal(fmt.Errorf("cannot open '%s': %w", v, err))
+		}
+		rs = append(rs, f)
+		cs = append(cs, f)
+	}
+	return fileio.JoinLines(rs...), closeAll
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/filter"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// nameFilter selects parsing results that go to the output.
+	nameFilter = filter.New()
+
+	// rejected receives results that do not satisfy nameFilter. If it is
+	// nil, such results are dropped.
+	rejected *output
+)
+
+// filterFlags sets nameFilter from flags and opens a file for rejected
+// results, if it is given.
+func filterFlags(cmd *cobra.Command, f gnfmt.Format) {
+	var err error
+	flags := cmd.Flags()
+	flt := filter.New()
+	if flt.MinQuality, err = flags.GetInt("min_quality"); err != nil {
+		log.Fatal(err)
+	}
+	if flt.MaxQuality, err = flags.GetInt("max_quality"); err != nil {
+		log.Fatal(err)
+	}
+	if err = flt.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	card, _ := flags.GetString("cardinality")
+	if flt.Cardinality, err = filter.ParseCardinality(card); err != nil {
+		log.Fatal(err)
+	}
+	warn, _ := flags.GetString("has_warning")
+	if flt.Warnings, err = filter.ParseWarnings(warn); err != nil {
+		log.Fatal(err)
+	}
+	flt.Hybrid, _ = flags.GetBool("hybrid")
+	flt.Surrogate, _ = flags.GetBool("surrogate")
+	flt.Bacteria, _ = flags.GetBool("bacteria")
+	nameFilter = flt
+
+	path, _ := flags.GetString("rejected")
+	if path == "" {
+		return
+	}
+	offset := chk.rejectedOffset()
+	if rejected, err = newOutput(path, offset); err != nil {
+		log.Fatal(err)
+	}
+	if f == gnfmt.CSV && offset == 0 {
+		header := parsed.HeaderCSV()
+		if uniq, _ := flags.GetBool("unique"); uniq {
+			header = parsed.HeaderCountedCSV()
+		}
+		fmt.Fprintln(rejected, header)
+	}
+}
+
+// closeRejected saves results written to the file with rejected results.
+func closeRejected() {
+	if rejected == nil {
+		return
+	}
+	if err := rejected.close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// printParsed prints a parsing result if it satisfies the filter,
+// otherwise it sends the result to the rejected file.
+func printParsed(p parsed.Parsed, f gnfmt.Format) {
+	if nameFilter.Match(p) {
+		fmt.Fprintln(out, p.Output(f))
+	} else if rejected != nil {
+		fmt.Fprintln(rejected, p.Output(f))
+	}
+}
+
+// printCounted prints a result of a unique name if it satisfies the
+// filter, otherwise it sends the result to the rejected file.
+func printCounted(c parsed.Counted, f gnfmt.Format) {
+	if nameFilter.Match(c.Parsed) {
+		fmt.Fprintln(out, c.Output(f))
+	} else if rejected != nil {
+		fmt.Fprintln(rejected, c.Output(f))
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/web"
 	"github.com/spf13/cobra"
 )
 
@@ -50,8 +51,8 @@ func ignoreHTMLTagsFlag(cmd *cobra.Command) {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	if ignoreTags {
-		opts = append(opts, gnparser.OptIgnoreHTMLTags(true))
+	if cmd.Flags().Changed("ignore_tags") {
+		opts = append(opts, gnparser.OptIgnoreHTMLTags(ignoreTags))
 	}
 }
 
@@ -61,8 +62,8 @@ func withDetailsFlag(cmd *cobra.Command) {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	if withDet {
-		opts = append(opts, gnparser.OptWithDetails(true))
+	if cmd.Flags().Changed("details") {
+		opts = append(opts, gnparser.OptWithDetails(withDet))
 	}
 }
 
@@ -72,8 +73,8 @@ func withNoOrderFlag(cmd *cobra.Command) {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	if withOrd {
-		opts = append(opts, gnparser.OptWithNoOrder(true))
+	if cmd.Flags().Changed("unordered") {
+		opts = append(opts, gnparser.OptWithNoOrder(withOrd))
 	}
 }
 
@@ -83,8 +84,8 @@ func withStreamFlag(cmd *cobra.Command) {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	if withDet {
-		opts = append(opts, gnparser.OptWithStream(true))
+	if cmd.Flags().Changed("stream") {
+		opts = append(opts, gnparser.OptWithStream(withDet))
 	}
 }
 
@@ -99,6 +100,48 @@ func batchSizeFlag(cmd *cobra.Command) {
 	}
 }
 
+func withDedupeFlag(cmd *cobra.Command) {
+	dedupe, err := cmd.Flags().GetBool("dedupe")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if cmd.Flags().Changed("dedupe") {
+		opts = append(opts, gnparser.OptWithDedupe(dedupe))
+	}
+}
+
+func withPublicationFlag(cmd *cobra.Command) {
+	pub, err := cmd.Flags().GetBool("publication")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if cmd.Flags().Changed("publication") {
+		opts = append(opts, gnparser.OptWithPublication(pub))
+	}
+}
+
+func uniqueFlag(cmd *cobra.Command) bool {
+	unique, err := cmd.Flags().GetBool("unique")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return unique
+}
+
+func cacheSizeFlag(cmd *cobra.Command) {
+	size, err := cmd.Flags().GetInt("cache_size")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if cmd.Flags().Changed("cache_size") {
+		opts = append(opts, gnparser.OptCacheSize(size))
+	}
+}
+
 func portFlag(cmd *cobra.Command) int {
 	webPort, err := cmd.Flags().GetInt("port")
 	if err != nil {
@@ -110,3 +153,67 @@ func portFlag(cmd *cobra.Command) int {
 	}
 	return webPort
 }
+
+func webLimitsFlags(cmd *cobra.Command) []web.Option {
+	var res []web.Option
+	flags := cmd.Flags()
+	maxNames, err := flags.GetInt("max_names")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if maxNames > 0 {
+		res = append(res, web.OptMaxNames(maxNames))
+	}
+
+	bodyLimit, err := flags.GetString("body_limit")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if bodyLimit != "" {
+		res = append(res, web.OptBodyLimit(bodyLimit))
+	}
+
+	timeout, err := flags.GetDuration("timeout")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if timeout > 0 {
+		res = append(res, web.OptTimeout(timeout))
+	}
+
+	maxConcurrent, err := flags.GetInt("max_concurrent")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if maxConcurrent > 0 {
+		res = append(res, web.OptMaxConcurrent(maxConcurrent))
+	}
+
+	rate, err := flags.GetFloat64("rate_limit")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	burst, err := flags.GetInt("rate_burst")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if rate > 0 {
+		res = append(res, web.OptRateLimit(rate, burst))
+	}
+
+	trustProxy, err := flags.GetBool("trust_proxy")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if trustProxy {
+		res = append(res, web.OptTrustProxy(true))
+	}
+	return res
+}
@@ -1,64 +1,79 @@
 package cmd
 
 import (
-	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"log"
-	"sync"
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/fileio"
 )
 
-func parseBatch(
+// parseLines parses names from lines of the input by batches, or one by
+// one in the stream mode, and prints results in the order of the input.
+func parseLines(
 	gnp gnparser.GNparser,
 	f io.Reader,
-	quiet bool,
 ) {
-	batch := make([]string, batchSize)
-	chOut := make(chan []parsed.Parsed)
-	var wg sync.WaitGroup
+	frmt := gnp.Format()
+	if frmt == gnfmt.CSV && chk.outputOffset() == 0 {
+		fmt.Fprintln(out, parsed.HeaderCSV())
+	}
+	err := gnp.ParseReader(context.Background(), f, func(p parsed.Parsed) error {
+		printParsed(p, frmt)
+		chk.add(1)
+		prog.Add(1)
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
 
-	wg.Add(1)
-	go processResults(chOut, &wg, gnp.Format())
+// parseUnique parses every unique name of the input once, and prints
+// results for unique names with the number of their occurrences. Results
+// are printed after the whole input is processed.
+func parseUnique(
+	gnp gnparser.GNparser,
+	f io.Reader,
+) {
+	var res []parsed.Counted
+	seen := make(map[string]int)
+	batch := make([]string, 0, batchSize)
+	process := func() {
+		for _, v := range gnp.ParseNamesUnique(batch) {
+			seen[v.Verbatim] = len(res)
+			res = append(res, v)
+		}
+		batch = batch[:0]
+	}
 
-	sc := bufio.NewScanner(f)
-	var i, count int
+	sc := fileio.NewScanner(f)
 	for sc.Scan() {
-		batch[count] = sc.Text()
-		count++
-		if count == batchSize {
-			i++
-			if !quiet {
-				log.Printf("Parsing %d-th line\n", count*i)
-			}
-			chOut <- gnp.ParseNames(batch)
-			batch = make([]string, batchSize)
-			count = 0
+		name := sc.Text()
+		prog.Add(1)
+		if i, ok := seen[name]; ok {
+			res[i].Count++
+		} else {
+			batch = append(batch, name)
+		}
+		if len(batch) == batchSize {
+			process()
 		}
 	}
-	chOut <- gnp.ParseNames(batch[:count])
-	close(chOut)
+	process()
 	if err := sc.Err(); err != nil {
 		log.Panic(err)
 	}
-	wg.Wait()
-}
 
-func processResults(
-	out <-chan []parsed.Parsed,
-	wg *sync.WaitGroup,
-	f gnfmt.Format,
-) {
-	defer wg.Done()
-	if f == gnfmt.CSV {
-		fmt.Println(parsed.HeaderCSV())
+	frmt := gnp.Format()
+	if frmt == gnfmt.CSV {
+		fmt.Fprintln(out, parsed.HeaderCountedCSV())
 	}
-	for pr := range out {
-		for i := range pr {
-			fmt.Println(pr[i].Output(f))
-		}
+	for i := range res {
+		printCounted(res[i], frmt)
 	}
 }
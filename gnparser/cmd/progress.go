@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"log"
+	"os"
+
+	"github.com/gnames/gnparser/io/progress"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// prog reports progress of parsing. It is nil if reports are not needed.
+	prog *progress.Reporter
+
+	// progOpts keep settings of progress reports.
+	progOpts []progress.Option
+)
+
+// progressFlags prepares settings of progress reports. Human-readable
+// reports go to STDERR unless quiet is true, JSON events go to the file
+// descriptor given by the progress_fd flag.
+func progressFlags(cmd *cobra.Command, quiet bool) {
+	flags := cmd.Flags()
+	if !quiet {
+		progOpts = append(progOpts, progress.OptText(os.Stderr))
+	}
+	if fd, _ := flags.GetInt("progress_fd"); fd > 0 {
+		f := os.NewFile(uintptr(fd), "progress")
+		if f == nil {
+			log.Fatalf("Cannot use file descriptor %d for progress.", fd)
+		}
+		progOpts = append(progOpts, progress.OptJSON(f))
+	}
+	if d, _ := flags.GetDuration("progress_interval"); d != 0 {
+		progOpts = append(progOpts, progress.OptInterval(d))
+	}
+}
+
+// startProgress starts progress reports for an input of the given size.
+// The size is 0 if it is unknown.
+func startProgress(totalBytes int64) {
+	prog = progress.New(totalBytes, progOpts...)
+}
+
+// stdinSize returns the size of STDIN if it is redirected from a file,
+// otherwise it returns 0.
+func stdinSize() int64 {
+	fi, err := os.Stdin.Stat()
+	if err != nil || !fi.Mode().IsRegular() {
+		return 0
+	}
+	return fi.Size()
+}
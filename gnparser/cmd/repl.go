@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/repl"
+	"github.com/spf13/cobra"
+)
+
+// replCmd starts an interactive session of parsing.
+var replCmd = &cobra.Command{
+	Use:   "repl",
+	Short: "Starts an interactive mode for parsing names one by one.",
+	Long: `
+Starts an interactive mode that parses each entered name-string and shows
+its canonical forms, authorship, quality and warnings. The mode supports
+line editing and keeps history of entered lines between sessions.
+
+Commands:
+  :details          toggle details of parsing results
+  :format [name]    set format: view, compact, pretty or csv
+  :explain [name]   show the explain tree of a name, or of the last name
+  :compare          compare the last two names
+  :help             show help
+  :quit             exit (also Ctrl-D)
+
+To start the interactive mode:
+gnparser repl
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ignoreHTMLTagsFlag(cmd)
+		withDetailsFlag(cmd)
+		cfg := gnparser.NewConfig(opts...)
+
+		replOpts := []repl.Option{repl.OptHistory(historyPath())}
+		if noColor, _ := cmd.Flags().GetBool("no_color"); noColor {
+			replOpts = append(replOpts, repl.OptColor(false))
+		}
+		if err := repl.New(cfg, replOpts...).Run(); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	replCmd.Flags().BoolP("details", "d", false, "provides more details")
+	replCmd.Flags().BoolP("ignore_tags", "i", false,
+		"ignore HTML entities and tags when parsing.")
+	replCmd.Flags().Bool("no_color", false, "do not use colors.")
+	rootCmd.AddCommand(replCmd)
+}
+
+// historyPath returns the path to the file with the history of the
+// interactive mode. It returns an empty string if the history cannot
+// be kept.
+func historyPath() string {
+	path, err := configPath()
+	if err != nil {
+		return ""
+	}
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "repl_history")
+}
@@ -9,8 +9,8 @@ import (
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/fileio"
 	"github.com/gnames/gnparser/io/web"
-	"github.com/gnames/gnsys"
 	"github.com/spf13/cobra"
 )
 
@@ -21,7 +21,7 @@ var (
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
-	Use:   "gnparser file_or_name",
+	Use:   "gnparser [files_or_name]",
 	Short: "Parses scientific names into their semantic elements.",
 	Long: `
 Parses scientific names into their semantic elements.
@@ -45,13 +45,47 @@ gnparser "Homo sapiens Linnaeus 1758" -d -f pretty
 To parse many names from a file (one name per line):
 gnparser names.txt [flags] > parsed_names.txt
 
+To parse names from several files, including compressed ones, and save
+compressed results:
+gnparser names1.txt names2.txt.gz "data/*.zst" -o parsed_names.csv.gz
+
 To leave HTML tags and entities intact when parsing (faster)
 gnparser names.txt -n > parsed_names.txt
 
 To start web service on port 8080 with 5 concurrent jobs:
 gnparser -j 5 -p 8080
+
+To start web service that accepts up to 1000 names per request, and
+allows 10 requests per second from one IP:
+gnparser -p 8080 --max_names 1000 --rate_limit 10
+
+To reuse results of repeated names from a cache of 100000 names:
+gnparser names.txt --cache_size 100000 > parsed_names.txt
+
+To output only unique names with the number of their occurrences:
+gnparser names.txt --unique > unique_names.txt
+
+To output only binomials with parsing quality 1 or 2, and save other
+names to a separate file:
+gnparser names.txt --cardinality 2 --max_quality 2 --rejected other.csv
+
+To output only names with unparsed tails:
+gnparser names.txt --has_warning TAIL
+
+To save progress of a long run and to resume it after a crash:
+gnparser names.txt -o parsed.csv --checkpoint parsed.chk
+gnparser names.txt -o parsed.csv --checkpoint parsed.chk --resume
+
+To create a configuration file with default settings:
+gnparser config init
+
+Settings can also be given by environment variables, for example:
+GNPARSER_FORMAT=compact gnparser "Homo sapiens"
  `,
 
+	// names are given as arguments, so they are not treated as subcommands.
+	Args: cobra.ArbitraryArgs,
+
 	Run: func(cmd *cobra.Command, args []string) {
 		if versionFlag(cmd) {
 			os.Exit(0)
@@ -63,27 +97,46 @@ gnparser -j 5 -p 8080
 		withDetailsFlag(cmd)
 		withStreamFlag(cmd)
 		withNoOrderFlag(cmd)
+		withDedupeFlag(cmd)
+		withPublicationFlag(cmd)
 		batchSizeFlag(cmd)
+		cacheSizeFlag(cmd)
 		port := portFlag(cmd)
+		if port == 0 {
+			port = cfgPort
+		}
 		cfg := gnparser.NewConfig(opts...)
 		batchSize = cfg.BatchSize
 
 		if port != 0 {
-			cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
+			cfg := gnparser.NewConfig(
+				gnparser.OptFormat("compact"),
+				gnparser.OptCacheSize(cfg.CacheSize),
+			)
 			gnp := gnparser.New(cfg)
-			gnps := web.NewGNparserService(gnp, port)
+			gnps := web.NewGNparserService(gnp, port, webLimitsFlags(cmd)...)
 			web.Run(gnps)
 			os.Exit(0)
 		}
 
 		quiet, _ := cmd.Flags().GetBool("quiet")
+		unique := uniqueFlag(cmd)
+		progressFlags(cmd, quiet)
+		checkpointFlags(cmd, cfg, unique)
+		filterFlags(cmd, cfg.Format)
+		outputFlag(cmd)
 
 		if len(args) == 0 {
-			processStdin(cmd, cfg, quiet)
+			processStdin(cmd, cfg, quiet, unique)
+			chk.save()
+			closeRejected()
+			closeOutput()
 			os.Exit(0)
 		}
-		data := getInput(cmd, args)
-		parse(data, cfg, quiet)
+		parse(args, cfg, quiet, unique)
+		chk.save()
+		closeRejected()
+		closeOutput()
 	},
 }
 
@@ -98,48 +151,132 @@ func Execute() {
 }
 
 func init() {
+	cobra.OnInitialize(initConfig)
+
 	rootCmd.PersistentFlags().BoolP("version", "V", false,
 		"shows build version and date, ignores other flags.")
 
 	rootCmd.Flags().IntP("batch_size", "b", 0,
 		"maximum number of names in a batch send for processing.")
 
+	rootCmd.Flags().Bool("bacteria", false,
+		"filter: output only bacterial names.")
+
+	rootCmd.Flags().Int("cache_size", 0,
+		"keeps up to this number of parsed names in a cache, 0 means no cache.")
+
+	rootCmd.Flags().String("checkpoint", "",
+		"saves progress to the file, so parsing can be resumed with --resume.")
+
+	rootCmd.Flags().String("cardinality", "",
+		"filter: output only names with given cardinalities, for example '2,3'.")
+
+	rootCmd.Flags().Bool("dedupe", false,
+		"parse every unique name of a batch only once.")
+
 	rootCmd.Flags().BoolP("details", "d", false, "provides more details")
 
 	formatHelp := "sets output format. Can be one of:\n  " +
 		"'csv', 'compact', 'pretty'"
 	rootCmd.Flags().StringP("format", "f", "", formatHelp)
 
+	rootCmd.Flags().String("body_limit", "",
+		"web-service: maximum size of a request body, for example '10M'.")
+
+	rootCmd.Flags().String("has_warning", "",
+		"filter: output only names with one of given warning codes, for example\n"+
+			"  'TAIL,AUTH_EX'.")
+
+	rootCmd.Flags().Bool("hybrid", false,
+		"filter: output only hybrid names.")
+
 	rootCmd.Flags().BoolP("ignore_tags", "i", false,
 		"ignore HTML entities and tags when parsing.")
 
 	rootCmd.Flags().IntP("jobs", "j", 0,
 		"nubmer of threads to run. CPU's threads number is the default.")
 
+	rootCmd.Flags().Int("max_concurrent", 0,
+		"web-service: maximum number of requests processed simultaneously.")
+
+	rootCmd.Flags().Int("max_names", 0,
+		"web-service: maximum number of names in one request.")
+
+	rootCmd.Flags().Int("max_quality", 4,
+		"filter: output only names with parsing quality not higher than given.")
+
+	rootCmd.Flags().Int("min_quality", 0,
+		"filter: output only names with parsing quality not lower than given.")
+
+	rootCmd.Flags().StringP("output", "o", "",
+		"write results to the file, '.gz' and '.zst' files are compressed.")
+
 	rootCmd.Flags().IntP("port", "p", 0,
 		"starts web site and REST server on the port.")
 
+	rootCmd.Flags().Int("progress_fd", 0,
+		"writes progress as JSON events to the given file descriptor.")
+
+	rootCmd.Flags().Duration("progress_interval", 0,
+		"minimal time between progress reports, '5s' by default.")
+
+	rootCmd.Flags().Bool("publication", false,
+		"cuts off references to publications from the end of names.")
+
 	rootCmd.Flags().BoolP("quiet", "q", false, "do not show progress")
 
+	rootCmd.Flags().Int("rate_burst", 0,
+		"web-service: number of requests allowed above the rate limit in a burst.")
+
+	rootCmd.Flags().Float64("rate_limit", 0,
+		"web-service: requests per second allowed from one IP, 0 means no limit.")
+
+	rootCmd.Flags().String("rejected", "",
+		"filter: write names that did not pass filters to the given file.")
+
+	rootCmd.Flags().Bool("resume", false,
+		"resumes parsing from the file given by --checkpoint.")
+
 	rootCmd.Flags().BoolP("stream", "s", false,
 		"parse one name at a time in a stream instead of a batch parsing")
 
+	rootCmd.Flags().Bool("surrogate", false,
+		"filter: output only surrogate names.")
+
+	rootCmd.Flags().Duration("timeout", 0,
+		"web-service: maximum duration of a request, for example '30s'.")
+
+	rootCmd.Flags().Bool("trust_proxy", false,
+		"web-service: take client IP for rate limit from X-Forwarded-For header.")
+
+	rootCmd.Flags().Bool("unique", false,
+		"output only unique names with the number of their occurrences.")
+
 	rootCmd.Flags().BoolP("unordered", "u", false,
 		"output and input are in different order")
 }
 
-func processStdin(cmd *cobra.Command, cfg gnparser.Config, quiet bool) {
+func processStdin(
+	cmd *cobra.Command,
+	cfg gnparser.Config,
+	quiet, unique bool,
+) {
 	if !checkStdin() {
 		_ = cmd.Help()
 		return
 	}
 	gnp := gnparser.New(cfg)
 
-	if cfg.WithStream {
-		parseStream(gnp, os.Stdin, quiet)
-	} else {
-		parseBatch(gnp, os.Stdin, quiet)
+	startProgress(stdinSize())
+	f := chk.skipInput(prog.Reader(os.Stdin))
+	switch {
+	case unique:
+		parseUnique(gnp, f)
+	default:
+		parseLines(gnp, f)
 	}
+	prog.Finish()
+	logCacheStats(gnp, quiet)
 }
 
 func checkStdin() bool {
@@ -151,49 +288,59 @@ func checkStdin() bool {
 	return (stat.Mode() & os.ModeCharDevice) == 0
 }
 
-func getInput(cmd *cobra.Command, args []string) string {
-	var data string
-	switch len(args) {
-	case 1:
-		data = args[0]
-	default:
-		_ = cmd.Help()
-		os.Exit(0)
-	}
-	return data
-}
-
+// parse processes files given as arguments. The arguments can be paths or
+// glob patterns. If the only argument is not a file, it is parsed as
+// a name-string.
 func parse(
-	data string,
+	args []string,
 	cfg gnparser.Config,
-	quiet bool,
+	quiet, unique bool,
 ) {
 	gnp := gnparser.New(cfg)
 
-	path := string(data)
-	exists, _ := gnsys.FileExists(path)
-	if exists {
-		f, err := os.OpenFile(path, os.O_RDONLY, os.ModePerm)
-		if err != nil {
-			log.Fatal(err)
-			os.Exit(1)
-		}
-		if cfg.WithStream {
-			parseStream(gnp, f, quiet)
-		} else {
-			parseBatch(gnp, f, quiet)
-		}
-		f.Close()
-	} else {
-		parseString(gnp, data)
+	paths, err := fileio.Expand(args)
+	if len(args) == 1 && (err != nil || len(paths) == 0) {
+		parseString(gnp, args[0])
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	size, err := fileio.Size(paths)
+	if err != nil {
+		log.Fatal(err)
+	}
+	startProgress(size)
+	f, closeFiles := openFiles(paths)
+	defer closeFiles()
+	f = chk.skipInput(f)
+	switch {
+	case unique:
+		parseUnique(gnp, f)
+	default:
+		parseLines(gnp, f)
+	}
+	prog.Finish()
+	logCacheStats(gnp, quiet)
+}
+
+// logCacheStats shows hits and misses of the parsing cache, if the cache
+// is used.
+func logCacheStats(gnp gnparser.GNparser, quiet bool) {
+	stats := gnp.CacheStats()
+	if quiet || stats.Size == 0 {
+		return
 	}
+	log.Printf("Cache hits: %d, misses: %d.", stats.Hits, stats.Misses)
 }
 
 func parseString(gnp gnparser.GNparser, name string) {
 	res := gnp.ParseName(name)
 	f := gnp.Format()
-	if f == gnfmt.CSV {
-		fmt.Println(parsed.HeaderCSV())
+	if f == gnfmt.CSV && chk.outputOffset() == 0 {
+		fmt.Fprintln(out, parsed.HeaderCSV())
 	}
-	fmt.Println(res.Output(f))
+	printParsed(res, f)
+	chk.add(1)
 }
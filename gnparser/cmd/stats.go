@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/stats"
+	"github.com/gnames/gnparser/io/fileio"
+	"github.com/spf13/cobra"
+)
+
+// statsCmd parses all names of an input and prints summary statistics.
+var statsCmd = &cobra.Command{
+	Use:   "stats [files]",
+	Short: "Prints summary statistics of parsing results.",
+	Long: `
+Parses all names from files or from the standard input and prints
+summary statistics: counts by quality, cardinality, warnings, hybrid and
+surrogate annotations, bacteria and viruses, the most frequent genera and
+tails, and throughput.
+
+To get statistics as text:
+gnparser stats names.txt
+
+To get statistics as JSON with 20 most frequent genera and tails:
+gnparser stats names.txt -f pretty --top 20
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		jobsNumFlag(cmd)
+		ignoreHTMLTagsFlag(cmd)
+		// names go one by one through ParseNameStream, so memory does not
+		// grow with the size of batches, and the order is not needed.
+		opts = append(opts,
+			gnparser.OptWithStream(true),
+			gnparser.OptWithNoOrder(true),
+		)
+		cfg := gnparser.NewConfig(opts...)
+		gnp := gnparser.New(cfg)
+
+		top, _ := cmd.Flags().GetInt("top")
+		frmt, _ := cmd.Flags().GetString("format")
+		quiet, _ := cmd.Flags().GetBool("quiet")
+		progressFlags(cmd, quiet)
+
+		var f io.Reader
+		if len(args) > 0 {
+			paths, err := fileio.Expand(args)
+			if err != nil {
+				log.Fatal(err)
+			}
+			size, err := fileio.Size(paths)
+			if err != nil {
+				log.Fatal(err)
+			}
+			startProgress(size)
+			var closeFiles func()
+			f, closeFiles = openFiles(paths)
+			defer closeFiles()
+		} else if checkStdin() {
+			startProgress(stdinSize())
+			f = prog.Reader(os.Stdin)
+		} else {
+			_ = cmd.Help()
+			return
+		}
+
+		res := collectStats(gnp, f, top)
+		prog.Finish()
+		if frmt == "text" {
+			fmt.Print(res.Text())
+			return
+		}
+		jsonFrmt, err := gnfmt.NewFormat(frmt)
+		if err != nil || jsonFrmt == gnfmt.CSV {
+			log.Fatalf("Unknown format '%s', use 'text', 'compact' or 'pretty'.", frmt)
+		}
+		enc := gnfmt.GNjson{Pretty: jsonFrmt == gnfmt.PrettyJSON}
+		out, err := enc.Encode(res)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(out))
+	},
+}
+
+func init() {
+	statsCmd.Flags().StringP("format", "f", "text",
+		"sets output format. Can be one of:\n  'text', 'compact', 'pretty'")
+	statsCmd.Flags().BoolP("ignore_tags", "i", false,
+		"ignore HTML entities and tags when parsing.")
+	statsCmd.Flags().IntP("jobs", "j", 0,
+		"nubmer of threads to run. CPU's threads number is the default.")
+	statsCmd.Flags().Int("progress_fd", 0,
+		"writes progress as JSON events to the given file descriptor.")
+	statsCmd.Flags().Duration("progress_interval", 0,
+		"minimal time between progress reports, '5s' by default.")
+	statsCmd.Flags().BoolP("quiet", "q", false, "do not show progress")
+	statsCmd.Flags().Int("top", 10,
+		"number of the most frequent genera and tails to show.")
+	rootCmd.AddCommand(statsCmd)
+}
+
+// collectStats parses names from an input and collects their
+// statistics.
+func collectStats(
+	gnp gnparser.GNparser,
+	f io.Reader,
+	top int,
+) *stats.Stats {
+	res := stats.New(top)
+	err := gnp.ParseReader(context.Background(), f, func(p parsed.Parsed) error {
+		res.Add(p)
+		prog.Add(1)
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	res.Finish()
+	return res
+}
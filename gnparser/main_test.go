@@ -1,6 +1,11 @@
 package main
 
 import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -70,3 +75,254 @@ func TestStdin(t *testing.T) {
 		assert.Contains(t, c.Stdout(), ",Bubo,")
 	})
 }
+
+func TestConfig(t *testing.T) {
+	home := t.TempDir()
+	env := []string{"HOME=" + home, "PATH=" + os.Getenv("PATH")}
+
+	t.Run("creates config file", func(t *testing.T) {
+		c := testcli.Command("gnparser", "config", "init")
+		c.SetEnv(env)
+		c.Run()
+		assert.True(t, c.Success())
+		path := filepath.Join(home, ".config", "gnparser", "gnparser.yaml")
+		assert.FileExists(t, path)
+
+		c = testcli.Command("gnparser", "config", "init")
+		c.SetEnv(env)
+		c.Run()
+		assert.True(t, c.Failure())
+		assert.Contains(t, c.Stdout(), "--force")
+	})
+
+	t.Run("takes settings from environment", func(t *testing.T) {
+		c := testcli.Command("gnparser", "Homo sapiens")
+		c.SetEnv(append(env, "GNPARSER_FORMAT=compact"))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), `"verbatim":"Homo sapiens"`)
+	})
+
+	t.Run("flags override environment", func(t *testing.T) {
+		c := testcli.Command("gnparser", "Homo sapiens", "-f", "csv")
+		c.SetEnv(append(env, "GNPARSER_FORMAT=compact"))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), ",Homo sapiens,2")
+	})
+
+	t.Run("false flags override config file", func(t *testing.T) {
+		path := filepath.Join(home, ".config", "gnparser", "gnparser.yaml")
+		cfg := []byte("Format: compact\nWithDetails: true\n")
+		err := os.WriteFile(path, cfg, 0644)
+		assert.Nil(t, err)
+
+		c := testcli.Command("gnparser", "Homo sapiens")
+		c.SetEnv(env)
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), `"details":`)
+
+		c = testcli.Command("gnparser", "Homo sapiens", "--details=false")
+		c.SetEnv(env)
+		c.Run()
+		assert.True(t, c.Success())
+		assert.NotContains(t, c.Stdout(), `"details":`)
+
+		c = testcli.Command("gnparser", "Homo sapiens", "--details=false")
+		c.SetEnv(append(env, "GNPARSER_WITH_DETAILS=true"))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.NotContains(t, c.Stdout(), `"details":`)
+	})
+}
+
+func TestUnique(t *testing.T) {
+	c := testcli.Command("gnparser", "--unique", "-q", "-b", "2")
+	c.SetStdin(strings.NewReader("Bubo bubo\nAus bus\nBubo bubo\nBubo bubo\n"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), ",Quality,Count")
+	assert.Contains(t, c.Stdout(), ",Bubo bubo,2,Bubo bub,Bubo bubo,Bubo bubo,,,1,3")
+	assert.Contains(t, c.Stdout(), ",Aus bus,2,Aus bus,Aus bus,Aus bus,,,1,1")
+}
+
+func TestStats(t *testing.T) {
+	c := testcli.Command("gnparser", "stats")
+	c.SetStdin(strings.NewReader("Bubo bubo\nBubo bubo L.\nAus bus\n"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), "Names:         3")
+	assert.Contains(t, c.Stdout(), "Top genera:")
+
+	c = testcli.Command("gnparser", "stats", "-f", "compact")
+	c.SetStdin(strings.NewReader("Bubo bubo\nBubo bubo L.\nAus bus\n"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), `"topGenera":[{"value":"Bubo","count":2}`)
+}
+
+func TestExplain(t *testing.T) {
+	c := testcli.Command("gnparser", "explain", "Bubo bubo sensu Smith")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), "Annotation:    cut at 9")
+	assert.Contains(t, c.Stdout(), `SciName [0:9] "Bubo bubo"`)
+
+	c = testcli.Command("gnparser", "explain", "Bubo bubo", "-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), `"tree":{"rule":"SciName"`)
+}
+
+func TestFilter(t *testing.T) {
+	rejected := filepath.Join(t.TempDir(), "rejected.csv")
+	c := testcli.Command("gnparser", "-q", "--cardinality", "2",
+		"--max_quality", "2", "--rejected", rejected)
+	c.SetStdin(strings.NewReader("Bubo bubo\nBubo\nAus bus junk 1234\n"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), ",Bubo bubo,2,")
+	assert.NotContains(t, c.Stdout(), ",Bubo,1,")
+	assert.NotContains(t, c.Stdout(), ",Aus bus junk 1234,")
+	rej, err := os.ReadFile(rejected)
+	assert.Nil(t, err)
+	assert.Contains(t, string(rej), "Id,Verbatim,")
+	assert.Contains(t, string(rej), ",Bubo,1,")
+	assert.Contains(t, string(rej), ",Aus bus junk 1234,")
+
+	c = testcli.Command("gnparser", "-q", "-s", "--has_warning", "TAIL")
+	c.SetStdin(strings.NewReader("Bubo bubo\nAus bus junk 1234\n"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.NotContains(t, c.Stdout(), ",Bubo bubo,")
+	assert.Contains(t, c.Stdout(), ",Aus bus junk 1234,")
+}
+
+func TestFiles(t *testing.T) {
+	dir := t.TempDir()
+	names1 := filepath.Join(dir, "names1.txt")
+	err := os.WriteFile(names1, []byte("Bubo bubo\nPomatomus saltatrix"), 0644)
+	assert.Nil(t, err)
+
+	names2 := filepath.Join(dir, "names2.txt.zst")
+	c := testcli.Command("gnparser", "-q", names1, "-f", "compact", "-o", names2)
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Equal(t, "", c.Stdout())
+	raw, err := os.ReadFile(names2)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x28, 0xb5, 0x2f, 0xfd}, raw[:4])
+
+	long := strings.Repeat("Aus bus ", 10_000)
+	names3 := filepath.Join(dir, "names3.txt")
+	assert.Nil(t, os.WriteFile(names3, []byte(long+"\nHomo sapiens\n"), 0644))
+
+	out := filepath.Join(dir, "out.csv.gz")
+	c = testcli.Command("gnparser", "-q", names1, filepath.Join(dir, "*.zst"),
+		names3, "-o", out)
+	c.Run()
+	assert.True(t, c.Success())
+
+	f, err := os.Open(out)
+	assert.Nil(t, err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	assert.Nil(t, err)
+	raw, err = io.ReadAll(gz)
+	assert.Nil(t, err)
+	res := string(raw)
+	assert.Equal(t, 1, strings.Count(res, "Id,Verbatim,"))
+	assert.Equal(t, 2, strings.Count(res, ",Bubo bubo,"))
+	for _, v := range []string{
+		",Pomatomus saltatrix,", `,"{""parsed"":true`, ",Homo sapiens,",
+	} {
+		assert.Contains(t, res, v)
+	}
+
+	c = testcli.Command("gnparser", "-q", names1, filepath.Join(dir, "none.txt"))
+	c.Run()
+	assert.False(t, c.Success())
+}
+
+func TestCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	names := filepath.Join(dir, "names.txt")
+	err := os.WriteFile(names,
+		[]byte("Bubo bubo\nBubo\nPomatomus saltatrix\nHomo sapiens\nAus bus\n"), 0644)
+	assert.Nil(t, err)
+	out := filepath.Join(dir, "out.csv")
+	chk := filepath.Join(dir, "out.chk")
+
+	for _, stream := range []bool{false, true} {
+		args := []string{"-q", "-b", "2", names, "-o", out, "--checkpoint", chk}
+		if stream {
+			args = append(args, "-s")
+		}
+		c := testcli.Command("gnparser", args...)
+		c.Run()
+		assert.True(t, c.Success())
+		full, err := os.ReadFile(out)
+		assert.Nil(t, err)
+		assert.Equal(t, 6, strings.Count(string(full), "\n"))
+		saved, err := os.ReadFile(chk)
+		assert.Nil(t, err)
+		assert.Contains(t, string(saved), `"lines":5`)
+
+		// simulate a crash after the header and two names were saved, and
+		// a part of the next name was written.
+		offset := 0
+		for i := 0; i < 3; i++ {
+			offset += strings.Index(string(full[offset:]), "\n") + 1
+		}
+		crashed := append(full[:offset:offset], []byte("garbage")...)
+		assert.Nil(t, os.WriteFile(out, crashed, 0644))
+		state := fmt.Sprintf(`{"lines":2,"outputOffset":%d}`, offset)
+		assert.Nil(t, os.WriteFile(chk, []byte(state), 0644))
+
+		c = testcli.Command("gnparser", append(args, "--resume")...)
+		c.Run()
+		assert.True(t, c.Success())
+		res, err := os.ReadFile(out)
+		assert.Nil(t, err)
+		assert.Equal(t, string(full), string(res))
+	}
+
+	c := testcli.Command("gnparser", names, "--checkpoint", chk)
+	c.Run()
+	assert.False(t, c.Success())
+}
+
+func TestProgress(t *testing.T) {
+	names := filepath.Join(t.TempDir(), "names.txt")
+	err := os.WriteFile(names, []byte("Bubo bubo\nPomatomus saltatrix\n"), 0644)
+	assert.Nil(t, err)
+
+	c := testcli.Command("gnparser", names, "-q", "--progress_fd", "2")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stderr(), `{"event":"done","names":2,`)
+	assert.Contains(t, c.Stderr(), `"totalBytes":30,"percent":100,`)
+	assert.NotContains(t, c.Stderr(), "Finished")
+
+	c = testcli.Command("gnparser", "stats", names)
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stderr(), "Finished, parsed 2 names")
+}
+
+func TestREPL(t *testing.T) {
+	home := t.TempDir()
+	c := testcli.Command("gnparser", "repl")
+	c.SetEnv([]string{"HOME=" + home, "PATH=" + os.Getenv("PATH")})
+	c.SetStdin(strings.NewReader("Bubo bubo L.\nBubo bubo\n:compare\n:quit\n"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), "authorship:  L.")
+	assert.Contains(t, c.Stdout(), "canonical:   same Bubo bubo")
+	hist, err := os.ReadFile(
+		filepath.Join(home, ".config", "gnparser", "repl_history"),
+	)
+	assert.Nil(t, err)
+	assert.Contains(t, string(hist), ":compare")
+}
@@ -0,0 +1,76 @@
+package gnparser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gnames/gnparser/ent/cache"
+	"github.com/gnames/gnparser/ent/nameidx"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+// brokenCache panics on one of the name-strings to emulate a failure of
+// parsing.
+type brokenCache struct {
+	cache.Cache
+	broken string
+}
+
+func (bc brokenCache) Get(k cache.Key) (parsed.Parsed, bool) {
+	if k.Verbatim == bc.broken {
+		panic("broken name")
+	}
+	return parsed.Parsed{}, false
+}
+
+func (bc brokenCache) Set(cache.Key, parsed.Parsed) {}
+
+func brokenParser() gnparser {
+	gnp := New(NewConfig(OptIsTest(true))).(gnparser)
+	gnp.cache = brokenCache{broken: "Aus bus"}
+	return gnp
+}
+
+func TestParseNamesError(t *testing.T) {
+	gnp := brokenParser()
+	names := []string{"Bubo bubo", "Aus bus", "Cus dus"}
+	res, err := gnp.ParseNamesCtx(context.Background(), names)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Aus bus")
+	assert.Equal(t, 3, len(res))
+	assert.True(t, res[0].Parsed)
+	assert.False(t, res[1].Parsed)
+	assert.Equal(t, "Aus bus", res[1].Verbatim)
+	assert.Equal(t, "test_version", res[1].ParserVersion)
+	assert.True(t, res[2].Parsed)
+
+	assert.Equal(t, 3, len(gnp.ParseNames(names)))
+}
+
+func TestParseNameStreamError(t *testing.T) {
+	gnp := brokenParser()
+	names := []string{"Bubo bubo", "Aus bus", "Cus dus"}
+	chIn := make(chan nameidx.NameIdx)
+	chOut := make(chan parsed.Parsed)
+	go func() {
+		defer close(chIn)
+		for i, v := range names {
+			chIn <- nameidx.NameIdx{Index: i, NameString: v}
+		}
+	}()
+	chErr := make(chan error)
+	go func() {
+		chErr <- gnp.ParseNameStreamCtx(context.Background(), chIn, chOut)
+	}()
+	var res []parsed.Parsed
+	for p := range chOut {
+		res = append(res, p)
+	}
+	err := <-chErr
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Aus bus")
+	assert.Equal(t, 3, len(res))
+	assert.False(t, res[1].Parsed)
+	assert.Equal(t, "Cus dus", res[2].Verbatim)
+}
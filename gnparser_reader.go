@@ -0,0 +1,194 @@
+package gnparser
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/nameidx"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/fileio"
+)
+
+// ParseIter takes name-strings from the next function until it returns
+// false, and calls fn for every parsing result in the order of the input,
+// unless Config.WithNoOrder is true. Names are parsed by batches of
+// Config.BatchSize, or one by one if Config.WithStream is true. The next
+// function is called from another goroutine, but never concurrently, and it
+// is not called anymore after ParseIter returns.
+//
+// Parsing stops if fn returns an error, and the error is returned. It also
+// stops if the context is cancelled, and the error of the context is
+// returned. Names that failed to parse are given to fn as not parsed, and
+// the error of the first failed name is returned at the end.
+func (gnp gnparser) ParseIter(
+	ctx context.Context,
+	next func() (string, bool),
+	fn func(parsed.Parsed) error,
+) error {
+	ctxIter, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chOut := make(chan parsed.Parsed)
+	chErr := make(chan error, 1)
+	if gnp.cfg.WithStream {
+		go gnp.iterStream(ctxIter, next, chOut, chErr)
+	} else {
+		go gnp.iterBatch(ctxIter, next, chOut, chErr)
+	}
+
+	var err error
+	for p := range chOut {
+		if err != nil {
+			continue
+		}
+		if err = fn(p); err != nil {
+			cancel()
+		}
+	}
+	errParse := <-chErr
+	if err != nil {
+		return err
+	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	return errParse
+}
+
+// ParseReader parses name-strings from lines of a reader, and calls fn for
+// every parsing result in the order of the input. Lines can be of any
+// length. It stops and returns an error the same way as ParseIter, and it
+// also returns an error of reading.
+func (gnp gnparser) ParseReader(
+	ctx context.Context,
+	r io.Reader,
+	fn func(parsed.Parsed) error,
+) error {
+	sc := fileio.NewScanner(r)
+	next := func() (string, bool) {
+		if sc.Scan() {
+			return sc.Text(), true
+		}
+		return "", false
+	}
+	err := gnp.ParseIter(ctx, next, fn)
+	if errRead := sc.Err(); errRead != nil {
+		return errRead
+	}
+	return err
+}
+
+// ParseToWriter parses name-strings from lines of a reader, and writes
+// results to a writer in the format of the GNparser. CSV output starts with
+// a header.
+func (gnp gnparser) ParseToWriter(
+	ctx context.Context,
+	r io.Reader,
+	w io.Writer,
+) error {
+	f := gnp.Format()
+	if f == gnfmt.CSV {
+		if _, err := fmt.Fprintln(w, parsed.HeaderCSV()); err != nil {
+			return err
+		}
+	}
+	return gnp.ParseReader(ctx, r, func(p parsed.Parsed) error {
+		_, err := fmt.Fprintln(w, p.Output(f))
+		return err
+	})
+}
+
+// iterBatch collects names from the next function into batches and sends
+// results of parsing of the batches to the output channel. The next batch
+// is collected and parsed while results of the previous one are consumed.
+// At the end the output channel is closed, and the first error of parsing
+// is sent to the error channel.
+func (gnp gnparser) iterBatch(
+	ctx context.Context,
+	next func() (string, bool),
+	chOut chan<- parsed.Parsed,
+	chErr chan<- error,
+) {
+	var errs firstError
+	defer func() {
+		close(chOut)
+		chErr <- errs.get()
+	}()
+
+	size := gnp.cfg.BatchSize
+	batch := make([]string, 0, size)
+	chBatch := make(chan []parsed.Parsed)
+	go func() {
+		defer close(chBatch)
+		send := func() bool {
+			res, err := gnp.ParseNamesCtx(ctx, batch)
+			if ctx.Err() != nil {
+				return false
+			}
+			errs.set(err)
+			batch = make([]string, 0, size)
+			select {
+			case <-ctx.Done():
+				return false
+			case chBatch <- res:
+				return true
+			}
+		}
+		for ctx.Err() == nil {
+			name, ok := next()
+			if !ok {
+				break
+			}
+			batch = append(batch, name)
+			if len(batch) == size && !send() {
+				return
+			}
+		}
+		if len(batch) > 0 {
+			send()
+		}
+	}()
+
+	for res := range chBatch {
+		for i := range res {
+			select {
+			case <-ctx.Done():
+			case chOut <- res[i]:
+			}
+		}
+	}
+}
+
+// iterStream sends names from the next function to ParseNameStreamCtx one
+// by one. At the end the output channel is closed, and the error of
+// ParseNameStreamCtx is sent to the error channel.
+func (gnp gnparser) iterStream(
+	ctx context.Context,
+	next func() (string, bool),
+	chOut chan<- parsed.Parsed,
+	chErr chan<- error,
+) {
+	chIn := make(chan nameidx.NameIdx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer close(chIn)
+		for i := 0; ctx.Err() == nil; i++ {
+			name, ok := next()
+			if !ok {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case chIn <- nameidx.NameIdx{Index: i, NameString: name}:
+			}
+		}
+	}()
+
+	err := gnp.ParseNameStreamCtx(ctx, chIn, chOut)
+	<-done
+	chErr <- err
+}
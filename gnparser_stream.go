@@ -2,26 +2,38 @@ package gnparser
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"sync"
 
 	"github.com/gnames/gnparser/ent/nameidx"
 	"github.com/gnames/gnparser/ent/parsed"
-	"github.com/gnames/gnparser/ent/parser"
 	"github.com/gnames/organizer"
 )
 
 // ParseNameStream takes an input channel of input.Name and
 // returns back a stream of parsed data following the same order as
-// the input.
+// the input. Names that failed to parse are sent as not parsed, use
+// ParseNameStreamCtx to get errors of parsing.
 func (gnp gnparser) ParseNameStream(
 	ctx context.Context,
 	chIn <-chan nameidx.NameIdx,
 	chOut chan<- parsed.Parsed,
 ) {
+	_ = gnp.ParseNameStreamCtx(ctx, chIn, chOut)
+}
+
+// ParseNameStreamCtx is the same as ParseNameStream, but it returns
+// the error of the context if the context was cancelled, or the error of
+// the first failed name.
+func (gnp gnparser) ParseNameStreamCtx(
+	ctx context.Context,
+	chIn <-chan nameidx.NameIdx,
+	chOut chan<- parsed.Parsed,
+) error {
 	chUnordered := make(chan organizer.Ordered)
 	chOrdered := make(chan organizer.Ordered)
 	var wgWorker, wgOutput sync.WaitGroup
+	var errs firstError
 	jobs := gnp.cfg.JobsNum
 	wgWorker.Add(jobs)
 	wgOutput.Add(1)
@@ -32,15 +44,19 @@ func (gnp gnparser) ParseNameStream(
 
 	if gnp.cfg.WithNoOrder {
 		close(chOrdered)
-		go sendUnordered(ctx, chUnordered, chOut, &wgOutput)
+		go sendResults(ctx, chUnordered, chOut, &errs, &wgOutput)
 	} else {
 		go organizer.Organize(ctx, chUnordered, chOrdered)
-		go sendOrdered(ctx, chOrdered, chOut, &wgOutput)
+		go sendResults(ctx, chOrdered, chOut, &errs, &wgOutput)
 	}
 
 	wgWorker.Wait()
 	close(chUnordered)
 	wgOutput.Wait()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return errs.get()
 }
 
 func (gnp gnparser) parseStreamWorker(
@@ -50,57 +66,58 @@ func (gnp gnparser) parseStreamWorker(
 	wg *sync.WaitGroup,
 ) {
 	defer wg.Done()
-	gnp.parser = parser.New()
-	for v := range chIn {
-		parseRes := gnp.ParseName(v.NameString)
+	for {
+		var v nameidx.NameIdx
+		var ok bool
 		select {
 		case <-ctx.Done():
 			return
-		case chOut <- parsed.ParsedWithIdx{Parsed: parseRes, Error: nil, Idx: v.Index}:
-		}
-	}
-}
-
-func sendOrdered(
-	ctx context.Context,
-	chOrdered <-chan organizer.Ordered,
-	chOut chan<- parsed.Parsed,
-	wg *sync.WaitGroup,
-) {
-	defer wg.Done()
-	for v := range chOrdered {
-		var p parsed.Parsed
-		err := v.Unpack(&p)
-		if err != nil {
-			log.Panic(err)
+		case v, ok = <-chIn:
+			if !ok {
+				return
+			}
 		}
+		parseRes, err := gnp.parseNameSafe(v.NameString)
 		select {
 		case <-ctx.Done():
 			return
-		case chOut <- p:
+		case chOut <- parsed.ParsedWithIdx{Parsed: parseRes, Error: err, Idx: v.Index}:
 		}
 	}
-	close(chOut)
 }
 
-func sendUnordered(
+// sendResults sends parsing results to the output channel and keeps
+// the first error of parsing.
+func sendResults(
 	ctx context.Context,
-	chUnordered <-chan organizer.Ordered,
+	chIn <-chan organizer.Ordered,
 	chOut chan<- parsed.Parsed,
+	errs *firstError,
 	wg *sync.WaitGroup,
 ) {
 	defer wg.Done()
-	for v := range chUnordered {
-		var p parsed.Parsed
-		err := v.Unpack(&p)
-		if err != nil {
-			log.Panic(err)
+	defer close(chOut)
+	for {
+		var v organizer.Ordered
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return
+		case v, ok = <-chIn:
+			if !ok {
+				return
+			}
+		}
+		pr, ok := v.(parsed.ParsedWithIdx)
+		if !ok {
+			errs.set(fmt.Errorf("unexpected parsing result %T", v))
+			continue
 		}
+		errs.set(pr.Error)
 		select {
 		case <-ctx.Done():
 			return
-		case chOut <- p:
+		case chOut <- pr.Parsed:
 		}
 	}
-	close(chOut)
 }
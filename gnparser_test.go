@@ -2,13 +2,19 @@ package gnparser_test
 
 import (
 	"bufio"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/cache"
+	"github.com/gnames/gnparser/ent/nameidx"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,7 +39,304 @@ func TestParseName(t *testing.T) {
 	}
 }
 
-func getTestData(t *testing.T) []testData {
+func TestParseNameCache(t *testing.T) {
+	cfg := gnparser.NewConfig(
+		gnparser.OptCacheSize(10),
+		gnparser.OptIsTest(true),
+	)
+	gnp := gnparser.New(cfg)
+	names := []string{"Bubo bubo", "Pomatomus saltatrix", "Bubo bubo"}
+	res := gnp.ParseNames(names)
+	assert.Equal(t, "Bubo bubo", res[2].Canonical.Simple)
+	assert.Nil(t, res[0].Details)
+	stats := gnp.CacheStats()
+	assert.Equal(t, uint64(3), stats.Hits+stats.Misses)
+	assert.Equal(t, 2, stats.Len)
+
+	// details change the output, so results are cached separately.
+	gnpDet := gnp.ChangeConfig(gnparser.OptWithDetails(true))
+	p := gnpDet.ParseName("Bubo bubo")
+	assert.NotNil(t, p.Details)
+	p = gnpDet.ParseName("Bubo bubo")
+	assert.NotNil(t, p.Details)
+	stats = gnp.CacheStats()
+	assert.Equal(t, 3, stats.Len)
+	assert.Equal(t, uint64(5), stats.Hits+stats.Misses)
+
+	gnp = gnparser.New(gnparser.NewConfig())
+	gnp.ParseName("Bubo bubo")
+	assert.Equal(t, cache.Stats{}, gnp.CacheStats())
+}
+
+func TestParseNamesDedupe(t *testing.T) {
+	names := []string{"Bubo bubo", "Aus bus", "Bubo bubo", "Cus dus", "Aus bus"}
+	cfg := gnparser.NewConfig(
+		gnparser.OptWithDedupe(true),
+		gnparser.OptIsTest(true),
+	)
+	gnp := gnparser.New(cfg)
+	res := gnp.ParseNames(names)
+	assert.Equal(t, len(names), len(res))
+	for i := range names {
+		assert.Equal(t, names[i], res[i].Verbatim)
+	}
+
+	uniq := gnp.ParseNamesUnique(names)
+	assert.Equal(t, 3, len(uniq))
+	counts := map[string]int{"Bubo bubo": 2, "Aus bus": 2, "Cus dus": 1}
+	for i, v := range []string{"Bubo bubo", "Aus bus", "Cus dus"} {
+		assert.Equal(t, v, uniq[i].Verbatim)
+		assert.Equal(t, counts[v], uniq[i].Count)
+	}
+}
+
+func TestParseNamePublication(t *testing.T) {
+	s := "Carex flava L., Sp. Pl. 2: 975. 1753"
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptIsTest(true)))
+	res := gnp.ParseName(s)
+	assert.Nil(t, res.Publication)
+	assert.Equal(t, 4, res.ParseQuality)
+
+	gnp = gnp.ChangeConfig(gnparser.OptWithPublication(true))
+	res = gnp.ParseName(s)
+	assert.Equal(t, 1, res.ParseQuality)
+	assert.Equal(t, s, res.Verbatim)
+	assert.Equal(t, "Carex flava L.", res.Normalized)
+	assert.Equal(t, "L.", res.Authorship.Normalized)
+	assert.Equal(t, &parsed.Publication{
+		Verbatim: "Sp. Pl. 2: 975. 1753",
+		Title:    "Sp. Pl.",
+		Volume:   "2",
+		Pages:    "975",
+		Year:     "1753",
+	}, res.Publication)
+}
+
+func TestParseNameUncertainGenus(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	res := gnp.ParseName("Ferganoconcha? oblonga")
+	assert.Equal(t, "Ferganoconcha oblonga", res.Canonical.Simple)
+	assert.Equal(t, 2, res.Cardinality)
+	assert.True(t, res.Words[0].Uncertain)
+	assert.False(t, res.Words[1].Uncertain)
+	det, ok := res.Details.(parsed.DetailsSpecies)
+	assert.True(t, ok)
+	assert.True(t, det.Species.GenusUncertain)
+	assert.False(t, det.Species.SpeciesUncertain)
+	assert.Equal(t, 1, len(res.QualityWarnings))
+	assert.Equal(t, parsed.CapWordQuestionWarn, res.QualityWarnings[0].Warning)
+
+	res = gnp.ParseName("Ferganoconcha?")
+	assert.True(t, res.Words[0].Uncertain)
+	det2, ok := res.Details.(parsed.DetailsUninomial)
+	assert.True(t, ok)
+	assert.True(t, det2.Uninomial.Uncertain)
+}
+
+func TestParseNameInAuthors(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	res := gnp.ParseName("Aus bus Smith in Jones 1900")
+	assert.Equal(t, "1900", res.Authorship.Year)
+
+	det, ok := res.Details.(parsed.DetailsSpecies)
+	assert.True(t, ok)
+	orig := det.Species.Authorship.Original
+	assert.Equal(t, []string{"Smith"}, orig.Authors)
+	assert.Nil(t, orig.Year)
+	assert.Equal(t, &parsed.Authors{
+		Authors: []string{"Jones"},
+		Year:    &parsed.Year{Value: "1900"},
+	}, orig.InAuthors)
+}
+
+func TestParseNamesCtx(t *testing.T) {
+	names := []string{"Bubo bubo", "Aus bus", "Bubo bubo"}
+	for _, dedupe := range []bool{false, true} {
+		cfg := gnparser.NewConfig(
+			gnparser.OptWithDedupe(dedupe),
+			gnparser.OptIsTest(true),
+		)
+		gnp := gnparser.New(cfg)
+		res, err := gnp.ParseNamesCtx(context.Background(), names)
+		assert.Nil(t, err)
+		assert.Equal(t, len(names), len(res))
+		assert.Equal(t, "Aus bus", res[1].Verbatim)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		res, err = gnp.ParseNamesCtx(ctx, names)
+		assert.Equal(t, context.Canceled, err)
+		assert.Nil(t, res)
+	}
+}
+
+func TestParseNameStream(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptIsTest(true)))
+	chIn := make(chan nameidx.NameIdx)
+	chOut := make(chan parsed.Parsed)
+	go func() {
+		defer close(chIn)
+		for i, v := range []string{"Bubo bubo", "Aus bus"} {
+			chIn <- nameidx.NameIdx{Index: i, NameString: v}
+		}
+	}()
+	go gnp.ParseNameStream(context.Background(), chIn, chOut)
+	var res []string
+	for p := range chOut {
+		res = append(res, p.Verbatim)
+	}
+	assert.Equal(t, []string{"Bubo bubo", "Aus bus"}, res)
+}
+
+func TestParseNameStreamCtx(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptIsTest(true)))
+	chIn := make(chan nameidx.NameIdx)
+	chOut := make(chan parsed.Parsed)
+	go func() {
+		defer close(chIn)
+		for i, v := range []string{"Bubo bubo", "Aus bus"} {
+			chIn <- nameidx.NameIdx{Index: i, NameString: v}
+		}
+	}()
+	chErr := make(chan error)
+	go func() {
+		chErr <- gnp.ParseNameStreamCtx(context.Background(), chIn, chOut)
+	}()
+	var res []string
+	for p := range chOut {
+		res = append(res, p.Verbatim)
+	}
+	assert.Nil(t, <-chErr)
+	assert.Equal(t, []string{"Bubo bubo", "Aus bus"}, res)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	chIn = make(chan nameidx.NameIdx)
+	chOut = make(chan parsed.Parsed)
+	go func() {
+		chErr <- gnp.ParseNameStreamCtx(ctx, chIn, chOut)
+	}()
+	for range chOut {
+	}
+	assert.Equal(t, context.Canceled, <-chErr)
+}
+
+func TestParseReader(t *testing.T) {
+	input := "Bubo bubo\nAus bus\n\nCus dus Linn."
+	for _, stream := range []bool{false, true} {
+		cfg := gnparser.NewConfig(
+			gnparser.OptWithStream(stream),
+			gnparser.OptBatchSize(2),
+			gnparser.OptIsTest(true),
+		)
+		gnp := gnparser.New(cfg)
+		var res []string
+		err := gnp.ParseReader(
+			context.Background(),
+			strings.NewReader(input),
+			func(p parsed.Parsed) error {
+				res = append(res, p.Verbatim)
+				return nil
+			},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Bubo bubo", "Aus bus", "", "Cus dus Linn."}, res)
+
+		errStop := errors.New("stop")
+		var count int
+		err = gnp.ParseReader(
+			context.Background(),
+			strings.NewReader(input),
+			func(p parsed.Parsed) error {
+				count++
+				return errStop
+			},
+		)
+		assert.Equal(t, errStop, err)
+		assert.Equal(t, 1, count)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err = gnp.ParseReader(ctx, strings.NewReader(input),
+			func(parsed.Parsed) error { return nil },
+		)
+		assert.Equal(t, context.Canceled, err)
+	}
+}
+
+func TestParseIter(t *testing.T) {
+	names := []string{"Bubo bubo", "Aus bus", "Bubo bubo"}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptBatchSize(2)))
+	var i int
+	next := func() (string, bool) {
+		if i == len(names) {
+			return "", false
+		}
+		i++
+		return names[i-1], true
+	}
+	var res []string
+	err := gnp.ParseIter(context.Background(), next, func(p parsed.Parsed) error {
+		res = append(res, p.Canonical.Simple)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, names, res)
+}
+
+func TestParseToWriter(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptFormat("csv"), gnparser.OptIsTest(true))
+	gnp := gnparser.New(cfg)
+	var buf bytes.Buffer
+	err := gnp.ParseToWriter(
+		context.Background(),
+		strings.NewReader("Bubo bubo\nAus bus\n"),
+		&buf,
+	)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, 3, len(lines))
+	assert.Equal(t, parsed.HeaderCSV(), lines[0])
+	assert.Contains(t, lines[2], "Aus bus")
+}
+
+// TestParseNameConcurrent checks that one GNparser can be used from many
+// goroutines at once. Run it with `go test -race` to detect data races.
+func TestParseNameConcurrent(t *testing.T) {
+	data := getTestData(t)
+	for _, size := range []int{0, 50} {
+		cfg := gnparser.NewConfig(
+			gnparser.OptWithDetails(true),
+			gnparser.OptFormat("compact"),
+			gnparser.OptIsTest(true),
+			gnparser.OptCacheSize(size),
+		)
+		gnp := gnparser.New(cfg)
+		gnpSimple := gnp.ChangeConfig(gnparser.OptWithDetails(false))
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(start int) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					v := data[(start*97+j)%len(data)]
+					res := gnp.ParseName(v.name).Output(gnp.Format())
+					assert.Equal(t, v.jsonData, res, v.name)
+					_ = gnpSimple.ParseName(v.name)
+					if j%50 == 0 {
+						exp := gnp.Explain(v.name)
+						assert.Equal(t, v.name, exp.Verbatim)
+						names := []string{v.name, "Bubo bubo"}
+						assert.Equal(t, 2, len(gnp.ParseNames(names)))
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+	}
+}
+
+func getTestData(t testing.TB) []testData {
 	var res []testData
 	path := filepath.Join("testdata", "test_data.md")
 	f, err := os.Open(path)
@@ -81,32 +384,23 @@ func Example() {
 	// e2fdf10b-6a36-5cc7-b6ca-be4d3b34b21f,"Pardosa moesta Banks, 1892",2,Pardosa moest,Pardosa moesta,Pardosa moesta,Banks 1892,1892,1
 }
 
-// BenchmarkParse checks parsing event speed. Run it with:
+// BenchmarkParse checks parsing event speed over names of the test corpus.
+// Run it with:
 // `go test -bench=. -benchmem -count=10 -run=XXX > bench.txt && benchstat bench.txt`
 func BenchmarkParse(b *testing.B) {
-	path := filepath.Join("testdata", "200k-lines.txt")
-	count := 1000
-	test := make([]string, count)
+	data := getTestData(b)
+	test := make([]string, len(data))
+	for i := range data {
+		test[i] = data[i].name
+	}
 	cfgJSON := gnparser.NewConfig(gnparser.OptFormat("compact"))
 	gnpJSON := gnparser.New(cfgJSON)
 	cfgDet := gnparser.NewConfig(gnparser.OptFormat("compact"), gnparser.OptWithDetails(true))
 	gnpDet := gnparser.New(cfgDet)
 	cfgCSV := gnparser.NewConfig(gnparser.OptFormat("csv"))
 	gnpCSV := gnparser.New(cfgCSV)
-	f, err := os.Open(path)
+	var err error
 
-	if err != nil {
-		panic(err)
-	}
-	scanner := bufio.NewScanner(f)
-
-	for scanner.Scan() {
-		if count == 0 {
-			break
-		}
-		test = append(test, scanner.Text())
-		count--
-	}
 	b.Run("Parse to object once", func(b *testing.B) {
 		var p parsed.Parsed
 		for i := 0; i < b.N; i++ {
@@ -132,6 +426,14 @@ func BenchmarkParse(b *testing.B) {
 		_ = fmt.Sprintf("%v", p.Parsed)
 	})
 
+	b.Run("Parse names in a batch", func(b *testing.B) {
+		var res []parsed.Parsed
+		for i := 0; i < b.N; i++ {
+			res = gnpCSV.ParseNames(test)
+		}
+		_ = fmt.Sprintf("%d", len(res))
+	})
+
 	b.Run("Parse to JSON", func(b *testing.B) {
 		var s string
 		for i := 0; i < b.N; i++ {
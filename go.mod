@@ -6,15 +6,17 @@ require (
 	github.com/fsnotify/fsnotify v1.4.9 // indirect
 	github.com/gnames/gnfmt v0.1.0
 	github.com/gnames/gnlib v0.2.1
-	github.com/gnames/gnsys v0.1.1
 	github.com/gnames/gnuuid v0.1.1
 	github.com/gnames/organizer v0.1.1
 	github.com/gnames/tribool v0.1.1
+	github.com/klauspost/compress v1.11.3
 	github.com/labstack/echo/v4 v4.1.17
+	github.com/labstack/gommon v0.3.0
 	github.com/magiconair/properties v1.8.4 // indirect
 	github.com/mattn/go-colorable v0.1.8 // indirect
 	github.com/mitchellh/mapstructure v1.4.1 // indirect
 	github.com/pelletier/go-toml v1.8.1 // indirect
+	github.com/peterh/liner v1.2.1
 	github.com/rendon/testcli v1.0.0
 	github.com/shurcooL/httpfs v0.0.0-20190707220628-8d4bc4ba7749
 	github.com/spf13/afero v1.5.1 // indirect
@@ -23,6 +25,7 @@ require (
 	github.com/spf13/jwalterweatherman v1.1.0 // indirect
 	github.com/spf13/viper v1.7.1
 	github.com/stretchr/testify v1.7.0
+	github.com/tetratelabs/wazero v1.3.1
 	golang.org/x/crypto v0.0.0-20201221181555-eec23a3978ad // indirect
 	golang.org/x/net v0.0.0-20210119194325-5f4716e94777
 	golang.org/x/sys v0.0.0-20210124154548-22da62e12c0c // indirect
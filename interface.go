@@ -2,31 +2,72 @@ package gnparser
 
 import (
 	"context"
+	"io"
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnlib/ent/gnvers"
+	"github.com/gnames/gnparser/ent/cache"
 	"github.com/gnames/gnparser/ent/nameidx"
 	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/parser"
 )
 
 // GNparser is the main use-case interface. It provides methods required
-// for parsing scientific names.
+// for parsing scientific names. Methods of GNparser are safe for concurrent
+// use, one GNparser can serve many goroutines.
 type GNparser interface {
 	// GetVersion provides a version and a build timestamp of gnparser.
 	GetVersion() gnvers.Version
 	// ParseName takes a name-string, and returns parsed results for the name.
+	// It can be called from several goroutines at once, every call uses its
+	// own parsing engine from a pool.
 	ParseName(string) parsed.Parsed
+	// Explain takes a name-string, and returns a description of the parsing
+	// process. It is useful for finding out why a name was parsed in an
+	// unexpected way.
+	Explain(string) parser.Explanation
 	// ParseNames takes a slice of name-strings, and returns a slice of
 	// parsed results in the same order as the input.
 	ParseNames([]string) []parsed.Parsed
+	// ParseNamesCtx is the same as ParseNames, but it stops parsing and
+	// returns an error when the context is cancelled. It also returns
+	// the first error of parsing, if parsing of some names failed.
+	ParseNamesCtx(context.Context, []string) ([]parsed.Parsed, error)
+	// ParseNamesUnique takes a slice of name-strings, and returns parsed
+	// results for unique name-strings with the number of their occurrences
+	// in the input. Results follow the order of the first appearance of
+	// names in the input.
+	ParseNamesUnique([]string) []parsed.Counted
 	// ParseNameStream takes a context, an input channel that takes a
 	// a name-string and its position in the input. It returns parsed results
 	// that come in the same order as the input.
 	ParseNameStream(context.Context, <-chan nameidx.NameIdx, chan<- parsed.Parsed)
+	// ParseNameStreamCtx is the same as ParseNameStream. The output channel
+	// is closed at the end. It returns an error if the context is
+	// cancelled, or if parsing of some names failed.
+	ParseNameStreamCtx(
+		context.Context, <-chan nameidx.NameIdx, chan<- parsed.Parsed,
+	) error
+	// ParseIter takes name-strings from a function until it returns false,
+	// and calls a callback for every parsed result in the order of the
+	// input. It handles batching and concurrency of parsing, and stops with
+	// an error if the callback returns an error or the context is cancelled.
+	ParseIter(
+		context.Context, func() (string, bool), func(parsed.Parsed) error,
+	) error
+	// ParseReader is the same as ParseIter, but it takes name-strings from
+	// lines of a reader.
+	ParseReader(context.Context, io.Reader, func(parsed.Parsed) error) error
+	// ParseToWriter takes name-strings from lines of a reader, and writes
+	// parsed results to a writer in the chosen output format.
+	ParseToWriter(context.Context, io.Reader, io.Writer) error
 	// Format returns currently chosen desired output format of a JSON or
 	// CSV output.
 	Format() gnfmt.Format
 	// ChangeConfig allows to modify settings of GNparser. Changing settings
 	// might modify parsing process, and the final output of results.
 	ChangeConfig(opts ...Option) GNparser
+	// CacheStats returns statistics of the parsing cache. The cache is
+	// used if Config.CacheSize is more than 0.
+	CacheStats() cache.Stats
 }
@@ -0,0 +1,71 @@
+// Package checkpoint saves and loads the progress of a long parsing run,
+// so the run can be resumed after a crash without gaps or duplicates in
+// its output.
+package checkpoint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/gnames/gnfmt"
+)
+
+// Checkpoint is the state of a parsing run at the moment when all its
+// output up to this moment is saved.
+type Checkpoint struct {
+	// Lines is the number of input lines with fully written results.
+	Lines int64 `json:"lines"`
+
+	// OutputOffset is the size of the output file that contains results
+	// of these lines.
+	OutputOffset int64 `json:"outputOffset"`
+
+	// RejectedOffset is the size of the file with rejected results of these
+	// lines. It is zero if such file is not used.
+	RejectedOffset int64 `json:"rejectedOffset"`
+}
+
+// Load reads a checkpoint from a file. If the file does not exist, the
+// returned error satisfies os.IsNotExist.
+func Load(path string) (Checkpoint, error) {
+	var res Checkpoint
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return res, err
+	}
+	if err = (gnfmt.GNjson{}).Decode(data, &res); err != nil {
+		return res, fmt.Errorf("cannot read checkpoint %s: %w", path, err)
+	}
+	if res.Lines < 0 || res.OutputOffset < 0 || res.RejectedOffset < 0 {
+		return res, fmt.Errorf("checkpoint %s has negative values", path)
+	}
+	return res, nil
+}
+
+// Save writes a checkpoint to a file. The file is replaced atomically, so
+// a crash during saving leaves the previous checkpoint intact.
+func (c Checkpoint) Save(path string) error {
+	data, err := (gnfmt.GNjson{}).Encode(c)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".checkpoint-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
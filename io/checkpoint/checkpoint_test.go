@@ -0,0 +1,39 @@
+package checkpoint_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gnames/gnparser/io/checkpoint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "run.checkpoint")
+
+	_, err := checkpoint.Load(path)
+	assert.True(t, os.IsNotExist(err))
+
+	chk := checkpoint.Checkpoint{Lines: 10, OutputOffset: 300}
+	assert.Nil(t, chk.Save(path))
+	res, err := checkpoint.Load(path)
+	assert.Nil(t, err)
+	assert.Equal(t, chk, res)
+
+	chk.Lines, chk.OutputOffset = 20, 600
+	assert.Nil(t, chk.Save(path))
+	res, err = checkpoint.Load(path)
+	assert.Nil(t, err)
+	assert.Equal(t, chk, res)
+
+	files, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(files))
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("not json"), 0644))
+	_, err = checkpoint.Load(path)
+	assert.NotNil(t, err)
+}
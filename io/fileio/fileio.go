@@ -0,0 +1,292 @@
+// Package fileio provides reading and writing of files with names-strings.
+// Files with `.gz` or `.zst` extensions are compressed and decompressed
+// transparently.
+package fileio
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// maxLineSize is the maximum size of a line. It is large enough to treat
+// lines as having no size limit.
+const maxLineSize = int(^uint(0) >> 1)
+
+// Option is a type of functions that modify opening of a file.
+type Option func(*openCfg)
+
+type openCfg struct {
+	wrap func(io.Reader) io.Reader
+}
+
+// OptRawReader wraps the reader of the raw, possibly compressed, content of
+// a file. For example, it allows to count bytes read from a disk.
+func OptRawReader(wrap func(io.Reader) io.Reader) Option {
+	return func(cfg *openCfg) {
+		cfg.wrap = wrap
+	}
+}
+
+// Open opens a file for reading. Files with `.gz` and `.zst` extensions are
+// decompressed.
+func Open(path string, opts ...Option) (io.ReadCloser, error) {
+	var cfg openCfg
+	for i := range opts {
+		opts[i](&cfg)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw io.Reader = f
+	if cfg.wrap != nil {
+		raw = cfg.wrap(f)
+	}
+	switch compression(path) {
+	case ".gz":
+		gz, err := gzip.NewReader(raw)
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("cannot read gzip file %s: %w", path, err)
+		}
+		return &readCloser{Reader: gz, closers: []io.Closer{gz, f}}, nil
+	case ".zst":
+		zr, err := zstd.NewReader(raw)
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("cannot read zstd file %s: %w", path, err)
+		}
+		zc := closerFunc(func() error { zr.Close(); return nil })
+		return &readCloser{Reader: zr, closers: []io.Closer{zc, f}}, nil
+	default:
+		return &readCloser{Reader: raw, closers: []io.Closer{f}}, nil
+	}
+}
+
+// Create creates a file for writing. Output to files with `.gz` and `.zst`
+// extensions is compressed.
+func Create(path string) (io.WriteCloser, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	switch compression(path) {
+	case ".gz":
+		gz := gzip.NewWriter(f)
+		return &writeCloser{Writer: gz, closers: []io.Closer{gz, f}}, nil
+	case ".zst":
+		zw, err := zstd.NewWriter(f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &writeCloser{Writer: zw, closers: []io.Closer{zw, f}}, nil
+	default:
+		return f, nil
+	}
+}
+
+// Append opens a not compressed file for writing at the offset. Content of
+// the file after the offset is removed. It returns an error if the file
+// is compressed or is shorter than the offset.
+func Append(path string, offset int64) (io.WriteCloser, error) {
+	if IsCompressed(path) {
+		return nil, fmt.Errorf("cannot append to compressed file %s", path)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if fi.Size() < offset {
+		f.Close()
+		return nil, fmt.Errorf(
+			"file %s is shorter than %d bytes", path, offset,
+		)
+	}
+	if err = f.Truncate(offset); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err = f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// IsCompressed returns true if a file is compressed according to its
+// extension.
+func IsCompressed(path string) bool {
+	return compression(path) != ""
+}
+
+// Size returns the total size of files in bytes.
+func Size(paths []string) (int64, error) {
+	var res int64
+	for _, v := range paths {
+		fi, err := os.Stat(v)
+		if err != nil {
+			return 0, err
+		}
+		res += fi.Size()
+	}
+	return res, nil
+}
+
+// Expand converts file paths and glob patterns into a list of existing
+// files. It returns an error if a path or a pattern does not match any
+// file.
+func Expand(paths []string) ([]string, error) {
+	var res []string
+	for _, v := range paths {
+		matches, err := filepath.Glob(v)
+		if err != nil {
+			return nil, fmt.Errorf("bad pattern '%s': %w", v, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("file '%s' is not found", v)
+		}
+		for _, m := range matches {
+			if fi, err := os.Stat(m); err == nil && !fi.IsDir() {
+				res = append(res, m)
+			}
+		}
+	}
+	return res, nil
+}
+
+// NewScanner creates a scanner of lines that does not limit the size of
+// a line.
+func NewScanner(r io.Reader) *bufio.Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return sc
+}
+
+// SkipLines reads and discards n lines of a reader. It returns a reader of
+// the remaining content and the number of skipped lines, which is less
+// than n if the content is shorter.
+func SkipLines(r io.Reader, n int64) (io.Reader, int64, error) {
+	br := bufio.NewReader(r)
+	var count int64
+	for count < n {
+		_, isPrefix, err := br.ReadLine()
+		if err == io.EOF {
+			return br, count, nil
+		}
+		if err != nil {
+			return br, count, err
+		}
+		if !isPrefix {
+			count++
+		}
+	}
+	return br, count, nil
+}
+
+// JoinLines concatenates readers, so their lines are read as if they come
+// from one source. It adds a new line character at the end of a reader
+// if the character is missing, so the last line of a reader does not merge
+// with the first line of the next one.
+func JoinLines(rs ...io.Reader) io.Reader {
+	res := make([]io.Reader, len(rs))
+	for i := range rs {
+		res[i] = &newlineReader{r: rs[i]}
+	}
+	return io.MultiReader(res...)
+}
+
+// compression returns the extension of a compressed file, or an empty
+// string.
+func compression(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	switch ext {
+	case ".gz", ".zst":
+		return ext
+	default:
+		return ""
+	}
+}
+
+// newlineReader makes sure that its output ends with a new line character,
+// if the output is not empty.
+type newlineReader struct {
+	r    io.Reader
+	last byte
+	done bool
+}
+
+func (nr *newlineReader) Read(p []byte) (int, error) {
+	if nr.done {
+		return 0, io.EOF
+	}
+	n, err := nr.r.Read(p)
+	if n > 0 {
+		nr.last = p[n-1]
+	}
+	if err != io.EOF {
+		return n, err
+	}
+	nr.done = true
+	if nr.last == '\n' || nr.last == 0 {
+		return n, io.EOF
+	}
+	if n < len(p) {
+		p[n] = '\n'
+		return n + 1, io.EOF
+	}
+	nr.done = false
+	nr.r = strings.NewReader("\n")
+	nr.last = 0
+	return n, nil
+}
+
+type readCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+// Close closes the decompressor and the file.
+func (rc *readCloser) Close() error {
+	var res error
+	for _, c := range rc.closers {
+		if err := c.Close(); err != nil && res == nil {
+			res = err
+		}
+	}
+	return res
+}
+
+type writeCloser struct {
+	io.Writer
+	closers []io.Closer
+}
+
+// Close flushes the compressor and closes the file.
+func (wc *writeCloser) Close() error {
+	var res error
+	for _, c := range wc.closers {
+		if err := c.Close(); err != nil && res == nil {
+			res = err
+		}
+	}
+	return res
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
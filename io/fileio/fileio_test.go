@@ -0,0 +1,149 @@
+package fileio_test
+
+import (
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnparser/io/fileio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompression(t *testing.T) {
+	dir := t.TempDir()
+	for _, v := range []string{"names.txt", "names.txt.gz", "names.txt.zst"} {
+		path := filepath.Join(dir, v)
+		w, err := fileio.Create(path)
+		assert.Nil(t, err, v)
+		_, err = io.WriteString(w, "Bubo bubo\nPomatomus saltatrix\n")
+		assert.Nil(t, err, v)
+		assert.Nil(t, w.Close(), v)
+
+		r, err := fileio.Open(path)
+		assert.Nil(t, err, v)
+		res, err := ioutil.ReadAll(r)
+		assert.Nil(t, err, v)
+		assert.Nil(t, r.Close(), v)
+		assert.Equal(t, "Bubo bubo\nPomatomus saltatrix\n", string(res), v)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "names.txt.gz"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x1f, 0x8b}, data[:2])
+	data, err = ioutil.ReadFile(filepath.Join(dir, "names.txt.zst"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x28, 0xb5, 0x2f, 0xfd}, data[:4])
+
+	var raw int
+	counter := func(r io.Reader) io.Reader {
+		return readerFunc(func(p []byte) (int, error) {
+			n, err := r.Read(p)
+			raw += n
+			return n, err
+		})
+	}
+	path := filepath.Join(dir, "names.txt.gz")
+	r, err := fileio.Open(path, fileio.OptRawReader(counter))
+	assert.Nil(t, err)
+	_, err = ioutil.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Nil(t, r.Close())
+	size, err := fileio.Size([]string{path})
+	assert.Nil(t, err)
+	assert.Equal(t, size, int64(raw))
+
+	_, err = fileio.Open(filepath.Join(dir, "missing.txt"))
+	assert.NotNil(t, err)
+}
+
+func TestExpand(t *testing.T) {
+	dir := t.TempDir()
+	for _, v := range []string{"a.txt", "b.txt", "c.csv"} {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, v), nil, 0644))
+	}
+	res, err := fileio.Expand([]string{
+		filepath.Join(dir, "*.txt"),
+		filepath.Join(dir, "c.csv"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(res))
+
+	_, err = fileio.Expand([]string{filepath.Join(dir, "*.gz")})
+	assert.NotNil(t, err)
+}
+
+func TestJoinLines(t *testing.T) {
+	data := []struct {
+		msg string
+		in  []string
+		out string
+	}{
+		{"newlines", []string{"a\nb\n", "c\n"}, "a\nb\nc\n"},
+		{"no newlines", []string{"a\nb", "c"}, "a\nb\nc\n"},
+		{"empty", []string{"", "a", ""}, "a\n"},
+	}
+	for _, v := range data {
+		rs := make([]io.Reader, len(v.in))
+		for i := range v.in {
+			rs[i] = strings.NewReader(v.in[i])
+		}
+		res, err := ioutil.ReadAll(fileio.JoinLines(rs...))
+		assert.Nil(t, err, v.msg)
+		assert.Equal(t, v.out, string(res), v.msg)
+	}
+}
+
+func TestScannerLongLine(t *testing.T) {
+	long := strings.Repeat("a", 1_000_000)
+	sc := fileio.NewScanner(strings.NewReader("Bubo bubo\n" + long + "\n"))
+	var lines []string
+	for sc.Scan() {
+		lines = append(lines, sc.Text())
+	}
+	assert.Nil(t, sc.Err())
+	assert.Equal(t, 2, len(lines))
+	assert.Equal(t, long, lines[1])
+}
+
+func TestAppend(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.txt")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("line1\nline2\npartial"), 0644))
+
+	w, err := fileio.Append(path, 12)
+	assert.Nil(t, err)
+	_, err = io.WriteString(w, "line3\n")
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	res, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "line1\nline2\nline3\n", string(res))
+
+	_, err = fileio.Append(path, 100)
+	assert.NotNil(t, err)
+	_, err = fileio.Append(path+".gz", 0)
+	assert.NotNil(t, err)
+}
+
+func TestSkipLines(t *testing.T) {
+	long := strings.Repeat("a", 100_000)
+	in := "line1\n" + long + "\nline3\nline4"
+	r, n, err := fileio.SkipLines(strings.NewReader(in), 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), n)
+	res, err := ioutil.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Equal(t, "line3\nline4", string(res))
+
+	_, n, err = fileio.SkipLines(strings.NewReader(in), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), n)
+}
+
+type readerFunc func([]byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p)
+}
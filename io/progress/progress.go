@@ -0,0 +1,220 @@
+// Package progress reports how far parsing of a large input went. Reports
+// include the number of parsed names, the rate of parsing, and, if the size
+// of the input is known, percent of the input done and the estimated time
+// left. Reports are written as human-readable lines, or as JSON events
+// for tools that orchestrate gnparser.
+package progress
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gnames/gnfmt"
+)
+
+// Event is a JSON progress report.
+type Event struct {
+	// Event is "progress" for intermediate reports and "done" for the final
+	// report.
+	Event string `json:"event"`
+
+	// Names is the number of parsed names.
+	Names int64 `json:"names"`
+
+	// Bytes is the number of bytes read from the input.
+	Bytes int64 `json:"bytes"`
+
+	// TotalBytes is the size of the input, or 0 if the size is unknown.
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+
+	// Percent is the percent of the input read, or 0 if the size of the
+	// input is unknown.
+	Percent float64 `json:"percent,omitempty"`
+
+	// NamesPerSec is the average rate of parsing.
+	NamesPerSec float64 `json:"namesPerSec"`
+
+	// ElapsedSec is the time since the start of parsing in seconds.
+	ElapsedSec float64 `json:"elapsedSec"`
+
+	// ETASec is the estimated time to the end of parsing in seconds, or 0
+	// if the size of the input is unknown.
+	ETASec float64 `json:"etaSec,omitempty"`
+}
+
+// Reporter keeps track of parsing progress and writes reports not more
+// often than its interval. Methods of a nil Reporter do nothing, so a nil
+// Reporter can be used when reports are not needed.
+type Reporter struct {
+	text     *log.Logger
+	json     io.Writer
+	interval time.Duration
+	total    int64
+	bytes    int64
+
+	mx    sync.Mutex
+	names int64
+	start time.Time
+	last  time.Time
+}
+
+// Option is a type of functions that modify Reporter.
+type Option func(*Reporter)
+
+// OptText sets a writer for human-readable reports.
+func OptText(w io.Writer) Option {
+	return func(r *Reporter) {
+		r.text = log.New(w, "", log.LstdFlags)
+	}
+}
+
+// OptJSON sets a writer for JSON events, one event per line.
+func OptJSON(w io.Writer) Option {
+	return func(r *Reporter) {
+		r.json = w
+	}
+}
+
+// OptInterval sets the minimal time between reports.
+func OptInterval(d time.Duration) Option {
+	return func(r *Reporter) {
+		if d <= 0 {
+			log.Println("Progress interval should be a positive duration")
+			return
+		}
+		r.interval = d
+	}
+}
+
+// New creates a Reporter for an input of the given size in bytes. If the
+// size is unknown, it should be 0. New returns nil if there is no writer
+// for reports.
+func New(totalBytes int64, opts ...Option) *Reporter {
+	now := time.Now()
+	res := &Reporter{
+		interval: 5 * time.Second,
+		total:    totalBytes,
+		start:    now,
+		last:     now,
+	}
+	for i := range opts {
+		opts[i](res)
+	}
+	if res.text == nil && res.json == nil {
+		return nil
+	}
+	return res
+}
+
+// Reader returns a reader that counts bytes read from the input.
+func (r *Reporter) Reader(rd io.Reader) io.Reader {
+	if r == nil {
+		return rd
+	}
+	return &countReader{r: rd, count: &r.bytes}
+}
+
+// Add records n more parsed names and writes a report if the interval
+// since the last report has passed.
+func (r *Reporter) Add(n int) {
+	if r == nil {
+		return
+	}
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.names += int64(n)
+	now := time.Now()
+	if now.Sub(r.last) < r.interval {
+		return
+	}
+	r.last = now
+	r.report(r.event("progress", now))
+}
+
+// Finish writes the final report.
+func (r *Reporter) Finish() {
+	if r == nil {
+		return
+	}
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.report(r.event("done", time.Now()))
+}
+
+func (r *Reporter) event(name string, now time.Time) Event {
+	elapsed := now.Sub(r.start).Seconds()
+	res := Event{
+		Event:      name,
+		Names:      r.names,
+		Bytes:      atomic.LoadInt64(&r.bytes),
+		TotalBytes: r.total,
+		ElapsedSec: round(elapsed),
+	}
+	if elapsed > 0 {
+		res.NamesPerSec = round(float64(res.Names) / elapsed)
+	}
+	if r.total > 0 {
+		done := math.Min(float64(res.Bytes)/float64(r.total), 1)
+		res.Percent = round(done * 100)
+		if name == "done" {
+			res.Percent = 100
+		} else if done > 0 {
+			res.ETASec = round(elapsed * (1 - done) / done)
+		}
+	}
+	return res
+}
+
+func (r *Reporter) report(e Event) {
+	if r.text != nil {
+		r.text.Print(e.Text())
+	}
+	if r.json != nil {
+		data, err := gnfmt.GNjson{}.Encode(e)
+		if err == nil {
+			_, err = fmt.Fprintln(r.json, string(data))
+		}
+		if err != nil {
+			log.Printf("Cannot write progress: %s.", err)
+		}
+	}
+}
+
+// Text converts an event into a human-readable line.
+func (e Event) Text() string {
+	verb := "Parsed"
+	if e.Event == "done" {
+		verb = "Finished, parsed"
+	}
+	res := fmt.Sprintf("%s %d names, %.0f names/sec", verb, e.Names, e.NamesPerSec)
+	if e.TotalBytes > 0 && e.Event != "done" {
+		eta := time.Duration(e.ETASec * float64(time.Second)).Round(time.Second)
+		res += fmt.Sprintf(", %.1f%% done, ETA %s", e.Percent, eta)
+	}
+	if e.Event == "done" {
+		elapsed := time.Duration(e.ElapsedSec * float64(time.Second))
+		res += fmt.Sprintf(", took %s", elapsed.Round(time.Millisecond))
+	}
+	return res + "."
+}
+
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+// countReader counts bytes read from a reader.
+type countReader struct {
+	r     io.Reader
+	count *int64
+}
+
+func (cr *countReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	atomic.AddInt64(cr.count, int64(n))
+	return n, err
+}
@@ -0,0 +1,61 @@
+package progress_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/io/progress"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNil(t *testing.T) {
+	r := progress.New(100)
+	assert.Nil(t, r)
+	in := strings.NewReader("Bubo bubo\n")
+	assert.Equal(t, in, r.Reader(in))
+	r.Add(1)
+	r.Finish()
+}
+
+func TestReport(t *testing.T) {
+	var text, json bytes.Buffer
+	input := "Bubo bubo\nPomatomus saltatrix\n"
+	r := progress.New(int64(2*len(input)),
+		progress.OptText(&text),
+		progress.OptJSON(&json),
+		progress.OptInterval(time.Nanosecond),
+	)
+	_, err := ioutil.ReadAll(r.Reader(strings.NewReader(input)))
+	assert.Nil(t, err)
+	time.Sleep(20 * time.Millisecond)
+	r.Add(2)
+	r.Finish()
+
+	lines := strings.Split(strings.TrimSpace(json.String()), "\n")
+	assert.Equal(t, 2, len(lines))
+	var e progress.Event
+	assert.Nil(t, gnfmt.GNjson{}.Decode([]byte(lines[0]), &e))
+	assert.Equal(t, "progress", e.Event)
+	assert.Equal(t, int64(2), e.Names)
+	assert.Equal(t, int64(len(input)), e.Bytes)
+	assert.Equal(t, 50.0, e.Percent)
+	assert.True(t, e.NamesPerSec > 0)
+	assert.True(t, e.ETASec > 0)
+
+	assert.Nil(t, gnfmt.GNjson{}.Decode([]byte(lines[1]), &e))
+	assert.Equal(t, "done", e.Event)
+	assert.Equal(t, 100.0, e.Percent)
+
+	assert.Contains(t, text.String(), "Parsed 2 names")
+	assert.Contains(t, text.String(), "50.0% done, ETA")
+	assert.Contains(t, text.String(), "Finished, parsed 2 names")
+}
+
+func TestUnknownSize(t *testing.T) {
+	e := progress.Event{Event: "progress", Names: 10, NamesPerSec: 5}
+	assert.Equal(t, "Parsed 10 names, 5 names/sec.", e.Text())
+}
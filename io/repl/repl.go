@@ -0,0 +1,361 @@
+// Package repl provides an interactive mode of gnparser. It parses each
+// entered name-string and shows a compact view of the result. Commands that
+// start with ':' change settings, show the explain tree of a name, or
+// compare the last two names.
+package repl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/labstack/gommon/color"
+	"github.com/peterh/liner"
+)
+
+// viewFormat is the name of the default compact colored format of the REPL.
+const viewFormat = "view"
+
+const help = `Enter a name-string to parse it, or one of commands:
+  :details          toggle details of parsing results
+  :format [name]    set format: view, compact, pretty or csv
+  :explain [name]   show the explain tree of a name, or of the last name
+  :compare          compare the last two names
+  :help             show this help
+  :quit             exit (also Ctrl-D)`
+
+// REPL reads name-strings and commands, parses names and prints results.
+type REPL struct {
+	gnp     gnparser.GNparser
+	out     io.Writer
+	clr     *color.Color
+	history string
+	format  string
+	details bool
+
+	// last keeps up to two recent parsing results, the most recent is the
+	// last one.
+	last []parsed.Parsed
+}
+
+// Option is a type of functions that modify REPL.
+type Option func(*REPL)
+
+// OptOutput sets the writer for results. The default is STDOUT.
+func OptOutput(w io.Writer) Option {
+	return func(r *REPL) {
+		r.out = w
+		r.clr.SetOutput(w)
+	}
+}
+
+// OptHistory sets a file to keep history of entered lines between sessions.
+func OptHistory(path string) Option {
+	return func(r *REPL) {
+		r.history = path
+	}
+}
+
+// OptColor enables or disables colors. By default colors are used only if
+// the output is a terminal.
+func OptColor(b bool) Option {
+	return func(r *REPL) {
+		if b {
+			r.clr.Enable()
+		} else {
+			r.clr.Disable()
+		}
+	}
+}
+
+// New creates a REPL with a parser of the given configuration. The format
+// of the configuration is ignored, results are shown in the "view" format
+// until the user changes it.
+func New(cfg gnparser.Config, opts ...Option) *REPL {
+	res := &REPL{
+		gnp:     gnparser.New(cfg),
+		out:     os.Stdout,
+		clr:     color.New(),
+		format:  viewFormat,
+		details: cfg.WithDetails,
+	}
+	res.clr.SetOutput(os.Stdout)
+	for i := range opts {
+		opts[i](res)
+	}
+	return res
+}
+
+// Run reads lines from the terminal with line editing and history, until
+// the user quits.
+func (r *REPL) Run() error {
+	line := liner.NewLiner()
+	defer line.Close()
+	line.SetCtrlCAborts(true)
+	r.readHistory(line)
+	defer r.writeHistory(line)
+
+	fmt.Fprintln(r.out, "gnparser "+r.gnp.GetVersion().Version+
+		", enter :help for help.")
+	for {
+		s, err := line.Prompt("> ")
+		if errors.Is(err, liner.ErrPromptAborted) {
+			continue
+		}
+		if err == io.EOF {
+			fmt.Fprintln(r.out)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(s) != "" {
+			line.AppendHistory(s)
+		}
+		if quit := r.Exec(s); quit {
+			return nil
+		}
+	}
+}
+
+// Exec processes one entered line. It returns true if the user wants to
+// quit.
+func (r *REPL) Exec(s string) bool {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return false
+	}
+	if !strings.HasPrefix(s, ":") {
+		r.parse(s)
+		return false
+	}
+
+	fields := strings.Fields(s)
+	cmd, arg := fields[0], strings.TrimSpace(s[len(fields[0]):])
+	switch cmd {
+	case ":q", ":quit", ":exit":
+		return true
+	case ":h", ":help":
+		fmt.Fprintln(r.out, help)
+	case ":details":
+		r.details = !r.details
+		r.gnp = r.gnp.ChangeConfig(gnparser.OptWithDetails(r.details))
+		fmt.Fprintf(r.out, "Details: %t\n", r.details)
+	case ":format":
+		r.setFormat(arg)
+	case ":explain":
+		r.explain(arg)
+	case ":compare":
+		r.compare()
+	default:
+		r.errorf("Unknown command '%s', enter :help for help.", cmd)
+	}
+	return false
+}
+
+func (r *REPL) parse(name string) {
+	res := r.gnp.ParseName(name)
+	r.last = append(r.last, res)
+	if len(r.last) > 2 {
+		r.last = r.last[1:]
+	}
+
+	switch r.format {
+	case viewFormat:
+		fmt.Fprint(r.out, r.view(res))
+	case "csv":
+		fmt.Fprintln(r.out, parsed.HeaderCSV())
+		fmt.Fprintln(r.out, res.Output(gnfmt.CSV))
+	default:
+		f, _ := gnfmt.NewFormat(r.format)
+		fmt.Fprintln(r.out, res.Output(f))
+	}
+}
+
+func (r *REPL) setFormat(s string) {
+	switch s {
+	case "":
+		fmt.Fprintf(r.out, "Format: %s\n", r.format)
+	case viewFormat, "csv", "compact", "pretty":
+		r.format = s
+		fmt.Fprintf(r.out, "Format: %s\n", r.format)
+	default:
+		r.errorf("Unknown format '%s', use view, compact, pretty or csv.", s)
+	}
+}
+
+func (r *REPL) explain(name string) {
+	if name == "" {
+		if len(r.last) == 0 {
+			r.errorf("There is no name to explain yet.")
+			return
+		}
+		name = r.last[len(r.last)-1].Verbatim
+	}
+	fmt.Fprint(r.out, r.gnp.Explain(name).Text())
+}
+
+// view creates a compact colored description of a parsing result.
+func (r *REPL) view(p parsed.Parsed) string {
+	var b strings.Builder
+	label := func(s string) string {
+		return r.clr.Grey(fmt.Sprintf("  %-13s", s+":"))
+	}
+
+	if !p.Parsed {
+		b.WriteString(r.clr.Red("not parsed", color.B))
+		if p.Virus {
+			b.WriteString(" (virus)")
+		}
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	c := p.Canonical
+	b.WriteString(r.clr.Green(c.Simple, color.B) + "\n")
+	if c.Full != c.Simple {
+		b.WriteString(label("full") + c.Full + "\n")
+	}
+	b.WriteString(label("stemmed") + c.Stemmed + "\n")
+	if p.Authorship != nil {
+		b.WriteString(label("authorship") + r.clr.Cyan(p.Authorship.Normalized))
+		if p.Authorship.Year != "" {
+			b.WriteString(" (year " + p.Authorship.Year + ")")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(label("cardinality") + fmt.Sprintf("%d\n", p.Cardinality))
+	b.WriteString(label("quality") + r.quality(p.ParseQuality) + "\n")
+	for _, v := range annotations(p) {
+		b.WriteString(label(v[0]) + r.clr.Magenta(v[1]) + "\n")
+	}
+	if p.Tail != "" {
+		b.WriteString(label("tail") + r.clr.Red(strings.TrimSpace(p.Tail)) + "\n")
+	}
+	for _, v := range p.QualityWarnings {
+		b.WriteString(label("warning") +
+			r.quality(v.Quality) + " " + v.Warning.String() + "\n")
+	}
+	if r.details {
+		for _, v := range p.Words {
+			b.WriteString(label("word") +
+				fmt.Sprintf("%s %s\n", v.Verbatim, r.clr.Grey(v.Type.String())))
+		}
+	}
+	return b.String()
+}
+
+func (r *REPL) quality(q int) string {
+	s := fmt.Sprintf("%d", q)
+	switch q {
+	case 1:
+		return r.clr.Green(s)
+	case 2:
+		return r.clr.Yellow(s)
+	default:
+		return r.clr.Red(s)
+	}
+}
+
+// compare shows differences between the last two parsing results.
+func (r *REPL) compare() {
+	if len(r.last) < 2 {
+		r.errorf("Enter two names to compare them.")
+		return
+	}
+	p1, p2 := r.last[0], r.last[1]
+	fmt.Fprintf(r.out, "1: %s\n2: %s\n", p1.Verbatim, p2.Verbatim)
+	f1, f2 := compareFields(p1), compareFields(p2)
+	for i := range f1 {
+		lbl := r.clr.Grey(fmt.Sprintf("  %-13s", f1[i][0]+":"))
+		if f1[i][1] == f2[i][1] {
+			fmt.Fprintf(r.out, "%s%s %s\n", lbl, r.clr.Green("same"), f1[i][1])
+			continue
+		}
+		fmt.Fprintf(r.out, "%s%s %s | %s\n",
+			lbl, r.clr.Yellow("differ"), f1[i][1], f2[i][1])
+	}
+}
+
+// compareFields returns pairs of labels and values of a parsing result
+// that are used for comparison of names.
+func compareFields(p parsed.Parsed) [][2]string {
+	var simple, full, stemmed, auth string
+	if c := p.Canonical; c != nil {
+		simple, full, stemmed = c.Simple, c.Full, c.Stemmed
+	}
+	if p.Authorship != nil {
+		auth = p.Authorship.Normalized
+	}
+	ws := make([]string, len(p.QualityWarnings))
+	for i, v := range p.QualityWarnings {
+		ws[i] = v.Warning.Code()
+	}
+	res := [][2]string{
+		{"canonical", simple},
+		{"full", full},
+		{"stemmed", stemmed},
+		{"authorship", auth},
+		{"cardinality", fmt.Sprintf("%d", p.Cardinality)},
+		{"quality", fmt.Sprintf("%d", p.ParseQuality)},
+		{"warnings", strings.Join(ws, ",")},
+	}
+	for i := range res {
+		if res[i][1] == "" {
+			res[i][1] = "-"
+		}
+	}
+	return res
+}
+
+// annotations returns labels and values of hybrid, surrogate, bacteria and
+// extinct annotations of a result.
+func annotations(p parsed.Parsed) [][2]string {
+	var res [][2]string
+	if p.Hybrid != nil {
+		res = append(res, [2]string{"hybrid", p.Hybrid.String()})
+	}
+	if p.Surrogate != nil {
+		res = append(res, [2]string{"surrogate", p.Surrogate.String()})
+	}
+	if p.Bacteria != nil {
+		res = append(res, [2]string{"bacteria", p.Bacteria.String()})
+	}
+	if p.Extinct {
+		res = append(res, [2]string{"extinct", "true"})
+	}
+	return res
+}
+
+func (r *REPL) errorf(format string, a ...interface{}) {
+	fmt.Fprintln(r.out, r.clr.Red(fmt.Sprintf(format, a...)))
+}
+
+func (r *REPL) readHistory(line *liner.State) {
+	if r.history == "" {
+		return
+	}
+	f, err := os.Open(r.history)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_, _ = line.ReadHistory(f)
+}
+
+func (r *REPL) writeHistory(line *liner.State) {
+	if r.history == "" {
+		return
+	}
+	f, err := os.Create(r.history)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_, _ = line.WriteHistory(f)
+}
@@ -0,0 +1,62 @@
+package repl_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/repl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExec(t *testing.T) {
+	var out bytes.Buffer
+	r := repl.New(gnparser.NewConfig(),
+		repl.OptOutput(&out), repl.OptColor(false))
+
+	data := []struct {
+		msg, line string
+		quit      bool
+		res       []string
+	}{
+		{"view", "Bubo bubo (Linnaeus, 1758)", false, []string{
+			"Bubo bubo\n", "stemmed:     Bubo bub\n",
+			"authorship:  (Linnaeus 1758) (year 1758)\n", "quality:     1\n",
+		}},
+		{"warning", "Bubo bubo sensu Smith", false, []string{
+			"warning:     4 Unparsed tail\n", "tail:        sensu Smith\n",
+		}},
+		{"compare", ":compare", false, []string{
+			"1: Bubo bubo (Linnaeus, 1758)\n",
+			"canonical:   same Bubo bubo\n",
+			"quality:     differ 1 | 4\n",
+		}},
+		{"details", ":details", false, []string{"Details: true\n"}},
+		{"words", "Bubo bubo", false, []string{"word:        bubo SPECIES\n"}},
+		{"format", ":format compact", false, []string{"Format: compact\n"}},
+		{"compact", "Bubo bubo", false, []string{`{"parsed":true,`}},
+		{"bad format", ":format xml", false, []string{"Unknown format 'xml'"}},
+		{"explain", ":explain", false, []string{"PEG tree:\n", "SpeciesEpithet"}},
+		{"unknown", ":foo", false, []string{"Unknown command ':foo'"}},
+		{"help", ":help", false, []string{":compare"}},
+		{"quit", ":quit", true, nil},
+	}
+	for _, v := range data {
+		out.Reset()
+		assert.Equal(t, v.quit, r.Exec(v.line), v.msg)
+		for _, s := range v.res {
+			assert.Contains(t, out.String(), s, v.msg)
+		}
+	}
+}
+
+func TestCompareEmpty(t *testing.T) {
+	var out bytes.Buffer
+	r := repl.New(gnparser.NewConfig(),
+		repl.OptOutput(&out), repl.OptColor(false))
+	r.Exec(":compare")
+	assert.Contains(t, out.String(), "Enter two names")
+	out.Reset()
+	r.Exec(":explain")
+	assert.Contains(t, out.String(), "no name to explain")
+}
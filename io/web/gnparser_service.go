@@ -6,15 +6,21 @@ import (
 
 type gnparserService struct {
 	gnparser.GNparser
-	port int
+	port   int
+	limits Limits
 }
 
 // NewGNparserService creates a new object that implements GNparserService
-// interface.
-func NewGNparserService(gnp gnparser.GNparser, port int) GNparserService {
+// interface. Options modify default Limits of the service.
+func NewGNparserService(
+	gnp gnparser.GNparser,
+	port int,
+	opts ...Option,
+) GNparserService {
 	res := gnparserService{
 		GNparser: gnp,
 		port:     port,
+		limits:   NewLimits(opts...),
 	}
 	return &res
 }
@@ -28,3 +34,8 @@ func (gnps *gnparserService) Ping() string {
 func (gnps *gnparserService) Port() int {
 	return gnps.port
 }
+
+// Limits returns restrictions applied to requests.
+func (gnps *gnparserService) Limits() Limits {
+	return gnps.limits
+}
@@ -12,4 +12,6 @@ type GNparserService interface {
 	Ping() string
 	// Port returns the port of the service.
 	Port() int
+	// Limits returns restrictions applied to requests to the service.
+	Limits() Limits
 }
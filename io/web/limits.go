@@ -0,0 +1,303 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/labstack/gommon/bytes"
+)
+
+// Limits keep restrictions that protect the web-service from requests that
+// are too large, too slow, or too frequent.
+type Limits struct {
+	// MaxNames is the maximum number of names allowed in one request.
+	MaxNames int
+
+	// BodyLimit is the maximum size of a request body. It is given as
+	// `4x` or `4xB`, where x is one of K, M, G, T or P.
+	BodyLimit string
+
+	// Timeout is the maximum duration of processing of one request.
+	Timeout time.Duration
+
+	// MaxConcurrent is the maximum number of requests processed at the same
+	// time. Requests above the limit wait for a free slot not longer than
+	// Timeout. The time of waiting is not taken from the Timeout of
+	// processing.
+	MaxConcurrent int
+
+	// RateLimit is the number of requests per second allowed from one
+	// client IP. If it is 0, the rate is not limited.
+	RateLimit float64
+
+	// RateBurst is the number of requests a client IP can send in a burst
+	// above the RateLimit.
+	RateBurst int
+
+	// TrustProxy is true if the service runs behind a reverse proxy. Then
+	// the client IP for the RateLimit is taken from X-Forwarded-For header.
+	// Otherwise the IP is taken from the connection, because any client can
+	// send such headers.
+	TrustProxy bool
+
+	// ShutdownTimeout is the time given to in-flight requests to finish
+	// after the service received SIGINT or SIGTERM.
+	ShutdownTimeout time.Duration
+}
+
+// NewLimits returns default Limits modified by given options.
+func NewLimits(opts ...Option) Limits {
+	res := Limits{
+		MaxNames:        5_000,
+		BodyLimit:       "10M",
+		Timeout:         time.Minute,
+		MaxConcurrent:   100,
+		ShutdownTimeout: 30 * time.Second,
+	}
+	for i := range opts {
+		opts[i](&res)
+	}
+	return res
+}
+
+// Option is a type of functions that modify Limits.
+type Option func(*Limits)
+
+// OptMaxNames sets the maximum number of names in one request.
+func OptMaxNames(i int) Option {
+	return func(l *Limits) {
+		if i <= 0 {
+			log.Println("Maximum number of names should be a positive number")
+			return
+		}
+		l.MaxNames = i
+	}
+}
+
+// OptBodyLimit sets the maximum size of a request body, for example "4M".
+func OptBodyLimit(s string) Option {
+	return func(l *Limits) {
+		if _, err := bytes.Parse(s); err != nil {
+			log.Printf("Cannot set body limit to '%s': %s.", s, err)
+			return
+		}
+		l.BodyLimit = s
+	}
+}
+
+// OptTimeout sets the maximum duration of a request.
+func OptTimeout(d time.Duration) Option {
+	return func(l *Limits) {
+		if d <= 0 {
+			log.Println("Timeout should be a positive duration")
+			return
+		}
+		l.Timeout = d
+	}
+}
+
+// OptMaxConcurrent sets the maximum number of simultaneous requests.
+func OptMaxConcurrent(i int) Option {
+	return func(l *Limits) {
+		if i <= 0 {
+			log.Println("Maximum of concurrent requests should be a positive number")
+			return
+		}
+		l.MaxConcurrent = i
+	}
+}
+
+// OptRateLimit sets a number of requests per second and a burst size
+// allowed for one client IP. Zero rate removes the limit.
+func OptRateLimit(rate float64, burst int) Option {
+	return func(l *Limits) {
+		if rate < 0 || burst < 0 {
+			log.Println("Rate limit and burst cannot be negative")
+			return
+		}
+		l.RateLimit = rate
+		l.RateBurst = burst
+	}
+}
+
+// OptTrustProxy sets the TrustProxy field.
+func OptTrustProxy(b bool) Option {
+	return func(l *Limits) {
+		l.TrustProxy = b
+	}
+}
+
+// OptShutdownTimeout sets the time given to in-flight requests to finish
+// during shutdown of the service.
+func OptShutdownTimeout(d time.Duration) Option {
+	return func(l *Limits) {
+		if d <= 0 {
+			log.Println("Shutdown timeout should be a positive duration")
+			return
+		}
+		l.ShutdownTimeout = d
+	}
+}
+
+// errTimeout is returned when a request is not processed in time.
+var errTimeout = echo.NewHTTPError(
+	http.StatusServiceUnavailable,
+	"request processing exceeded the time limit",
+)
+
+// checkNamesNum returns an error if a request contains more names than
+// allowed.
+func checkNamesNum(l Limits, num int) error {
+	if num <= l.MaxNames {
+		return nil
+	}
+	msg := fmt.Sprintf(
+		"request contains %d names, the limit is %d names", num, l.MaxNames,
+	)
+	return echo.NewHTTPError(http.StatusBadRequest, msg)
+}
+
+// timeout middleware adds a deadline to the context of a request.
+func timeout(d time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx, cancel := context.WithTimeout(c.Request().Context(), d)
+			defer cancel()
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// concurrency middleware limits the number of requests processed at the
+// same time. A request waits for a free slot not longer than the wait
+// duration, or until its context is done.
+func concurrency(max int, wait time.Duration) echo.MiddlewareFunc {
+	sem := make(chan struct{}, max)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+				return next(c)
+			case <-timer.C:
+				return echo.NewHTTPError(
+					http.StatusServiceUnavailable,
+					"too many concurrent requests, try again later",
+				)
+			case <-c.Request().Context().Done():
+				return echo.NewHTTPError(
+					http.StatusServiceUnavailable,
+					"too many concurrent requests, try again later",
+				)
+			}
+		}
+	}
+}
+
+// bucket is a token bucket of one client.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter keeps token buckets for every client IP.
+type rateLimiter struct {
+	sync.Mutex
+	rate    float64
+	burst   float64
+	buckets map[string]*bucket
+	calls   int
+}
+
+// cleanupEvery sets how often, in calls, idle buckets are removed.
+const cleanupEvery = 10_000
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    rate,
+		burst:   math.Max(float64(burst), 1),
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// allow reports if a client with the given IP can make a request now.
+func (rl *rateLimiter) allow(ip string, now time.Time) bool {
+	rl.Lock()
+	defer rl.Unlock()
+	rl.calls++
+	if rl.calls%cleanupEvery == 0 {
+		rl.cleanup(now)
+	}
+	b, ok := rl.buckets[ip]
+	if !ok {
+		b = &bucket{tokens: rl.burst, last: now}
+		rl.buckets[ip] = b
+	}
+	b.tokens += now.Sub(b.last).Seconds() * rl.rate
+	if b.tokens > rl.burst {
+		b.tokens = rl.burst
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// cleanup removes buckets that are full again, as they carry no state.
+func (rl *rateLimiter) cleanup(now time.Time) {
+	for k, v := range rl.buckets {
+		if v.tokens+now.Sub(v.last).Seconds()*rl.rate >= rl.burst {
+			delete(rl.buckets, k)
+		}
+	}
+}
+
+// useLimits adds middleware that enforce limits to the service. The
+// concurrency limit goes before the timeout, so waiting for a free slot
+// does not use up the time given for processing of a request.
+func useLimits(e *echo.Echo, lim Limits) {
+	e.IPExtractor = ipExtractor(lim.TrustProxy)
+	if lim.RateLimit > 0 {
+		e.Use(rateLimit(lim.RateLimit, lim.RateBurst))
+	}
+	e.Use(middleware.BodyLimit(lim.BodyLimit))
+	e.Use(concurrency(lim.MaxConcurrent, lim.Timeout))
+	e.Use(timeout(lim.Timeout))
+}
+
+// ipExtractor returns a function that finds the IP of a client. Headers
+// with the IP are used only if the service runs behind a trusted proxy.
+func ipExtractor(trustProxy bool) echo.IPExtractor {
+	if trustProxy {
+		return echo.ExtractIPFromXFFHeader()
+	}
+	return echo.ExtractIPDirect()
+}
+
+// rateLimit middleware rejects requests of clients that exceed their rate.
+func rateLimit(rate float64, burst int) echo.MiddlewareFunc {
+	rl := newRateLimiter(rate, burst)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !rl.allow(c.RealIP(), time.Now()) {
+				return echo.NewHTTPError(
+					http.StatusTooManyRequests,
+					"request rate limit exceeded, try again later",
+				)
+			}
+			return next(c)
+		}
+	}
+}
@@ -1,14 +1,21 @@
 package web
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/filter"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/io/fs"
 	"github.com/labstack/echo/v4"
@@ -17,15 +24,22 @@ import (
 
 const withLogs = false
 
+// writeTimeoutMargin gives a handler that reached its timeout a chance to
+// write the error response.
+const writeTimeoutMargin = 5 * time.Second
+
 type inputPOST struct {
-	Names       []string `json:"names"`
-	WithDetails bool     `json:"withDetails,omitempty"`
-	CSV         bool     `json:"csv,omitempty"`
+	Names           []string `json:"names"`
+	WithDetails     bool     `json:"withDetails,omitempty"`
+	WithPublication bool     `json:"withPublication,omitempty"`
+	CSV             bool     `json:"csv,omitempty"`
 }
 
 // Run starts the GNparser web service and servies both RESTful API and
-// a website.
+// a website. The service stops gracefully on SIGINT or SIGTERM, giving
+// in-flight requests time to finish.
 func Run(gnps GNparserService) {
+	lim := gnps.Limits()
 	e := echo.New()
 	e.Renderer = templates()
 	e.Use(middleware.Gzip())
@@ -33,12 +47,16 @@ func Run(gnps GNparserService) {
 	if withLogs {
 		e.Use(middleware.Logger())
 	}
+	useLimits(e, lim)
+
 	e.GET("/", home(gnps))
 	e.GET("/doc/api", docAPI())
 	e.GET("/api", info())
 	e.GET("/api/v1", info())
 	e.GET("/api/v1/ping", ping(gnps))
 	e.GET("/api/v1/version", ver(gnps))
+	e.GET("/api/v1/cache_stats", cacheStats(gnps))
+	e.GET("/api/v1/explain/:name", explain(gnps))
 	e.GET("/api/v1/:names", parseNamesGET(gnps))
 	e.GET("/api/:names", parseNamesGET(gnps))
 	e.POST("/api/v1", parseNamesPOST(gnps))
@@ -48,12 +66,31 @@ func Run(gnps GNparserService) {
 	e.GET("/static/*", echo.WrapHandler(http.StripPrefix("/static/", assetHandler)))
 
 	addr := fmt.Sprintf(":%d", gnps.Port())
+	// a request can wait for a free slot and then be processed, both
+	// up to the Timeout.
 	s := &http.Server{
 		Addr:         addr,
-		ReadTimeout:  5 * time.Minute,
-		WriteTimeout: 5 * time.Minute,
+		Handler:      e,
+		ReadTimeout:  lim.Timeout,
+		WriteTimeout: 2*lim.Timeout + writeTimeoutMargin,
+	}
+
+	go func() {
+		log.Printf("Starting gnparser web service on port %d.", gnps.Port())
+		if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+	log.Println("Shutting down web service, waiting for in-flight requests.")
+	ctx, cancel := context.WithTimeout(context.Background(), lim.ShutdownTimeout)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		log.Fatal(err)
 	}
-	e.Logger.Fatal(e.StartServer(s))
 }
 
 func info() func(c echo.Context) error {
@@ -81,14 +118,46 @@ func ver(gnps GNparserService) func(echo.Context) error {
 	}
 }
 
+func cacheStats(gnps GNparserService) func(echo.Context) error {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusOK, gnps.CacheStats())
+	}
+}
+
+func explain(gnps GNparserService) func(echo.Context) error {
+	return func(c echo.Context) error {
+		name, _ := url.QueryUnescape(c.Param("name"))
+		res := gnps.Explain(name)
+		if c.QueryParam("format") == "text" {
+			return c.String(http.StatusOK, res.Text())
+		}
+		return c.JSON(http.StatusOK, res)
+	}
+}
+
 func parseNamesGET(gnps GNparserService) func(echo.Context) error {
 	return func(c echo.Context) error {
 		nameStr, _ := url.QueryUnescape(c.Param("names"))
 		csv := c.QueryParam("csv") == "true"
 		det := c.QueryParam("with_details") == "true"
-		gnp := gnps.ChangeConfig(opts(c, csv, det)...)
+		pub := c.QueryParam("with_publication") == "true"
+		gnp := gnps.ChangeConfig(opts(c, csv, det, pub)...)
 		names := strings.Split(nameStr, "|")
-		res := gnp.ParseNames(names)
+		if err := checkNamesNum(gnps.Limits(), len(names)); err != nil {
+			return err
+		}
+		flt, err := queryFilter(c)
+		if err != nil {
+			return err
+		}
+		res, err := gnp.ParseNamesCtx(c.Request().Context(), names)
+		if c.Request().Context().Err() != nil {
+			return errTimeout
+		}
+		if err != nil {
+			log.Println(err)
+		}
+		res, _ = flt.Split(res)
 		return formatNames(c, res, gnp.Format())
 	}
 }
@@ -99,8 +168,24 @@ func parseNamesPOST(gnps GNparserService) func(echo.Context) error {
 		if err := c.Bind(&input); err != nil {
 			return err
 		}
-		gnp := gnps.ChangeConfig(opts(c, input.CSV, input.WithDetails)...)
-		res := gnp.ParseNames(input.Names)
+		if err := checkNamesNum(gnps.Limits(), len(input.Names)); err != nil {
+			return err
+		}
+		flt, err := queryFilter(c)
+		if err != nil {
+			return err
+		}
+		gnp := gnps.ChangeConfig(
+			opts(c, input.CSV, input.WithDetails, input.WithPublication)...,
+		)
+		res, err := gnp.ParseNamesCtx(c.Request().Context(), input.Names)
+		if c.Request().Context().Err() != nil {
+			return errTimeout
+		}
+		if err != nil {
+			log.Println(err)
+		}
+		res, _ = flt.Split(res)
 		return formatNames(c, res, gnp.Format())
 	}
 }
@@ -123,13 +208,54 @@ func formatNames(
 	}
 }
 
-func opts(c echo.Context, csv, details bool) []gnparser.Option {
-	if csv {
-		return []gnparser.Option{gnparser.OptFormat("csv")}
+// queryFilter creates a filter of results from query parameters
+// min_quality, max_quality, cardinality, has_warning, hybrid, surrogate
+// and bacteria.
+func queryFilter(c echo.Context) (filter.Filter, error) {
+	res := filter.New()
+	badRequest := func(err error) (filter.Filter, error) {
+		return res, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	var err error
+	if q := c.QueryParam("min_quality"); q != "" {
+		if res.MinQuality, err = strconv.Atoi(q); err != nil {
+			return badRequest(err)
+		}
 	}
+	if q := c.QueryParam("max_quality"); q != "" {
+		if res.MaxQuality, err = strconv.Atoi(q); err != nil {
+			return badRequest(err)
+		}
+	}
+	if err = res.Validate(); err != nil {
+		return badRequest(err)
+	}
+	if res.Cardinality, err = filter.ParseCardinality(
+		c.QueryParam("cardinality"),
+	); err != nil {
+		return badRequest(err)
+	}
+	if res.Warnings, err = filter.ParseWarnings(
+		c.QueryParam("has_warning"),
+	); err != nil {
+		return badRequest(err)
+	}
+	res.Hybrid = c.QueryParam("hybrid") == "true"
+	res.Surrogate = c.QueryParam("surrogate") == "true"
+	res.Bacteria = c.QueryParam("bacteria") == "true"
+	return res, nil
+}
+
+func opts(c echo.Context, csv, details, pub bool) []gnparser.Option {
 	var res []gnparser.Option
+	if pub {
+		res = append(res, gnparser.OptWithPublication(true))
+	}
+	if csv {
+		return append(res, gnparser.OptFormat("csv"))
+	}
 	if details {
-		res = []gnparser.Option{gnparser.OptWithDetails(true)}
+		res = append(res, gnparser.OptWithDetails(true))
 	}
 	return res
 }
@@ -58,6 +58,9 @@ func home(gnps GNparserService) func(echo.Context) error {
 		data.HomePage = true
 		data.Input = c.QueryParam("q")
 		names := strings.Split(data.Input, "\n")
+		if err := checkNamesNum(gnps.Limits(), len(names)); err != nil {
+			return err
+		}
 		for i := range names {
 			if len(names[i]) == 0 {
 				continue
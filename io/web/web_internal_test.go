@@ -7,11 +7,14 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnlib/ent/gnvers"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/cache"
 	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/parser"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 )
@@ -81,6 +84,49 @@ func TestVer(t *testing.T) {
 	assert.Regexp(t, `^v\d+\.\d+\.\d+`, response.Version)
 }
 
+func TestCacheStats(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptCacheSize(10))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0)
+	gnps.ParseNames([]string{"Bubo bubo", "Bubo bubo"})
+	c, rec := handlerGET("/cache_stats")
+
+	assert.Nil(t, cacheStats(gnps)(c))
+	var response cache.Stats
+	err := gnfmt.GNjson{}.Decode(rec.Body.Bytes(), &response)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, response.Size)
+	assert.Equal(t, 1, response.Len)
+	assert.Equal(t, uint64(2), response.Hits+response.Misses)
+}
+
+func TestExplain(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig())
+	gnps := NewGNparserService(gnp, 0)
+	name := url.PathEscape("Bubo bubo sensu Smith")
+
+	c, rec := handlerGET("/explain/" + name)
+	c.SetPath("/explain/:name")
+	c.SetParamNames("name")
+	c.SetParamValues(name)
+	assert.Nil(t, explain(gnps)(c))
+	var response struct {
+		Preprocessing parser.Preprocessing
+		Tree          *parser.RuleNode
+	}
+	err := gnfmt.GNjson{}.Decode(rec.Body.Bytes(), &response)
+	assert.Nil(t, err)
+	assert.Equal(t, "Bubo bubo", response.Preprocessing.Body)
+	assert.Equal(t, "SciName", response.Tree.Rule)
+
+	c, rec = handlerGET("/explain/" + name + "?format=text")
+	c.SetPath("/explain/:name")
+	c.SetParamNames("name")
+	c.SetParamValues(name)
+	assert.Nil(t, explain(gnps)(c))
+	assert.Contains(t, rec.Body.String(), "PEG tree:")
+}
+
 func TestParseGET(t *testing.T) {
 	cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
 	gnp := gnparser.New(cfg)
@@ -165,6 +211,81 @@ func TestParseParamsGET(t *testing.T) {
 	}
 }
 
+func TestParsePublicationGET(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptFormat("compact")))
+	gnps := NewGNparserService(gnp, 0)
+	name := url.QueryEscape("Carex flava L., Sp. Pl. 2: 975. 1753")
+
+	for _, pub := range []string{"false", "true"} {
+		e := echo.New()
+		q := make(url.Values)
+		q.Set("with_publication", pub)
+		req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:names")
+		c.SetParamNames("names")
+		c.SetParamValues(name)
+
+		assert.Nil(t, parseNamesGET(gnps)(c))
+		var response []parsed.Parsed
+		err := gnfmt.GNjson{}.Decode(rec.Body.Bytes(), &response)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(response))
+		if pub == "true" {
+			assert.Equal(t, "Sp. Pl.", response[0].Publication.Title)
+			assert.Equal(t, 1, response[0].ParseQuality)
+		} else {
+			assert.Nil(t, response[0].Publication)
+		}
+	}
+}
+
+func TestParseFilterGET(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptFormat("compact")))
+	gnps := NewGNparserService(gnp, 0)
+	name := url.QueryEscape("Bubo bubo|Bubo|Aus bus junk 1234|Aus × bus")
+
+	tests := []struct {
+		query  string
+		status int
+		names  []string
+	}{
+		{"", http.StatusOK,
+			[]string{"Bubo bubo", "Bubo", "Aus bus junk 1234", "Aus × bus"}},
+		{"cardinality=2", http.StatusOK, []string{"Bubo bubo", "Aus × bus"}},
+		{"max_quality=1", http.StatusOK, []string{"Bubo bubo", "Bubo"}},
+		{"has_warning=TAIL", http.StatusOK, []string{"Aus bus junk 1234"}},
+		{"hybrid=true", http.StatusOK, []string{"Aus × bus"}},
+		{"surrogate=true", http.StatusOK, []string{}},
+		{"max_quality=7", http.StatusBadRequest, nil},
+		{"has_warning=NOPE", http.StatusBadRequest, nil},
+	}
+
+	for _, v := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/?"+v.query, nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetPath("/:names")
+		c.SetParamNames("names")
+		c.SetParamValues(name)
+
+		err := parseNamesGET(gnps)(c)
+		if v.status != http.StatusOK {
+			assert.Equal(t, v.status, err.(*echo.HTTPError).Code, v.query)
+			continue
+		}
+		assert.Nil(t, err)
+		var response []parsed.Parsed
+		assert.Nil(t, gnfmt.GNjson{}.Decode(rec.Body.Bytes(), &response))
+		names := make([]string, len(response))
+		for i := range response {
+			names[i] = response[i].Verbatim
+		}
+		assert.Equal(t, v.names, names, v.query)
+	}
+}
+
 func TestParsePOST(t *testing.T) {
 	cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
 	gnp := gnparser.New(cfg)
@@ -224,3 +345,154 @@ func TestParsePOST(t *testing.T) {
 	assert.Nil(t, parseNamesPOST(gnps)(c))
 	assert.True(t, strings.HasPrefix(rec.Body.String(), "Id"))
 }
+
+func TestMaxNames(t *testing.T) {
+	cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
+	gnp := gnparser.New(cfg)
+	gnps := NewGNparserService(gnp, 0, OptMaxNames(2))
+
+	namesQuery := url.QueryEscape("Bubo bubo|Pomatomus|Pardosa moesta")
+	c, _ := handlerGET("/" + namesQuery)
+	c.SetPath("/:names")
+	c.SetParamNames("names")
+	c.SetParamValues(namesQuery)
+	err := parseNamesGET(gnps)(c)
+	he, ok := err.(*echo.HTTPError)
+	assert.True(t, ok)
+	assert.Equal(t, he.Code, http.StatusBadRequest)
+
+	params := inputPOST{Names: []string{"Bubo bubo", "Pomatomus", "Aus bus"}}
+	reqBody, err := gnfmt.GNjson{}.Encode(params)
+	assert.Nil(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c = echo.New().NewContext(req, rec)
+	err = parseNamesPOST(gnps)(c)
+	he, ok = err.(*echo.HTTPError)
+	assert.True(t, ok)
+	assert.Equal(t, he.Code, http.StatusBadRequest)
+}
+
+func TestLimitsOpts(t *testing.T) {
+	lim := NewLimits(
+		OptMaxNames(10),
+		OptBodyLimit("1M"),
+		OptBodyLimit("wrong"),
+		OptTimeout(time.Second),
+		OptMaxConcurrent(-1),
+		OptRateLimit(2, 3),
+		OptTrustProxy(true),
+	)
+	assert.Equal(t, lim.MaxNames, 10)
+	assert.Equal(t, lim.BodyLimit, "1M")
+	assert.Equal(t, lim.Timeout, time.Second)
+	assert.Equal(t, lim.MaxConcurrent, 100)
+	assert.Equal(t, lim.RateLimit, 2.0)
+	assert.Equal(t, lim.RateBurst, 3)
+	assert.True(t, lim.TrustProxy)
+}
+
+func TestConcurrencyWait(t *testing.T) {
+	e := echo.New()
+	useLimits(e, NewLimits(
+		OptMaxConcurrent(1),
+		OptTimeout(200*time.Millisecond),
+	))
+	release := make(chan struct{})
+	e.GET("/slow", func(c echo.Context) error {
+		<-release
+		return c.String(http.StatusOK, "ok")
+	})
+	e.GET("/fast", func(c echo.Context) error {
+		deadline, _ := c.Request().Context().Deadline()
+		left := time.Until(deadline)
+		return c.String(http.StatusOK, left.String())
+	})
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.Equal(t, http.StatusOK, serve("/slow").Code)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		release <- struct{}{}
+	}()
+
+	// the request waits for the slow one, but gets its full timeout.
+	rec := serve("/fast")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	left, err := time.ParseDuration(rec.Body.String())
+	assert.Nil(t, err)
+	assert.Greater(t, left, 150*time.Millisecond)
+	<-done
+
+	// the request cannot wait longer than the timeout for a slot.
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		serve("/slow")
+	}()
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, http.StatusServiceUnavailable, serve("/fast").Code)
+	release <- struct{}{}
+	<-done
+}
+
+func TestRateLimiter(t *testing.T) {
+	rl := newRateLimiter(1, 2)
+	now := time.Now()
+	assert.True(t, rl.allow("1.1.1.1", now))
+	assert.True(t, rl.allow("1.1.1.1", now))
+	assert.False(t, rl.allow("1.1.1.1", now))
+	assert.True(t, rl.allow("2.2.2.2", now))
+	assert.True(t, rl.allow("1.1.1.1", now.Add(time.Second)))
+	assert.False(t, rl.allow("1.1.1.1", now.Add(time.Second)))
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	e := echo.New()
+	h := rateLimit(0.001, 1)(func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	c := e.NewContext(req, httptest.NewRecorder())
+	assert.Nil(t, h(c))
+	c = e.NewContext(req, httptest.NewRecorder())
+	err := h(c)
+	he, ok := err.(*echo.HTTPError)
+	assert.True(t, ok)
+	assert.Equal(t, he.Code, http.StatusTooManyRequests)
+}
+
+func TestRateLimitSpoofedIP(t *testing.T) {
+	for _, trust := range []bool{false, true} {
+		e := echo.New()
+		e.IPExtractor = ipExtractor(trust)
+		h := rateLimit(0.001, 1)(func(c echo.Context) error {
+			return c.String(http.StatusOK, "ok")
+		})
+		for i, ip := range []string{"1.1.1.1", "2.2.2.2"} {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = "10.0.0.1:1234"
+			req.Header.Set(echo.HeaderXForwardedFor, ip)
+			req.Header.Set(echo.HeaderXRealIP, ip)
+			err := h(e.NewContext(req, httptest.NewRecorder()))
+			if i == 0 || trust {
+				assert.Nil(t, err)
+				continue
+			}
+			he, ok := err.(*echo.HTTPError)
+			assert.True(t, ok)
+			assert.Equal(t, http.StatusTooManyRequests, he.Code)
+		}
+	}
+}
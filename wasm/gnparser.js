@@ -0,0 +1,150 @@
+// Minimal glue code to use gnparser.wasm in browsers and Node.js.
+//
+//   import { load } from "./gnparser.js";
+//   const gnp = await load("gnparser.wasm");
+//   console.log(gnp.parse("Bubo bubo Linnaeus 1758"));
+//   console.log(gnp.parseAry(["Bubo bubo", "Pomatomus saltatrix"], "csv"));
+//
+// The module provides only WASI functions that the parser needs, without
+// access to files, environment or arguments.
+
+const ERRNO_SUCCESS = 0;
+const ERRNO_BADF = 8;
+const ERRNO_NOSYS = 52;
+
+// load instantiates the parser from a URL, a Response, or bytes of
+// the WebAssembly module.
+export async function load(source) {
+  let memory;
+  const view = () => new DataView(memory.buffer);
+  const bytes = (ptr, len) => new Uint8Array(memory.buffer, ptr, len);
+  const decoder = new TextDecoder();
+
+  const wasi = {
+    args_sizes_get(argc, size) {
+      view().setUint32(argc, 0, true);
+      view().setUint32(size, 0, true);
+      return ERRNO_SUCCESS;
+    },
+    args_get: () => ERRNO_SUCCESS,
+    environ_sizes_get(count, size) {
+      view().setUint32(count, 0, true);
+      view().setUint32(size, 0, true);
+      return ERRNO_SUCCESS;
+    },
+    environ_get: () => ERRNO_SUCCESS,
+    clock_time_get(id, precision, time) {
+      const ms = id === 0 ? Date.now() : performance.now();
+      view().setBigUint64(time, BigInt(Math.round(ms * 1e6)), true);
+      return ERRNO_SUCCESS;
+    },
+    random_get(ptr, len) {
+      for (let i = 0; i < len; i += 65536) {
+        crypto.getRandomValues(bytes(ptr + i, Math.min(65536, len - i)));
+      }
+      return ERRNO_SUCCESS;
+    },
+    fd_write(fd, iovs, iovsLen, written) {
+      let total = 0;
+      let text = "";
+      for (let i = 0; i < iovsLen; i++) {
+        const ptr = view().getUint32(iovs + i * 8, true);
+        const len = view().getUint32(iovs + i * 8 + 4, true);
+        text += decoder.decode(bytes(ptr, len));
+        total += len;
+      }
+      if (fd === 1) console.log(text);
+      if (fd === 2) console.error(text);
+      view().setUint32(written, total, true);
+      return ERRNO_SUCCESS;
+    },
+    fd_fdstat_get(fd, stat) {
+      if (fd > 2) return ERRNO_BADF;
+      bytes(stat, 24).fill(0);
+      // character device
+      view().setUint8(stat, 2);
+      return ERRNO_SUCCESS;
+    },
+    fd_prestat_get: () => ERRNO_BADF,
+    sched_yield: () => ERRNO_SUCCESS,
+    // poll_oneoff reports all subscriptions as ready without waiting.
+    poll_oneoff(subs, events, count, ready) {
+      for (let i = 0; i < count; i++) {
+        const sub = subs + i * 48;
+        const ev = events + i * 32;
+        bytes(ev, 32).fill(0);
+        view().setBigUint64(ev, view().getBigUint64(sub, true), true);
+        view().setUint8(ev + 10, view().getUint8(sub + 8));
+      }
+      view().setUint32(ready, count, true);
+      return ERRNO_SUCCESS;
+    },
+    proc_exit(code) {
+      throw new Error(`gnparser exited with code ${code}`);
+    },
+  };
+  const imports = {
+    wasi_snapshot_preview1: new Proxy(wasi, {
+      get: (target, name) => target[name] || (() => ERRNO_NOSYS),
+    }),
+  };
+
+  let result;
+  if (typeof source === "string" || source instanceof URL) {
+    source = fetch(source);
+  }
+  if (source instanceof Promise || source instanceof Response) {
+    result = await WebAssembly.instantiateStreaming(source, imports);
+  } else {
+    result = await WebAssembly.instantiate(source, imports);
+  }
+  const instance = result.instance;
+  memory = instance.exports.memory;
+  instance.exports._initialize();
+  return new GNparser(instance.exports, bytes);
+}
+
+// GNparser parses name-strings with the WebAssembly module.
+class GNparser {
+  constructor(exports, bytes) {
+    this.exports = exports;
+    this.bytes = bytes;
+    this.encoder = new TextEncoder();
+    this.decoder = new TextDecoder();
+  }
+
+  // parse parses a name-string and returns the result as a string in
+  // 'compact', 'pretty' or 'csv' format.
+  parse(name, format = "compact", details = false) {
+    return this.call("ParseToString", name, format, details);
+  }
+
+  // parseAry parses an array of name-strings and returns results as
+  // a string with a JSON array, or with CSV lines.
+  parseAry(names, format = "compact", details = false) {
+    return this.call("ParseAryToString", JSON.stringify(names), format, details);
+  }
+
+  call(fn, input, format, details) {
+    const [inPtr, inLen] = this.write(input);
+    const [fmtPtr, fmtLen] = this.write(format);
+    try {
+      const res = this.exports[fn](inPtr, inLen, fmtPtr, fmtLen, details ? 1 : 0);
+      const ptr = Number(res >> 32n);
+      const len = Number(res & 0xffffffffn);
+      const out = this.decoder.decode(this.bytes(ptr, len));
+      this.exports.FreeMemory(ptr);
+      return out;
+    } finally {
+      this.exports.FreeMemory(inPtr);
+      this.exports.FreeMemory(fmtPtr);
+    }
+  }
+
+  write(s) {
+    const data = this.encoder.encode(s);
+    const ptr = this.exports.Malloc(data.length);
+    this.bytes(ptr, data.length).set(data);
+    return [ptr, data.length];
+  }
+}
@@ -0,0 +1,164 @@
+//go:build wasip1
+
+// Package main provides a WebAssembly (WASI) module of the parser. It
+// exports the same functions as the C binding. Strings are exchanged
+// through the linear memory of the module: a host allocates a buffer with
+// Malloc, writes a string there, and gives its pointer and length to
+// a function. Results are returned as a pointer and a length packed into
+// one 64-bit number (pointer in the high 32 bits, length in the low 32
+// bits). All buffers have to be released with FreeMemory.
+//
+// Build it with
+//
+//	GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o gnparser.wasm
+//
+// The module is a WASI reactor, a host has to call `_initialize` before
+// calling other functions.
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+)
+
+// buffers keep memory allocated for a host, so it is not collected by
+// the garbage collector until the host frees it.
+var buffers = struct {
+	sync.Mutex
+	data map[uint32][]byte
+}{data: make(map[uint32][]byte)}
+
+// parsers keep parsers for combinations of format and details, so they
+// are not recreated on every call.
+var parsers = struct {
+	sync.Mutex
+	data map[string]gnparser.GNparser
+}{data: make(map[string]gnparser.GNparser)}
+
+// Malloc allocates a buffer of the given size in the memory of the module
+// and returns its pointer. The buffer has to be released by FreeMemory.
+//
+//go:wasmexport Malloc
+func Malloc(size uint32) uint32 {
+	if size == 0 {
+		size = 1
+	}
+	buf := make([]byte, size)
+	ptr := uint32(uintptr(unsafe.Pointer(&buf[0])))
+	buffers.Lock()
+	defer buffers.Unlock()
+	buffers.data[ptr] = buf
+	return ptr
+}
+
+// FreeMemory releases a buffer allocated by Malloc, or a result of
+// parsing.
+//
+//go:wasmexport FreeMemory
+func FreeMemory(ptr uint32) {
+	buffers.Lock()
+	defer buffers.Unlock()
+	delete(buffers.data, ptr)
+}
+
+// ParseToString takes a name-string, desired format and a withDetails flag
+// as 0|1 integer. It parses the name-string to either JSON, or a CSV
+// string, depending on the desired format. Format argument can take values
+// of 'csv', 'compact', 'pretty'.
+//
+//go:wasmexport ParseToString
+func ParseToString(
+	namePtr, nameLen uint32,
+	fmtPtr, fmtLen uint32,
+	details int32,
+) uint64 {
+	gnp := getParser(readString(fmtPtr, fmtLen), details > 0)
+	name := readString(namePtr, nameLen)
+	return writeString(gnp.ParseName(name).Output(gnp.Format()))
+}
+
+// ParseAryToString function takes a JSON array of names, parsing format,
+// and a withDetails flag as 0|1 integer. Parsed outputs are returned as
+// a string in either CSV or JSON format. If the array cannot be read,
+// it returns a JSON object with an `error` field.
+//
+//go:wasmexport ParseAryToString
+func ParseAryToString(
+	namesPtr, namesLen uint32,
+	fmtPtr, fmtLen uint32,
+	details int32,
+) uint64 {
+	var names []string
+	err := json.Unmarshal([]byte(readString(namesPtr, namesLen)), &names)
+	if err != nil {
+		res, _ := json.Marshal(struct {
+			Error string `json:"error"`
+		}{err.Error()})
+		return writeString(string(res))
+	}
+
+	gnp := getParser(readString(fmtPtr, fmtLen), details > 0)
+	var res string
+	parsed := gnp.ParseNames(names)
+	if gnp.Format() == gnfmt.CSV {
+		csv := make([]string, len(parsed))
+		for i := range parsed {
+			csv[i] = parsed[i].Output(gnfmt.CSV)
+		}
+		res = strings.Join(csv, "\n")
+	} else {
+		json, _ := gnfmt.GNjson{}.Encode(parsed)
+		res = string(json)
+	}
+	return writeString(res)
+}
+
+// getParser returns a parser with the given format and details settings.
+func getParser(format string, details bool) gnparser.GNparser {
+	key := format
+	if details {
+		key += "|details"
+	}
+	parsers.Lock()
+	defer parsers.Unlock()
+	if gnp, ok := parsers.data[key]; ok {
+		return gnp
+	}
+	opts := []gnparser.Option{
+		gnparser.OptFormat(format),
+		gnparser.OptWithDetails(details),
+		gnparser.OptJobsNum(1),
+	}
+	gnp := gnparser.New(gnparser.NewConfig(opts...))
+	parsers.data[key] = gnp
+	return gnp
+}
+
+// readString copies a string from a buffer allocated by Malloc. It returns
+// an empty string if the buffer is unknown or shorter than the length.
+func readString(ptr, length uint32) string {
+	buffers.Lock()
+	defer buffers.Unlock()
+	buf, ok := buffers.data[ptr]
+	if !ok || int(length) > len(buf) {
+		return ""
+	}
+	return string(buf[:length])
+}
+
+// writeString copies a string to a new buffer and returns the pointer and
+// the length of the buffer packed into one number.
+func writeString(s string) uint64 {
+	ptr := Malloc(uint32(len(s)))
+	buffers.Lock()
+	copy(buffers.data[ptr], s)
+	buffers.Unlock()
+	return uint64(ptr)<<32 | uint64(len(s))
+}
+
+func main() {}
@@ -0,0 +1,161 @@
+//go:build !wasip1
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// wasmPath is the location of the WebAssembly module built for tests.
+var wasmPath string
+
+// TestMain compiles the WebAssembly module once for all tests. Tests are
+// skipped in the short mode, because the compilation takes a while.
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if testing.Short() {
+		os.Exit(m.Run())
+	}
+	dir, err := os.MkdirTemp("", "gnparser-wasm")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	wasmPath = filepath.Join(dir, "gnparser.wasm")
+	cmd := exec.Command("go", "build", "-buildmode=c-shared", "-o", wasmPath, ".")
+	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot build wasm module: %v\n%s", err, out)
+		os.RemoveAll(dir)
+		os.Exit(1)
+	}
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// module runs the WebAssembly module with the wazero runtime.
+func module(t *testing.T) api.Module {
+	if wasmPath == "" {
+		t.Skip("wasm module is not built in the short mode")
+	}
+	ctx := context.Background()
+	r := wazero.NewRuntime(ctx)
+	t.Cleanup(func() { r.Close(ctx) })
+	wasi_snapshot_preview1.MustInstantiate(ctx, r)
+	bs, err := os.ReadFile(wasmPath)
+	assert.Nil(t, err)
+	cfg := wazero.NewModuleConfig().WithStartFunctions("_initialize")
+	m, err := r.InstantiateWithConfig(ctx, bs, cfg)
+	assert.Nil(t, err)
+	return m
+}
+
+// write copies a string into a buffer allocated by the module.
+func write(t *testing.T, m api.Module, s string) (uint64, uint64) {
+	res, err := m.ExportedFunction("Malloc").Call(context.Background(), uint64(len(s)))
+	assert.Nil(t, err)
+	assert.True(t, m.Memory().WriteString(uint32(res[0]), s))
+	return res[0], uint64(len(s))
+}
+
+// call runs a parsing function of the module and returns its result.
+func call(t *testing.T, m api.Module, fn, input, format string, details uint64) string {
+	ctx := context.Background()
+	inPtr, inLen := write(t, m, input)
+	fmtPtr, fmtLen := write(t, m, format)
+	res, err := m.ExportedFunction(fn).Call(ctx, inPtr, inLen, fmtPtr, fmtLen, details)
+	assert.Nil(t, err)
+	ptr, size := uint32(res[0]>>32), uint32(res[0])
+	out, ok := m.Memory().Read(ptr, size)
+	assert.True(t, ok)
+	s := string(out)
+	free := m.ExportedFunction("FreeMemory")
+	for _, p := range []uint64{uint64(ptr), inPtr, fmtPtr} {
+		_, err = free.Call(ctx, p)
+		assert.Nil(t, err)
+	}
+	return s
+}
+
+func TestParseToString(t *testing.T) {
+	m := module(t)
+	tests := []struct {
+		msg, format string
+		details     uint64
+		has, hasNot string
+	}{
+		{"compact", "compact", 0, `"simple":"Bubo bubo"`, `"details"`},
+		{"details", "compact", 1, `"details"`, ""},
+		{"pretty", "pretty", 0, "\n  \"parsed\": true", ""},
+		{"csv", "csv", 0, ",Bubo bub,Bubo bubo,Bubo bubo,Linnaeus 1758,1758,1", "{"},
+	}
+	for _, v := range tests {
+		res := call(t, m, "ParseToString", "Bubo bubo Linnaeus 1758", v.format, v.details)
+		assert.Contains(t, res, v.has, v.msg)
+		if v.hasNot != "" {
+			assert.NotContains(t, res, v.hasNot, v.msg)
+		}
+	}
+	res := call(t, m, "ParseToString", "", "compact", 0)
+	assert.Contains(t, res, `"parsed":false`)
+}
+
+func TestParseAryToString(t *testing.T) {
+	m := module(t)
+	names := []string{"Bubo bubo", "Pomatomus saltatrix", "Bubo bubo"}
+	input, _ := json.Marshal(names)
+
+	res := call(t, m, "ParseAryToString", string(input), "compact", 0)
+	var parsed []struct {
+		Verbatim string `json:"verbatim"`
+	}
+	assert.Nil(t, json.Unmarshal([]byte(res), &parsed))
+	assert.Equal(t, len(names), len(parsed))
+	for i := range names {
+		assert.Equal(t, names[i], parsed[i].Verbatim)
+	}
+
+	res = call(t, m, "ParseAryToString", string(input), "csv", 0)
+	assert.Equal(t, 3, len(strings.Split(res, "\n")))
+
+	res = call(t, m, "ParseAryToString", "not json", "csv", 0)
+	assert.Contains(t, res, `{"error":`)
+}
+
+// TestGlue runs the JavaScript glue code with Node.js, if it is installed.
+func TestGlue(t *testing.T) {
+	if wasmPath == "" {
+		t.Skip("wasm module is not built in the short mode")
+	}
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("node is not installed")
+	}
+	glue, err := filepath.Abs("gnparser.js")
+	assert.Nil(t, err)
+	script := fmt.Sprintf(`
+import { readFileSync } from "node:fs";
+import { load } from %q;
+const gnp = await load(readFileSync(%q));
+console.log(JSON.parse(gnp.parse("Bubo bubo Linnaeus 1758")).canonical.simple);
+console.log(gnp.parseAry(["Bubo bubo", "Aus bus"], "csv").split("\n").length);
+`, "file://"+glue, wasmPath)
+	cmd := exec.Command(node, "--input-type=module", "-e", script)
+	out, err := cmd.CombinedOutput()
+	assert.Nil(t, err, string(out))
+	assert.Equal(t, "Bubo bubo\n2\n", string(out))
+}